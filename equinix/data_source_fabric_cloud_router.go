@@ -3,8 +3,16 @@ package equinix
 import (
 	"context"
 
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
 )
 
 func readFabricCloudRouterResourceSchema() map[string]*schema.Schema {
@@ -38,7 +46,262 @@ Additional documentation:
 }
 
 func dataSourceFabricCloudRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 	uuid, _ := d.Get("uuid").(string)
-	d.SetId(uuid)
-	return resourceFabricCloudRouterRead(ctx, d, meta)
+	cloudRouter, _, err := client.CloudRoutersApi.GetCloudRouterByUuid(ctx, uuid).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	d.SetId(cloudRouter.GetUuid())
+	return setCloudRouterMap(d, cloudRouter)
+}
+
+func fabricCloudRouterPackageSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"code": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Fabric Cloud Router package code",
+		},
+	}
+}
+func fabricCloudRouterAccountSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_number": {
+			Type:        schema.TypeInt,
+			Required:    true,
+			Description: "Account Number",
+		},
+	}
+}
+func fabricCloudRouterProjectSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Optional:    true,
+			Description: "Project Id",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Unique Resource URL",
+		},
+	}
+}
+
+func fabricMarketplaceSubscriptionSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Optional:    true,
+			Description: "Marketplace Subscription type like; AWS_MARKETPLACE_SUBSCRIPTION",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Equinix-assigned Marketplace Subscription identifier",
+		},
+	}
+}
+
+func fabricCloudRouterResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Equinix-assigned Fabric Cloud Router identifier",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Fabric Cloud Router URI information",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 24),
+			Description:  "Fabric Cloud Router name. An alpha-numeric 24 characters string which can include only hyphens and underscores",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Customer-provided Fabric Cloud Router description",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Fabric Cloud Router overall state",
+		},
+		"equinix_asn": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Equinix ASN",
+		},
+		"package": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Fabric Cloud Router Package Type",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: fabricCloudRouterPackageSch(),
+			},
+		},
+		"change_log": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Captures Fabric Cloud Router lifecycle change information",
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.ChangeLogSch(),
+			},
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"XF_ROUTER"}, true),
+			Description:  "Defines the FCR type like; XF_ROUTER",
+		},
+		"location": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Fabric Cloud Router location",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.LocationSch(),
+			},
+		},
+		"project": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Customer resource hierarchy project information. Applicable to customers onboarded to Equinix Identity and Access Management. For more information see Identity and Access Management: Projects",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: fabricCloudRouterProjectSch(),
+			},
+		},
+		"marketplace_subscription": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Equinix Fabric Entity for Marketplace Subscription",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: fabricMarketplaceSubscriptionSch(),
+			},
+		},
+		"account": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer account information that is associated with this Fabric Cloud Router",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: fabricCloudRouterAccountSch(),
+			},
+		},
+		"order": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Optional:    true,
+			Description: "Order information related to this Fabric Cloud Router",
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.OrderSch(),
+			},
+		},
+		"notifications": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "Preferences for notifications on Fabric Cloud Router configuration or status changes",
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.NotificationSch(),
+			},
+		},
+		"connections_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of connections associated with this Fabric Cloud Router instance",
+		},
+	}
+}
+
+func fabricCloudRouterMap(fcr *fabricv4.CloudRouter) map[string]interface{} {
+	package_ := fcr.GetPackage()
+	location := fcr.GetLocation()
+	changeLog := fcr.GetChangeLog()
+	account := fcr.GetAccount()
+	notifications := fcr.GetNotifications()
+	project := fcr.GetProject()
+	order := fcr.GetOrder()
+	marketplaceSubscription := fcr.GetMarketplaceSubscription()
+	return map[string]interface{}{
+		"name":                     fcr.GetName(),
+		"uuid":                     fcr.GetUuid(),
+		"href":                     fcr.GetHref(),
+		"type":                     string(fcr.GetType()),
+		"state":                    string(fcr.GetState()),
+		"package":                  packageCloudRouterGoToTerraform(&package_),
+		"location":                 equinix_fabric_schema.LocationWithoutIBXGoToTerraform(&location),
+		"change_log":               equinix_fabric_schema.ChangeLogGoToTerraform(&changeLog),
+		"account":                  accountCloudRouterGoToTerraform(&account),
+		"notifications":            equinix_fabric_schema.NotificationsGoToTerraform(notifications),
+		"project":                  equinix_fabric_schema.ProjectGoToTerraform(&project),
+		"equinix_asn":              fcr.GetEquinixAsn(),
+		"connections_count":        fcr.GetConnectionsCount(),
+		"order":                    equinix_fabric_schema.OrderGoToTerraform(&order),
+		"marketplace_subscription": marketplaceSubscriptionCloudRouterGoToTerraform(&marketplaceSubscription),
+	}
+}
+
+func setCloudRouterMap(d *schema.ResourceData, fcr *fabricv4.CloudRouter) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	cloudRouterMap := fabricCloudRouterMap(fcr)
+	err := equinix_schema.SetMap(d, cloudRouterMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+func accountCloudRouterGoToTerraform(account *fabricv4.SimplifiedAccount) *schema.Set {
+	if account == nil {
+		return nil
+	}
+
+	mappedAccount := map[string]interface{}{
+		"account_number": int(account.GetAccountNumber()),
+	}
+
+	accountSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: equinix_fabric_schema.AccountSch()}),
+		[]interface{}{mappedAccount},
+	)
+
+	return accountSet
+}
+func packageCloudRouterGoToTerraform(packageType *fabricv4.CloudRouterPostRequestPackage) *schema.Set {
+	mappedPackage := map[string]interface{}{
+		"code": string(packageType.GetCode()),
+	}
+	packageSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: fabricCloudRouterPackageSch()}),
+		[]interface{}{mappedPackage},
+	)
+	return packageSet
+}
+func marketplaceSubscriptionCloudRouterGoToTerraform(subscription *fabricv4.MarketplaceSubscription) *schema.Set {
+	if subscription == nil {
+		return nil
+	}
+	mappedSubscription := make(map[string]interface{})
+	mappedSubscription["type"] = string(subscription.GetType())
+	mappedSubscription["uuid"] = subscription.GetUuid()
+
+	subscriptionSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: fabricMarketplaceSubscriptionSch()}),
+		[]interface{}{mappedSubscription})
+	return subscriptionSet
 }
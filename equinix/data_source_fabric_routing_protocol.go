@@ -2,9 +2,20 @@ package equinix
 
 import (
 	"context"
+	"strconv"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func readFabricRoutingProtocolResourceSchema() map[string]*schema.Schema {
@@ -22,6 +33,26 @@ func readFabricRoutingProtocolResourceSchema() map[string]*schema.Schema {
 			sch[key].ValidateFunc = nil
 		}
 	}
+	sch["bgp_ipv4_operational_status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Operational state of the BGP IPv4 session. One of UP, DOWN, UNKNOWN",
+	}
+	sch["bgp_ipv4_op_status_changed_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time of the last BGP IPv4 session state change",
+	}
+	sch["bgp_ipv6_operational_status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Operational state of the BGP IPv6 session. One of UP, DOWN, UNKNOWN",
+	}
+	sch["bgp_ipv6_op_status_changed_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time of the last BGP IPv6 session state change",
+	}
 	return sch
 }
 
@@ -40,7 +71,520 @@ Additional documentation:
 }
 
 func dataSourceRoutingProtocolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 	uuid, _ := d.Get("uuid").(string)
+	connectionUuid, _ := d.Get("connection_uuid").(string)
+	fabricRoutingProtocolData, _, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolByUuid(ctx, uuid, connectionUuid).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
 	d.SetId(uuid)
-	return resourceFabricRoutingProtocolRead(ctx, d, meta)
+
+	routingProtocol := FabricRoutingProtocolMap(fabricRoutingProtocolData)
+	for key, value := range routingProtocolBgpOperationMap(fabricRoutingProtocolData) {
+		routingProtocol[key] = value
+	}
+	if err = equinix_schema.SetMap(d, routingProtocol); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// routingProtocolBgpOperationMap flattens the operational state of the BGP
+// sessions so that it can be used in check blocks and postconditions
+func routingProtocolBgpOperationMap(routingProtocolData *fabricv4.RoutingProtocolData) map[string]interface{} {
+	bgpOperation := make(map[string]interface{})
+	rp, ok := routingProtocolData.GetActualInstance().(*fabricv4.RoutingProtocolBGPData)
+	if !ok {
+		return bgpOperation
+	}
+	if rp.BgpIpv4 != nil && rp.BgpIpv4.Operation != nil {
+		operation := rp.BgpIpv4.GetOperation()
+		bgpOperation["bgp_ipv4_operational_status"] = string(operation.GetOperationalStatus())
+		if operation.OpStatusChangedAt != nil {
+			bgpOperation["bgp_ipv4_op_status_changed_at"] = operation.GetOpStatusChangedAt().Format(time.RFC3339)
+		}
+	}
+	if rp.BgpIpv6 != nil && rp.BgpIpv6.Operation != nil {
+		operation := rp.BgpIpv6.GetOperation()
+		bgpOperation["bgp_ipv6_operational_status"] = string(operation.GetOperationalStatus())
+		if operation.OpStatusChangedAt != nil {
+			bgpOperation["bgp_ipv6_op_status_changed_at"] = operation.GetOpStatusChangedAt().Format(time.RFC3339)
+		}
+	}
+	return bgpOperation
+}
+
+func createDirectConnectionIpv4Sch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"equinix_iface_ip": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Equinix side Interface IP address",
+		},
+	}
+}
+
+func createDirectConnectionIpv6Sch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"equinix_iface_ip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Equinix side Interface IP address\n\n",
+		},
+	}
+}
+
+func createBgpConnectionIpv4Sch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"customer_peer_ip": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Customer side peering ip",
+		},
+		"equinix_peer_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix side peering ip",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Admin status for the BGP session",
+		},
+		"outbound_as_prepend_count": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "AS path prepend count. One of: 0, 1, 3, 5",
+		},
+		"inbound_med": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Inbound Multi Exit Discriminator attribute",
+		},
+		"outbound_med": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Outbound Multi Exit Discriminator attribute",
+		},
+	}
+}
+
+func createBgpConnectionIpv6Sch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"customer_peer_ip": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Customer side peering ip",
+		},
+		"equinix_peer_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix side peering ip",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Admin status for the BGP session",
+		},
+		"outbound_as_prepend_count": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "AS path prepend count. One of: 0, 1, 3, 5",
+		},
+		"inbound_med": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Inbound Multi Exit Discriminator attribute",
+		},
+		"outbound_med": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Outbound Multi Exit Discriminator attribute",
+		},
+	}
+}
+
+func createRoutingProtocolBfdSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Bidirectional Forwarding Detection enablement",
+		},
+		"interval": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     100,
+			Description: "Interval range between the received BFD control packets",
+		},
+	}
+}
+
+func createRoutingProtocolOperationSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"errors": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Errors occurred",
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.ErrorSch(),
+			},
+		},
+	}
+}
+
+func createRoutingProtocolChangeSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Uniquely identifies a change",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of change",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Routing Protocol Change URI",
+		},
+	}
+}
+
+func createFabricRoutingProtocolResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_uuid": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Connection URI associated with Routing Protocol",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Routing Protocol URI information",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"BGP", "DIRECT"}, true),
+			Description:  "Defines the routing protocol type like BGP or DIRECT",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Equinix-assigned routing protocol identifier",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Routing Protocol name. An alpha-numeric 24 characters string which can include only hyphens and underscores",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer-provided Fabric Routing Protocol description",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Routing Protocol overall state",
+		},
+		"operation": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Routing Protocol type-specific operational data",
+			Elem: &schema.Resource{
+				Schema: createRoutingProtocolOperationSch(),
+			},
+		},
+		"change": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Routing Protocol configuration Changes",
+			Elem: &schema.Resource{
+				Schema: createRoutingProtocolChangeSch(),
+			},
+		},
+		"direct_ipv4": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Routing Protocol Direct IPv4",
+			Elem: &schema.Resource{
+				Schema: createDirectConnectionIpv4Sch(),
+			},
+		},
+		"direct_ipv6": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Routing Protocol Direct IPv6",
+			Elem: &schema.Resource{
+				Schema: createDirectConnectionIpv6Sch(),
+			},
+		},
+		"bgp_ipv4": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Routing Protocol BGP IPv4",
+			Elem: &schema.Resource{
+				Schema: createBgpConnectionIpv4Sch(),
+			},
+		},
+		"bgp_ipv6": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Routing Protocol BGP IPv6",
+			Elem: &schema.Resource{
+				Schema: createBgpConnectionIpv6Sch(),
+			},
+		},
+		"customer_asn": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer-provided ASN",
+		},
+		"equinix_asn": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Equinix ASN",
+		},
+		"bgp_auth_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "BGP authorization key",
+		},
+		"as_override_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable AS number override",
+		},
+		"bfd": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Bidirectional Forwarding Detection",
+			Elem: &schema.Resource{
+				Schema: createRoutingProtocolBfdSch(),
+			},
+		},
+		"change_log": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Captures Routing Protocol lifecycle change information",
+			Elem: &schema.Resource{
+				Schema: equinix_fabric_schema.ChangeLogSch(),
+			},
+		},
+	}
+}
+
+func FabricRoutingProtocolMap(routingProtocolData *fabricv4.RoutingProtocolData) map[string]interface{} {
+	routingProtocol := make(map[string]interface{})
+	switch rp := routingProtocolData.GetActualInstance().(type) {
+	case *fabricv4.RoutingProtocolBGPData:
+		routingProtocol["name"] = rp.GetName()
+		routingProtocol["href"] = rp.GetHref()
+		routingProtocol["type"] = string(rp.GetType())
+		routingProtocol["state"] = string(rp.GetState())
+		routingProtocol["customer_asn"] = rp.GetCustomerAsn()
+		routingProtocol["equinix_asn"] = rp.GetCustomerAsn()
+		routingProtocol["bgp_auth_key"] = rp.GetBgpAuthKey()
+		routingProtocol["as_override_enabled"] = rp.GetAsOverrideEnabled()
+		if rp.Operation != nil {
+			operation := rp.GetOperation()
+			routingProtocol["operation"] = routingProtocolOperationGoToTerraform(&operation)
+		}
+		if rp.BgpIpv4 != nil {
+			bgpIpv4 := rp.GetBgpIpv4()
+			routingProtocol["bgp_ipv4"] = routingProtocolBgpConnectionIpv4GoToTerraform(&bgpIpv4)
+		}
+		if rp.BgpIpv6 != nil {
+			bgpIpv6 := rp.GetBgpIpv6()
+			routingProtocol["bgp_ipv6"] = routingProtocolBgpConnectionIpv6GoToTerraform(&bgpIpv6)
+		}
+		if rp.Bfd != nil {
+			bfd := rp.GetBfd()
+			routingProtocol["bfd"] = routingProtocolBfdGoToTerraform(&bfd)
+		}
+		if rp.Change != nil {
+			change := rp.GetChange()
+			routingProtocol["change"] = routingProtocolChangeGoToTerraform(&change)
+		}
+		if rp.Changelog != nil {
+			changeLog := rp.GetChangelog()
+			routingProtocol["change_log"] = equinix_fabric_schema.ChangeLogGoToTerraform(&changeLog)
+		}
+	case *fabricv4.RoutingProtocolDirectData:
+		routingProtocol["name"] = rp.GetName()
+		routingProtocol["href"] = rp.GetHref()
+		routingProtocol["type"] = string(rp.GetType())
+		routingProtocol["state"] = string(rp.GetState())
+		if rp.Operation != nil {
+			operation := rp.GetOperation()
+			routingProtocol["operation"] = routingProtocolOperationGoToTerraform(&operation)
+		}
+		if rp.DirectIpv4 != nil {
+			directIpv4 := rp.GetDirectIpv4()
+			routingProtocol["direct_ipv4"] = routingProtocolDirectConnectionIpv4GoToTerraform(&directIpv4)
+		}
+		if rp.DirectIpv6 != nil {
+			directIpv6 := rp.GetDirectIpv6()
+			routingProtocol["direct_ipv6"] = routingProtocolDirectConnectionIpv6GoToTerraform(&directIpv6)
+		}
+		if rp.Change != nil {
+			change := rp.GetChange()
+			routingProtocol["change"] = routingProtocolChangeGoToTerraform(&change)
+		}
+		if rp.Changelog != nil {
+			changeLog := rp.GetChangelog()
+			routingProtocol["change_log"] = equinix_fabric_schema.ChangeLogGoToTerraform(&changeLog)
+		}
+	}
+
+	return routingProtocol
+}
+func routingProtocolDirectConnectionIpv4GoToTerraform(routingProtocolDirectIpv4 *fabricv4.DirectConnectionIpv4) *schema.Set {
+	if routingProtocolDirectIpv4 == nil {
+		return nil
+	}
+
+	mappedDirectIpv4 := map[string]interface{}{
+		"equinix_iface_ip": routingProtocolDirectIpv4.GetEquinixIfaceIp(),
+	}
+
+	rpDirectIpv4Set := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createDirectConnectionIpv4Sch()}),
+		[]interface{}{mappedDirectIpv4},
+	)
+	return rpDirectIpv4Set
+}
+
+func routingProtocolDirectConnectionIpv6GoToTerraform(routingProtocolDirectIpv6 *fabricv4.DirectConnectionIpv6) *schema.Set {
+	if routingProtocolDirectIpv6 == nil {
+		return nil
+	}
+
+	mappedDirectIpv6 := map[string]interface{}{
+		"equinix_iface_ip": routingProtocolDirectIpv6.GetEquinixIfaceIp(),
+	}
+
+	rpDirectIpv6Set := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createDirectConnectionIpv6Sch()}),
+		[]interface{}{mappedDirectIpv6},
+	)
+	return rpDirectIpv6Set
+}
+
+func routingProtocolBgpConnectionIpv4GoToTerraform(routingProtocolBgpIpv4 *fabricv4.BGPConnectionIpv4) *schema.Set {
+	if routingProtocolBgpIpv4 == nil {
+		return nil
+	}
+
+	mappedBgpIpv4 := map[string]interface{}{
+		"customer_peer_ip":          routingProtocolBgpIpv4.GetCustomerPeerIp(),
+		"equinix_peer_ip":           routingProtocolBgpIpv4.GetEquinixPeerIp(),
+		"enabled":                   routingProtocolBgpIpv4.GetEnabled(),
+		"outbound_as_prepend_count": strconv.FormatInt(routingProtocolBgpIpv4.GetOutboundASPrependCount(), 10),
+		"inbound_med":               int(routingProtocolBgpIpv4.GetInboundMED()),
+		"outbound_med":              int(routingProtocolBgpIpv4.GetOutboundMED()),
+	}
+	rpBgpIpv4Set := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createBgpConnectionIpv4Sch()}),
+		[]interface{}{mappedBgpIpv4},
+	)
+	return rpBgpIpv4Set
+}
+
+func routingProtocolBgpConnectionIpv6GoToTerraform(routingProtocolBgpIpv6 *fabricv4.BGPConnectionIpv6) *schema.Set {
+	if routingProtocolBgpIpv6 == nil {
+		return nil
+	}
+
+	mappedBgpIpv6 := map[string]interface{}{
+		"customer_peer_ip":          routingProtocolBgpIpv6.GetCustomerPeerIp(),
+		"equinix_peer_ip":           routingProtocolBgpIpv6.GetEquinixPeerIp(),
+		"enabled":                   routingProtocolBgpIpv6.GetEnabled(),
+		"outbound_as_prepend_count": strconv.FormatInt(routingProtocolBgpIpv6.GetOutboundASPrependCount(), 10),
+		"inbound_med":               int(routingProtocolBgpIpv6.GetInboundMED()),
+		"outbound_med":              int(routingProtocolBgpIpv6.GetOutboundMED()),
+	}
+
+	rpBgpIpv6Set := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createBgpConnectionIpv6Sch()}),
+		[]interface{}{mappedBgpIpv6},
+	)
+	return rpBgpIpv6Set
+}
+
+func routingProtocolBfdGoToTerraform(routingProtocolBfd *fabricv4.RoutingProtocolBFD) *schema.Set {
+	if routingProtocolBfd == nil {
+		return nil
+	}
+
+	mappedRpBfd := map[string]interface{}{
+		"enabled":  routingProtocolBfd.GetEnabled(),
+		"interval": routingProtocolBfd.GetInterval(),
+	}
+
+	rpBfdSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createRoutingProtocolBfdSch()}),
+		[]interface{}{mappedRpBfd},
+	)
+	return rpBfdSet
+}
+
+func routingProtocolOperationGoToTerraform(routingProtocolOperation *fabricv4.RoutingProtocolOperation) *schema.Set {
+	if routingProtocolOperation == nil {
+		return nil
+	}
+	mappedRpOperation := make(map[string]interface{})
+	errors := routingProtocolOperation.GetErrors()
+	if errors != nil {
+		mappedRpOperation["errors"] = equinix_fabric_schema.ErrorGoToTerraform(errors)
+	}
+
+	rpOperationSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createRoutingProtocolOperationSch()}),
+		[]interface{}{mappedRpOperation},
+	)
+	return rpOperationSet
+}
+
+func routingProtocolChangeGoToTerraform(routingProtocolChange *fabricv4.RoutingProtocolChange) *schema.Set {
+	if routingProtocolChange == nil {
+		return nil
+	}
+
+	mappedRpChange := map[string]interface{}{
+		"uuid": routingProtocolChange.GetUuid(),
+		"type": string(routingProtocolChange.GetType()),
+		"href": routingProtocolChange.GetHref(),
+	}
+
+	rpChangeSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: createRoutingProtocolChangeSch()}),
+		[]interface{}{mappedRpChange},
+	)
+	return rpChangeSet
 }
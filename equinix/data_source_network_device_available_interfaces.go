@@ -0,0 +1,108 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/equinix/ne-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var neDeviceAvailableInterfacesSchemaNames = map[string]string{
+	"DeviceID":              "device_id",
+	"PrimaryInterfaceIDs":   "primary_interface_ids",
+	"SecondaryDeviceID":     "secondary_device_id",
+	"SecondaryInterfaceIDs": "secondary_interface_ids",
+}
+
+var neDeviceAvailableInterfacesDescriptions = map[string]string{
+	"DeviceID":              "Unique identifier of the primary network device",
+	"PrimaryInterfaceIDs":   "IDs of the primary device interfaces with AVAILABLE status, in ascending order",
+	"SecondaryDeviceID":     "Unique identifier of the secondary network device, empty when the device is not redundant",
+	"SecondaryInterfaceIDs": "IDs of the secondary device interfaces with AVAILABLE status, in ascending order",
+}
+
+func dataSourceNetworkDeviceAvailableInterfaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkDeviceAvailableInterfacesRead,
+		Description: "Use this data source to get the interfaces of an Equinix Network Edge network device that are not reserved or assigned to a connection",
+		Schema: map[string]*schema.Schema{
+			neDeviceAvailableInterfacesSchemaNames["DeviceID"]: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  neDeviceAvailableInterfacesDescriptions["DeviceID"],
+			},
+			neDeviceAvailableInterfacesSchemaNames["PrimaryInterfaceIDs"]: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: neDeviceAvailableInterfacesDescriptions["PrimaryInterfaceIDs"],
+			},
+			neDeviceAvailableInterfacesSchemaNames["SecondaryDeviceID"]: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: neDeviceAvailableInterfacesDescriptions["SecondaryDeviceID"],
+			},
+			neDeviceAvailableInterfacesSchemaNames["SecondaryInterfaceIDs"]: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: neDeviceAvailableInterfacesDescriptions["SecondaryInterfaceIDs"],
+			},
+		},
+	}
+}
+
+func dataSourceNetworkDeviceAvailableInterfacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conf := m.(*config.Config)
+	var diags diag.Diagnostics
+
+	primary, err := conf.Ne.GetDevice(d.Get(neDeviceAvailableInterfacesSchemaNames["DeviceID"]).(string))
+	if err != nil {
+		return diag.Errorf("cannot fetch primary network device due to '%v'", err)
+	}
+	if slices.Contains([]string{ne.DeviceStateDeprovisioning, ne.DeviceStateDeprovisioned}, ne.StringValue(primary.Status)) {
+		return diag.Errorf("network device %s is %s", ne.StringValue(primary.UUID), ne.StringValue(primary.Status))
+	}
+
+	var secondary *ne.Device
+	if ne.StringValue(primary.RedundantUUID) != "" {
+		secondary, err = conf.Ne.GetDevice(ne.StringValue(primary.RedundantUUID))
+		if err != nil {
+			return diag.Errorf("cannot fetch secondary network device due to '%v'", err)
+		}
+	}
+
+	if err := updateDataSourceNetworkDeviceAvailableInterfaces(primary, secondary, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func updateDataSourceNetworkDeviceAvailableInterfaces(primary *ne.Device, secondary *ne.Device, d *schema.ResourceData) error {
+	d.SetId(ne.StringValue(primary.UUID))
+	if err := d.Set(neDeviceAvailableInterfacesSchemaNames["PrimaryInterfaceIDs"], network.AvailableInterfaceIDs(primary.Interfaces)); err != nil {
+		return fmt.Errorf("error reading PrimaryInterfaceIDs: %s", err)
+	}
+
+	secondaryDeviceID := ""
+	secondaryInterfaceIDs := []int{}
+	if secondary != nil {
+		secondaryDeviceID = ne.StringValue(secondary.UUID)
+		secondaryInterfaceIDs = network.AvailableInterfaceIDs(secondary.Interfaces)
+	}
+	if err := d.Set(neDeviceAvailableInterfacesSchemaNames["SecondaryDeviceID"], secondaryDeviceID); err != nil {
+		return fmt.Errorf("error reading SecondaryDeviceID: %s", err)
+	}
+	if err := d.Set(neDeviceAvailableInterfacesSchemaNames["SecondaryInterfaceIDs"], secondaryInterfaceIDs); err != nil {
+		return fmt.Errorf("error reading SecondaryInterfaceIDs: %s", err)
+	}
+	return nil
+}
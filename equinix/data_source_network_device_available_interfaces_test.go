@@ -0,0 +1,64 @@
+package equinix
+
+import (
+	"testing"
+
+	"github.com/equinix/ne-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkDeviceAvailableInterfaces_updateResourceData(t *testing.T) {
+	// given
+	primary := &ne.Device{
+		UUID: ne.String("primary-uuid"),
+		Interfaces: []ne.DeviceInterface{
+			{ID: ne.Int(5), Status: ne.String("AVAILABLE")},
+			{ID: ne.Int(1), Status: ne.String("ASSIGNED")},
+			{ID: ne.Int(3), Status: ne.String("AVAILABLE")},
+			{ID: ne.Int(4), Status: ne.String("RESERVED")},
+		},
+	}
+	secondary := &ne.Device{
+		UUID: ne.String("secondary-uuid"),
+		Interfaces: []ne.DeviceInterface{
+			{ID: ne.Int(2), Status: ne.String("ASSIGNED")},
+			{ID: ne.Int(6), Status: ne.String("AVAILABLE")},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkDeviceAvailableInterfaces().Schema, map[string]interface{}{
+		neDeviceAvailableInterfacesSchemaNames["DeviceID"]: "primary-uuid",
+	})
+
+	// when
+	err := updateDataSourceNetworkDeviceAvailableInterfaces(primary, secondary, d)
+
+	// then
+	assert.Nil(t, err, "Update of resource data does not return error")
+	assert.Equal(t, "primary-uuid", d.Id(), "ID matches")
+	assert.Equal(t, []interface{}{3, 5}, d.Get(neDeviceAvailableInterfacesSchemaNames["PrimaryInterfaceIDs"]), "PrimaryInterfaceIDs matches")
+	assert.Equal(t, "secondary-uuid", d.Get(neDeviceAvailableInterfacesSchemaNames["SecondaryDeviceID"]), "SecondaryDeviceID matches")
+	assert.Equal(t, []interface{}{6}, d.Get(neDeviceAvailableInterfacesSchemaNames["SecondaryInterfaceIDs"]), "SecondaryInterfaceIDs matches")
+}
+
+func TestNetworkDeviceAvailableInterfaces_updateResourceDataWithoutSecondary(t *testing.T) {
+	// given
+	primary := &ne.Device{
+		UUID: ne.String("primary-uuid"),
+		Interfaces: []ne.DeviceInterface{
+			{ID: ne.Int(1), Status: ne.String("ASSIGNED")},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkDeviceAvailableInterfaces().Schema, map[string]interface{}{
+		neDeviceAvailableInterfacesSchemaNames["DeviceID"]: "primary-uuid",
+	})
+
+	// when
+	err := updateDataSourceNetworkDeviceAvailableInterfaces(primary, nil, d)
+
+	// then
+	assert.Nil(t, err, "Update of resource data does not return error")
+	assert.Empty(t, d.Get(neDeviceAvailableInterfacesSchemaNames["PrimaryInterfaceIDs"]), "PrimaryInterfaceIDs is empty")
+	assert.Equal(t, "", d.Get(neDeviceAvailableInterfacesSchemaNames["SecondaryDeviceID"]), "SecondaryDeviceID is empty")
+	assert.Empty(t, d.Get(neDeviceAvailableInterfacesSchemaNames["SecondaryInterfaceIDs"]), "SecondaryInterfaceIDs is empty")
+}
@@ -6,11 +6,12 @@ import (
 
 func networkEdgeDatasources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"equinix_network_account":         dataSourceNetworkAccount(),
-		"equinix_network_device":          dataSourceNetworkDevice(),
-		"equinix_network_device_type":     dataSourceNetworkDeviceType(),
-		"equinix_network_device_software": dataSourceNetworkDeviceSoftware(),
-		"equinix_network_device_platform": dataSourceNetworkDevicePlatform(),
+		"equinix_network_account":                     dataSourceNetworkAccount(),
+		"equinix_network_device":                      dataSourceNetworkDevice(),
+		"equinix_network_device_available_interfaces": dataSourceNetworkDeviceAvailableInterfaces(),
+		"equinix_network_device_type":                 dataSourceNetworkDeviceType(),
+		"equinix_network_device_software":             dataSourceNetworkDeviceSoftware(),
+		"equinix_network_device_platform":             dataSourceNetworkDevicePlatform(),
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/polling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -83,6 +84,20 @@ func Provider() *schema.Provider {
 				Default:     30,
 				Description: "Maximum number of seconds to wait before retrying a request.",
 			},
+			"poll_min_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(polling.DefaultMinInterval.Seconds()),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  fmt.Sprintf("The base duration of time, in seconds, to wait between two status checks of a resource that is being created, updated or deleted. Waits grow exponentially with random jitter from this value. (Defaults to `%d`)", int(polling.DefaultMinInterval.Seconds())),
+			},
+			"poll_max_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(polling.DefaultMaxInterval.Seconds()),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  fmt.Sprintf("The maximum duration of time, in seconds, to wait between two status checks of a resource that is being created, updated or deleted. Must not be lower than `poll_min_interval`. (Defaults to `%d`)", int(polling.DefaultMaxInterval.Seconds())),
+			},
 		},
 		DataSourcesMap: datasources,
 		ResourcesMap:   resources,
@@ -107,6 +122,11 @@ type providerMeta struct {
 func configureProvider(ctx context.Context, d *schema.ResourceData, p *schema.Provider) (interface{}, diag.Diagnostics) {
 	mrws := d.Get("max_retry_wait_seconds").(int)
 	rt := d.Get("request_timeout").(int)
+	pmin := d.Get("poll_min_interval").(int)
+	pmax := d.Get("poll_max_interval").(int)
+	if pmax < pmin {
+		return nil, diag.Errorf("poll_max_interval (%d) must not be lower than poll_min_interval (%d)", pmax, pmin)
+	}
 
 	config := config.Config{
 		AuthToken:      d.Get("auth_token").(string),
@@ -118,6 +138,9 @@ func configureProvider(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		PageSize:       d.Get("response_max_page_size").(int),
 		MaxRetries:     d.Get("max_retries").(int),
 		MaxRetryWait:   time.Duration(mrws) * time.Second,
+
+		PollMinInterval: time.Duration(pmin) * time.Second,
+		PollMaxInterval: time.Duration(pmax) * time.Second,
 	}
 	meta := providerMeta{}
 
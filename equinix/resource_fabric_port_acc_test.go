@@ -2,6 +2,7 @@ package equinix_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
@@ -61,3 +62,40 @@ func TestAccFabricGetPortsByName(t *testing.T) {
 		},
 	})
 }
+
+func TestAccFabricPortOrderPlan(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccFabricPortOrderConfig("10GBASE_LR"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccFabricPortOrderConfig("10GBASE_XX"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected physical_ports_type to be one of`),
+			},
+		},
+	})
+}
+
+func testAccFabricPortOrderConfig(physicalPortsType string) string {
+	return fmt.Sprintf(`resource "equinix_fabric_port" "test" {
+	type                     = "XF_PORT"
+	connectivity_source_type = "COLO"
+	physical_ports_speed     = 10000
+	physical_ports_type      = "%s"
+	lag_enabled              = true
+	physical_ports_count     = 2
+	location {
+		metro_code = "SV"
+		ibx        = "SV1"
+	}
+	encapsulation {
+		type = "DOT1Q"
+	}
+	}`, physicalPortsType)
+}
@@ -0,0 +1,959 @@
+package equinix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func portOrderAccountSch() map[string]*schema.Schema {
+	sch := equinix_fabric_schema.AccountSch()
+	sch["account_number"].Optional = true
+	sch["account_number"].Description = "Equinix-assigned account number. Defaults to the account of the API credentials"
+	return sch
+}
+
+func portOrderRedundancySch() map[string]*schema.Schema {
+	sch := PortRedundancySch()
+	sch["enabled"].Optional = true
+	sch["priority"].Optional = true
+	sch["priority"].ValidateFunc = validation.StringInSlice([]string{
+		string(fabricv4.PORTPRIORITY_PRIMARY),
+		string(fabricv4.PORTPRIORITY_SECONDARY),
+	}, false)
+	sch["priority"].Description = "Priority type-PRIMARY or SECONDARY"
+	return sch
+}
+
+func portOrderEncapsulationSch() map[string]*schema.Schema {
+	sch := portEncapsulationSch()
+	sch["type"].Computed = false
+	sch["type"].Required = true
+	sch["type"].ValidateFunc = validation.StringInSlice([]string{
+		string(fabricv4.PORTENCAPSULATIONTYPE_NULL),
+		string(fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q),
+		string(fabricv4.PORTENCAPSULATIONTYPE_QINQ),
+		string(fabricv4.PORTENCAPSULATIONTYPE_UNTAGGED),
+	}, false)
+	sch["type"].Description = "Port encapsulation protocol type. One of NULL, DOT1Q, QINQ, UNTAGGED"
+	sch["tag_protocol_id"].Optional = true
+	return sch
+}
+
+func portDemarcationPointSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ibx": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "IBX of the customer cage the cross connect terminates in",
+		},
+		"cage_unique_space_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer cage unique space id",
+		},
+		"cabinet_unique_space_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer cabinet unique space id",
+		},
+		"patch_panel": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer patch panel the cross connect terminates on",
+		},
+		"patch_panel_port_a": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer patch panel port A",
+		},
+		"patch_panel_port_b": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer patch panel port B",
+		},
+		"connector_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer patch panel connector type, i.e. SC or LC",
+		},
+	}
+}
+
+func portOrderSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"purchase_order_number": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Purchase order number",
+		},
+		"purchase_order_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(fabricv4.PORTORDERPURCHASEORDERTYPE_NEW),
+				string(fabricv4.PORTORDERPURCHASEORDERTYPE_EXISTING),
+				string(fabricv4.PORTORDERPURCHASEORDERTYPE_BLANKET),
+				string(fabricv4.PORTORDERPURCHASEORDERTYPE_EXEMPTION),
+			}, false),
+			Description: "Purchase order type. One of NEW, EXISTING, BLANKET, EXEMPTION",
+		},
+		"customer_reference_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Customer order reference id",
+		},
+		"order_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Order Identification",
+		},
+		"order_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Order Reference Number",
+		},
+	}
+}
+
+func portNotificationSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(fabricv4.PORTNOTIFICATIONTYPE_NOTIFICATION),
+				string(fabricv4.PORTNOTIFICATIONTYPE_TECHNICAL),
+				string(fabricv4.PORTNOTIFICATIONTYPE_PEERING),
+				string(fabricv4.PORTNOTIFICATIONTYPE_ESCALATION),
+			}, false),
+			Description: "Notification Type - NOTIFICATION, TECHNICAL, PEERING, ESCALATION",
+		},
+		"registered_users": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "Array of registered users receiving the notifications",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func portLoaSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Letter of Authorization identifier",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Letter of Authorization download URI",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Letter of Authorization type",
+		},
+	}
+}
+
+func portTetherSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cross_connect_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cross connect identifier",
+		},
+		"cabinet_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix cabinet number",
+		},
+		"system_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix system name",
+		},
+		"patch_panel": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix patch panel",
+		},
+		"patch_panel_port_a": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix patch panel port A",
+		},
+		"patch_panel_port_b": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix patch panel port B",
+		},
+		"ibx": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix IBX",
+		},
+	}
+}
+
+func physicalPortSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix-assigned physical port identifier",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Physical port type",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Physical port state",
+		},
+		"interface_speed": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Physical port speed in Mbps",
+		},
+		"interface_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Physical port interface type",
+		},
+		"tether": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Cross connect between the Equinix patch panel and the customer demarcation point",
+			Elem: &schema.Resource{
+				Schema: portTetherSch(),
+			},
+		},
+		"demarcation_point": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Customer side termination of the cross connect",
+			Elem: &schema.Resource{
+				Schema: portDemarcationPointSch(),
+			},
+		},
+		"loas": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Letters of Authorization for the cross connect of this physical port",
+			Elem: &schema.Resource{
+				Schema: portLoaSch(),
+			},
+		},
+	}
+}
+
+// fabricPortOrderResourceSchema extends the port read model with the
+// attributes needed to order a port. Everything but the number of physical
+// ports is fixed once ordered
+func fabricPortOrderResourceSchema() map[string]*schema.Schema {
+	sch := FabricPortResourceSchema()
+	sch["uuid"].Required = false
+	sch["uuid"].Computed = true
+
+	sch["type"].Computed = false
+	sch["type"].Required = true
+	sch["type"].ForceNew = true
+	sch["type"].ValidateFunc = validation.StringInSlice([]string{
+		string(fabricv4.PORTTYPE_XF_PORT),
+		string(fabricv4.PORTTYPE_IX_PORT),
+		string(fabricv4.PORTTYPE_IA_PORT),
+	}, false)
+	sch["type"].Description = "Port type. One of XF_PORT, IX_PORT, IA_PORT"
+
+	sch["description"].Optional = true
+	sch["description"].ForceNew = true
+
+	sch["location"].Computed = false
+	sch["location"].Required = true
+	sch["location"].ForceNew = true
+	sch["location"].MaxItems = 1
+	sch["location"].Description = "Port location information; metro_code is required and ibx selects the Equinix IBX the port is provisioned in"
+
+	sch["account"].Optional = true
+	sch["account"].ForceNew = true
+	sch["account"].MaxItems = 1
+	sch["account"].Elem = &schema.Resource{Schema: portOrderAccountSch()}
+
+	sch["redundancy"].Optional = true
+	sch["redundancy"].ForceNew = true
+	sch["redundancy"].MaxItems = 1
+	sch["redundancy"].Elem = &schema.Resource{Schema: portOrderRedundancySch()}
+
+	sch["encapsulation"].Computed = false
+	sch["encapsulation"].Required = true
+	sch["encapsulation"].ForceNew = true
+	sch["encapsulation"].MaxItems = 1
+	sch["encapsulation"].Elem = &schema.Resource{Schema: portOrderEncapsulationSch()}
+
+	sch["lag_enabled"].Optional = true
+	sch["lag_enabled"].ForceNew = true
+	sch["lag_enabled"].Description = "Order the port as a Link Aggregation Group. Required to add physical ports after ordering"
+
+	sch["project"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Customer resource hierarchy project information. Applicable to customers onboarded to Equinix Identity and Access Management. For more information see Identity and Access Management: Projects",
+		Elem: &schema.Resource{
+			Schema: equinix_fabric_schema.ProjectSch(),
+		},
+	}
+	sch["physical_ports_speed"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Speed of each physical port in Mbps, i.e. 1000, 10000 or 100000",
+	}
+	sch["physical_ports_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(fabricv4.PORTPHYSICALPORTSTYPE__1000_BASE_LX),
+			string(fabricv4.PORTPHYSICALPORTSTYPE__1000_BASE_SX),
+			string(fabricv4.PORTPHYSICALPORTSTYPE__10_GBASE_LR),
+			string(fabricv4.PORTPHYSICALPORTSTYPE__10_GBASE_ER),
+			string(fabricv4.PORTPHYSICALPORTSTYPE__100_GBASE_LR4),
+		}, false),
+		Description: "Optic type of the physical ports. One of 1000BASE_LX, 1000BASE_SX, 10GBASE_LR, 10GBASE_ER, 100GBASE_LR4",
+	}
+	sch["physical_ports_count"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Number of physical ports. Increasing it on a LAG port adds members in place; decreasing it replaces the port",
+	}
+	sch["connectivity_source_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(fabricv4.PORTCONNECTIVITYSOURCETYPE_COLO),
+			string(fabricv4.PORTCONNECTIVITYSOURCETYPE_BMMR),
+			string(fabricv4.PORTCONNECTIVITYSOURCETYPE_REMOTE),
+		}, false),
+		Description: "Connection type used to reach the port. One of COLO, BMMR, REMOTE",
+	}
+	sch["demarcation_point"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Customer side termination of the cross connects; also used for physical ports added to the LAG",
+		Elem: &schema.Resource{
+			Schema: portDemarcationPointSch(),
+		},
+	}
+	sch["shared_port_type"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Order the port as a shared port",
+	}
+	sch["shared_port_product"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(fabricv4.PORTSETTINGSSHAREDPORTPRODUCT_NETWORK_EDGE),
+			string(fabricv4.PORTSETTINGSSHAREDPORTPRODUCT_VIRTUAL_GATEWAY),
+			string(fabricv4.PORTSETTINGSSHAREDPORTPRODUCT_SMARTKEY),
+			string(fabricv4.PORTSETTINGSSHAREDPORTPRODUCT_EDGE_METAL),
+		}, false),
+		Description: "Product the shared port is used for. One of NETWORK_EDGE, VIRTUAL_GATEWAY, SMARTKEY, EDGE_METAL",
+	}
+	sch["order"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Order information related to this port",
+		Elem: &schema.Resource{
+			Schema: portOrderSch(),
+		},
+	}
+	sch["notifications"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Preferences for notifications on port configuration or status changes",
+		Elem: &schema.Resource{
+			Schema: portNotificationSch(),
+		},
+	}
+	sch["physical_ports"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Physical ports that implement this port, including their cross connects and Letters of Authorization",
+		Elem: &schema.Resource{
+			Schema: physicalPortSch(),
+		},
+	}
+	sch["loas"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Letters of Authorization for the cross connects of this port",
+		Elem: &schema.Resource{
+			Schema: portLoaSch(),
+		},
+	}
+	return sch
+}
+
+func resourceFabricPortOrder() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+		},
+		ReadContext:   resourceFabricPortOrderRead,
+		CreateContext: resourceFabricPortOrderCreate,
+		UpdateContext: resourceFabricPortOrderUpdate,
+		DeleteContext: resourceFabricPortOrderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("physical_ports_count", func(ctx context.Context, old, new, meta interface{}) bool {
+				return new.(int) < old.(int)
+			}),
+			customdiff.IfValueChange("physical_ports_count", func(ctx context.Context, old, new, meta interface{}) bool {
+				return new.(int) > old.(int)
+			}, resourceFabricPortOrderValidateAddToLag),
+		),
+		Schema: fabricPortOrderResourceSchema(),
+
+		Description: `Fabric V4 API compatible resource allows ordering and management of Equinix Fabric Ports, including Link Aggregation Group members and their cross connects
+
+Physical port provisioning includes installing cross connects in the IBX; increase the create timeout when the order waits on cross connect installation.
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/Fabric/IMPLEMENTATION/fabric-ports-implement.htm
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#ports`,
+	}
+}
+
+// resourceFabricPortOrderValidateAddToLag rejects at plan time adding physical
+// ports to an existing port that was not ordered as a LAG
+func resourceFabricPortOrderValidateAddToLag(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("lag_enabled") {
+		return nil
+	}
+	if !d.Get("lag_enabled").(bool) {
+		return fmt.Errorf("physical ports can only be added to a port ordered with lag_enabled = true")
+	}
+	return nil
+}
+
+func portOrderAccountTerraformToGo(accountList []interface{}) fabricv4.SimplifiedAccount {
+	account := fabricv4.SimplifiedAccount{}
+	if len(accountList) == 0 || accountList[0] == nil {
+		return account
+	}
+	accountMap := accountList[0].(map[string]interface{})
+	if accountNumber := accountMap["account_number"].(int); accountNumber != 0 {
+		account.SetAccountNumber(int64(accountNumber))
+	}
+	return account
+}
+
+func portOrderRedundancyTerraformToGo(redundancyList []interface{}) *fabricv4.PortRedundancy {
+	if len(redundancyList) == 0 || redundancyList[0] == nil {
+		return nil
+	}
+	redundancy := fabricv4.PortRedundancy{}
+	redundancyMap := redundancyList[0].(map[string]interface{})
+	redundancy.SetEnabled(redundancyMap["enabled"].(bool))
+	if priority := redundancyMap["priority"].(string); priority != "" {
+		redundancy.SetPriority(fabricv4.PortPriority(priority))
+	}
+	return &redundancy
+}
+
+func portOrderEncapsulationTerraformToGo(encapsulationList []interface{}) fabricv4.PortEncapsulation {
+	encapsulation := fabricv4.PortEncapsulation{}
+	if len(encapsulationList) == 0 || encapsulationList[0] == nil {
+		return encapsulation
+	}
+	encapsulationMap := encapsulationList[0].(map[string]interface{})
+	encapsulation.SetType(fabricv4.PortEncapsulationType(encapsulationMap["type"].(string)))
+	if tagProtocolID := encapsulationMap["tag_protocol_id"].(string); tagProtocolID != "" {
+		encapsulation.SetTagProtocolId(tagProtocolID)
+	}
+	return encapsulation
+}
+
+func portDemarcationPointTerraformToGo(demarcationPointList []interface{}) *fabricv4.PortDemarcationPoint {
+	if len(demarcationPointList) == 0 || demarcationPointList[0] == nil {
+		return nil
+	}
+	demarcationPoint := fabricv4.PortDemarcationPoint{}
+	demarcationPointMap := demarcationPointList[0].(map[string]interface{})
+	if ibx := demarcationPointMap["ibx"].(string); ibx != "" {
+		demarcationPoint.SetIbx(ibx)
+	}
+	if cageUniqueSpaceID := demarcationPointMap["cage_unique_space_id"].(string); cageUniqueSpaceID != "" {
+		demarcationPoint.SetCageUniqueSpaceId(cageUniqueSpaceID)
+	}
+	if cabinetUniqueSpaceID := demarcationPointMap["cabinet_unique_space_id"].(string); cabinetUniqueSpaceID != "" {
+		demarcationPoint.SetCabinetUniqueSpaceId(cabinetUniqueSpaceID)
+	}
+	if patchPanel := demarcationPointMap["patch_panel"].(string); patchPanel != "" {
+		demarcationPoint.SetPatchPanel(patchPanel)
+	}
+	if patchPanelPortA := demarcationPointMap["patch_panel_port_a"].(string); patchPanelPortA != "" {
+		demarcationPoint.SetPatchPanelPortA(patchPanelPortA)
+	}
+	if patchPanelPortB := demarcationPointMap["patch_panel_port_b"].(string); patchPanelPortB != "" {
+		demarcationPoint.SetPatchPanelPortB(patchPanelPortB)
+	}
+	if connectorType := demarcationPointMap["connector_type"].(string); connectorType != "" {
+		demarcationPoint.SetConnectorType(connectorType)
+	}
+	return &demarcationPoint
+}
+
+func portOrderTerraformToGo(orderList []interface{}) *fabricv4.PortOrder {
+	if len(orderList) == 0 || orderList[0] == nil {
+		return nil
+	}
+	order := fabricv4.PortOrder{}
+	orderMap := orderList[0].(map[string]interface{})
+	purchaseOrder := fabricv4.PortOrderPurchaseOrder{}
+	if number := orderMap["purchase_order_number"].(string); number != "" {
+		purchaseOrder.SetNumber(number)
+	}
+	if purchaseOrderType := orderMap["purchase_order_type"].(string); purchaseOrderType != "" {
+		purchaseOrder.SetType(fabricv4.PortOrderPurchaseOrderType(purchaseOrderType))
+	}
+	if purchaseOrder.HasNumber() || purchaseOrder.HasType() {
+		order.SetPurchaseOrder(purchaseOrder)
+	}
+	if customerReferenceID := orderMap["customer_reference_id"].(string); customerReferenceID != "" {
+		order.SetCustomerReferenceId(customerReferenceID)
+	}
+	return &order
+}
+
+func portNotificationsTerraformToGo(notificationsList []interface{}) []fabricv4.PortNotification {
+	if len(notificationsList) == 0 {
+		return nil
+	}
+	notifications := make([]fabricv4.PortNotification, len(notificationsList))
+	for index, notification := range notificationsList {
+		notificationMap := notification.(map[string]interface{})
+		notifications[index] = fabricv4.PortNotification{
+			Type:            fabricv4.PortNotificationType(notificationMap["type"].(string)),
+			RegisteredUsers: converters.IfArrToStringArr(notificationMap["registered_users"].([]interface{})),
+		}
+	}
+	return notifications
+}
+
+// newPhysicalPorts builds the physical port requests used to add members to a
+// LAG port
+func newPhysicalPorts(d *schema.ResourceData, count int) []fabricv4.PhysicalPort {
+	physicalPorts := make([]fabricv4.PhysicalPort, count)
+	demarcationPoint := portDemarcationPointTerraformToGo(d.Get("demarcation_point").(*schema.Set).List())
+	for index := range physicalPorts {
+		physicalPort := fabricv4.PhysicalPort{}
+		physicalPort.SetType(fabricv4.PHYSICALPORTTYPE_XF_PHYSICAL_PORT)
+		physicalPort.SetInterfaceSpeed(int32(d.Get("physical_ports_speed").(int)))
+		physicalPort.SetInterfaceType(d.Get("physical_ports_type").(string))
+		if demarcationPoint != nil {
+			physicalPort.SetDemarcationPoint(*demarcationPoint)
+		}
+		physicalPorts[index] = physicalPort
+	}
+	return physicalPorts
+}
+
+func resourceFabricPortOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+
+	schemaLocation := d.Get("location").(*schema.Set).List()
+	createPortRequest := fabricv4.NewPortRequest(
+		fabricv4.PortType(d.Get("type").(string)),
+		int32(d.Get("physical_ports_speed").(int)),
+		fabricv4.PortPhysicalPortsType(d.Get("physical_ports_type").(string)),
+		fabricv4.PortConnectivitySourceType(d.Get("connectivity_source_type").(string)),
+		portOrderAccountTerraformToGo(d.Get("account").(*schema.Set).List()),
+		equinix_fabric_schema.LocationTerraformToGo(schemaLocation),
+		portOrderEncapsulationTerraformToGo(d.Get("encapsulation").(*schema.Set).List()),
+		fabricv4.PortSettings{},
+	)
+
+	physicalPortsCount := d.Get("physical_ports_count").(int)
+	createPortRequest.SetPhysicalPortsCount(int32(physicalPortsCount))
+
+	if description, ok := d.GetOk("description"); ok {
+		createPortRequest.SetDescription(description.(string))
+	}
+	if lagEnabled, ok := d.GetOk("lag_enabled"); ok {
+		createPortRequest.SetLagEnabled(lagEnabled.(bool))
+	}
+	if redundancy := portOrderRedundancyTerraformToGo(d.Get("redundancy").(*schema.Set).List()); redundancy != nil {
+		createPortRequest.SetRedundancy(*redundancy)
+	}
+	if demarcationPoint := portDemarcationPointTerraformToGo(d.Get("demarcation_point").(*schema.Set).List()); demarcationPoint != nil {
+		createPortRequest.SetDemarcationPoint(*demarcationPoint)
+		if demarcationPoint.HasIbx() {
+			createPortRequest.SetDemarcationPointIbx(demarcationPoint.GetIbx())
+		}
+		createPortRequest.SetPhysicalPorts(newPhysicalPorts(d, physicalPortsCount))
+	}
+	if schemaProject, ok := d.GetOk("project"); ok {
+		project := equinix_fabric_schema.ProjectTerraformToGo(schemaProject.(*schema.Set).List())
+		createPortRequest.SetProject(project)
+	}
+	if order := portOrderTerraformToGo(d.Get("order").(*schema.Set).List()); order != nil {
+		createPortRequest.SetOrder(*order)
+	}
+	if notifications := portNotificationsTerraformToGo(d.Get("notifications").([]interface{})); notifications != nil {
+		createPortRequest.SetNotifications(notifications)
+	}
+
+	settings := fabricv4.PortSettings{}
+	if sharedPortType, ok := d.GetOk("shared_port_type"); ok {
+		settings.SetSharedPortType(sharedPortType.(bool))
+	}
+	if sharedPortProduct, ok := d.GetOk("shared_port_product"); ok {
+		settings.SetSharedPortProduct(fabricv4.PortSettingsSharedPortProduct(sharedPortProduct.(string)))
+	}
+	createPortRequest.SetSettings(settings)
+
+	start := time.Now()
+	port, _, err := client.PortsApi.CreatePort(ctx).PortRequest(*createPortRequest).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	d.SetId(port.GetUuid())
+
+	createTimeout := d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
+	if _, err = waitUntilPortIsActive(d.Id(), physicalPortsCount, meta, d, ctx, createTimeout); err != nil {
+		return diag.Errorf("error waiting for Port (%s) to be provisioned: %s", d.Id(), err)
+	}
+
+	return resourceFabricPortOrderRead(ctx, d, meta)
+}
+
+func resourceFabricPortOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	port, _, err := client.PortsApi.GetPortByUuid(ctx, d.Id()).Execute()
+	if err != nil {
+		log.Printf("[WARN] Port %s not found , error %s", d.Id(), err)
+		if !strings.Contains(err.Error(), "500") {
+			d.SetId("")
+		}
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+	d.SetId(port.GetUuid())
+	return setFabricPortOrderMap(d, port)
+}
+
+func setFabricPortOrderMap(d *schema.ResourceData, port *fabricv4.Port) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	portMap := fabricPortMap(port)
+
+	project := port.GetProject()
+	order := port.GetOrder()
+	demarcationPoint := port.GetDemarcationPoint()
+	settings := port.GetSettings()
+	portMap["project"] = equinix_fabric_schema.ProjectGoToTerraform(&project)
+	portMap["order"] = portOrderGoToTerraform(&order)
+	portMap["demarcation_point"] = portDemarcationPointGoToTerraform(&demarcationPoint)
+	portMap["physical_ports"] = physicalPortsGoToTerraform(port.GetPhysicalPorts())
+	portMap["loas"] = portLoasGoToTerraform(port.GetLoas())
+	if port.HasPhysicalPortsSpeed() {
+		portMap["physical_ports_speed"] = int(port.GetPhysicalPortsSpeed())
+	}
+	if port.HasPhysicalPortsType() {
+		portMap["physical_ports_type"] = string(port.GetPhysicalPortsType())
+	}
+	if port.HasPhysicalPortsCount() {
+		portMap["physical_ports_count"] = int(port.GetPhysicalPortsCount())
+	}
+	if port.HasConnectivitySourceType() {
+		portMap["connectivity_source_type"] = string(port.GetConnectivitySourceType())
+	}
+	if settings.HasSharedPortType() {
+		portMap["shared_port_type"] = settings.GetSharedPortType()
+	}
+	if settings.HasSharedPortProduct() {
+		portMap["shared_port_product"] = string(settings.GetSharedPortProduct())
+	}
+	if port.Notifications != nil {
+		portMap["notifications"] = portNotificationsGoToTerraform(port.GetNotifications())
+	}
+
+	err := equinix_schema.SetMap(d, portMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func portOrderGoToTerraform(order *fabricv4.PortOrder) *schema.Set {
+	if order == nil {
+		return nil
+	}
+	purchaseOrder := order.GetPurchaseOrder()
+	mappedOrder := map[string]interface{}{
+		"purchase_order_number": purchaseOrder.GetNumber(),
+		"purchase_order_type":   string(purchaseOrder.GetType()),
+		"customer_reference_id": order.GetCustomerReferenceId(),
+		"order_id":              order.GetOrderId(),
+		"order_number":          order.GetOrderNumber(),
+	}
+	orderSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: portOrderSch()}),
+		[]interface{}{mappedOrder},
+	)
+	return orderSet
+}
+
+func portDemarcationPointMap(demarcationPoint *fabricv4.PortDemarcationPoint) map[string]interface{} {
+	return map[string]interface{}{
+		"ibx":                     demarcationPoint.GetIbx(),
+		"cage_unique_space_id":    demarcationPoint.GetCageUniqueSpaceId(),
+		"cabinet_unique_space_id": demarcationPoint.GetCabinetUniqueSpaceId(),
+		"patch_panel":             demarcationPoint.GetPatchPanel(),
+		"patch_panel_port_a":      demarcationPoint.GetPatchPanelPortA(),
+		"patch_panel_port_b":      demarcationPoint.GetPatchPanelPortB(),
+		"connector_type":          demarcationPoint.GetConnectorType(),
+	}
+}
+
+func portDemarcationPointGoToTerraform(demarcationPoint *fabricv4.PortDemarcationPoint) *schema.Set {
+	if demarcationPoint == nil {
+		return nil
+	}
+	demarcationPointSet := schema.NewSet(
+		schema.HashResource(&schema.Resource{Schema: portDemarcationPointSch()}),
+		[]interface{}{portDemarcationPointMap(demarcationPoint)},
+	)
+	return demarcationPointSet
+}
+
+func portLoasGoToTerraform(loas []fabricv4.PortLoa) []map[string]interface{} {
+	if loas == nil {
+		return nil
+	}
+	mappedLoas := make([]map[string]interface{}, len(loas))
+	for index, loa := range loas {
+		mappedLoas[index] = map[string]interface{}{
+			"uuid": loa.GetUuid(),
+			"href": loa.GetHref(),
+			"type": string(loa.GetType()),
+		}
+	}
+	return mappedLoas
+}
+
+func physicalPortsGoToTerraform(physicalPorts []fabricv4.PhysicalPort) []map[string]interface{} {
+	if physicalPorts == nil {
+		return nil
+	}
+	mappedPhysicalPorts := make([]map[string]interface{}, len(physicalPorts))
+	for index, physicalPort := range physicalPorts {
+		mappedPhysicalPort := map[string]interface{}{
+			"uuid":            physicalPort.GetUuid(),
+			"type":            string(physicalPort.GetType()),
+			"state":           string(physicalPort.GetState()),
+			"interface_speed": int(physicalPort.GetInterfaceSpeed()),
+			"interface_type":  physicalPort.GetInterfaceType(),
+			"loas":            portLoasGoToTerraform(physicalPort.GetLoas()),
+		}
+		if physicalPort.HasTether() {
+			tether := physicalPort.GetTether()
+			mappedPhysicalPort["tether"] = []map[string]interface{}{{
+				"cross_connect_id":   tether.GetCrossConnectId(),
+				"cabinet_number":     tether.GetCabinetNumber(),
+				"system_name":        tether.GetSystemName(),
+				"patch_panel":        tether.GetPatchPanel(),
+				"patch_panel_port_a": tether.GetPatchPanelPortA(),
+				"patch_panel_port_b": tether.GetPatchPanelPortB(),
+				"ibx":                tether.GetIbx(),
+			}}
+		}
+		if physicalPort.HasDemarcationPoint() {
+			demarcationPoint := physicalPort.GetDemarcationPoint()
+			mappedPhysicalPort["demarcation_point"] = []map[string]interface{}{portDemarcationPointMap(&demarcationPoint)}
+		}
+		mappedPhysicalPorts[index] = mappedPhysicalPort
+	}
+	return mappedPhysicalPorts
+}
+
+func portNotificationsGoToTerraform(notifications []fabricv4.PortNotification) []map[string]interface{} {
+	mappedNotifications := make([]map[string]interface{}, len(notifications))
+	for index, notification := range notifications {
+		mappedNotifications[index] = map[string]interface{}{
+			"type":             string(notification.GetType()),
+			"registered_users": notification.GetRegisteredUsers(),
+		}
+	}
+	return mappedNotifications
+}
+
+func resourceFabricPortOrderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("physical_ports_count") {
+		return resourceFabricPortOrderRead(ctx, d, meta)
+	}
+
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	oldCount, newCount := d.GetChange("physical_ports_count")
+	added := newCount.(int) - oldCount.(int)
+	start := time.Now()
+	addToLagRequest := fabricv4.BulkPhysicalPort{}
+	addToLagRequest.SetData(newPhysicalPorts(d, added))
+	_, _, err := client.PortsApi.AddToLag(ctx, d.Id()).BulkPhysicalPort(addToLagRequest).Execute()
+	if err != nil {
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+
+	updateTimeout := d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
+	port, err := waitUntilPortIsActive(d.Id(), newCount.(int), meta, d, ctx, updateTimeout)
+	if err != nil {
+		return diag.Errorf("error waiting for physical ports to be added to Port (%s): %s", d.Id(), err)
+	}
+	return setFabricPortOrderMap(d, port)
+}
+
+func resourceFabricPortOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	start := time.Now()
+	_, resp, err := client.PortsApi.DeletePort(ctx, d.Id()).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return diags
+		}
+		return diag.FromErr(equinix_errors.FormatFabricError(err))
+	}
+
+	deleteTimeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second - time.Since(start)
+	if err = waitUntilPortIsDeprovisioned(d.Id(), meta, d, ctx, deleteTimeout); err != nil {
+		return diag.FromErr(fmt.Errorf("API call failed while waiting for resource deletion. Error %v", err))
+	}
+	return diags
+}
+
+// waitUntilPortIsActive waits for the port and the given number of physical
+// ports to finish provisioning
+func waitUntilPortIsActive(uuid string, physicalPortsCount int, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.Port, error) {
+	log.Printf("Waiting for Port to be provisioned, uuid %s", uuid)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.PORTSTATE_PENDING),
+			string(fabricv4.PORTSTATE_PROVISIONING),
+			string(fabricv4.PORTSTATE_REPROVISIONING),
+			string(fabricv4.PORTSTATE_PROVISIONED),
+			string(fabricv4.PORTSTATE_TO_BE_ADDED),
+			string(fabricv4.PORTSTATE_ADDED),
+		},
+		Target: []string{
+			string(fabricv4.PORTSTATE_ACTIVE),
+		},
+		Refresh: func() (interface{}, string, error) {
+			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+			port, _, err := client.PortsApi.GetPortByUuid(ctx, uuid).Execute()
+			if err != nil {
+				return "", "", equinix_errors.FormatFabricError(err)
+			}
+			state := port.GetState()
+			if state == fabricv4.PORTSTATE_ACTIVE && int(port.GetPhysicalPortsCount()) < physicalPortsCount {
+				// The port stays active while new LAG members are provisioned
+				return port, string(fabricv4.PORTSTATE_TO_BE_ADDED), nil
+			}
+			return port, string(state), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
+	var port *fabricv4.Port
+
+	if err == nil {
+		port = inter.(*fabricv4.Port)
+	}
+	return port, err
+}
+
+func waitUntilPortIsDeprovisioned(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) error {
+	log.Printf("Waiting for Port to be deprovisioned, uuid %s", uuid)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.PORTSTATE_ACTIVE),
+			string(fabricv4.PORTSTATE_TO_BE_DELETED),
+			string(fabricv4.PORTSTATE_DEPROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.PORTSTATE_DEPROVISIONED),
+			string(fabricv4.PORTSTATE_DELETED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+			port, resp, err := client.PortsApi.GetPortByUuid(ctx, uuid).Execute()
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return port, string(fabricv4.PORTSTATE_DELETED), nil
+				}
+				return "", "", equinix_errors.FormatFabricError(err)
+			}
+			return port, string(port.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
+	return err
+}
@@ -0,0 +1,172 @@
+package equinix
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPortOrderTerraformToGo(t *testing.T) {
+	account := portOrderAccountTerraformToGo([]interface{}{map[string]interface{}{"account_number": 123}})
+	if account.GetAccountNumber() != 123 {
+		t.Errorf("expected account number 123, got %d", account.GetAccountNumber())
+	}
+	if account := portOrderAccountTerraformToGo(nil); account.HasAccountNumber() {
+		t.Errorf("expected no account number, got %d", account.GetAccountNumber())
+	}
+
+	redundancy := portOrderRedundancyTerraformToGo([]interface{}{map[string]interface{}{"enabled": false, "priority": "PRIMARY"}})
+	if redundancy == nil || redundancy.GetEnabled() || redundancy.GetPriority() != fabricv4.PORTPRIORITY_PRIMARY {
+		t.Errorf("unexpected redundancy %+v", redundancy)
+	}
+	if redundancy := portOrderRedundancyTerraformToGo(nil); redundancy != nil {
+		t.Errorf("expected no redundancy, got %+v", redundancy)
+	}
+
+	encapsulation := portOrderEncapsulationTerraformToGo([]interface{}{map[string]interface{}{"type": "DOT1Q", "tag_protocol_id": ""}})
+	if encapsulation.GetType() != fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q || encapsulation.HasTagProtocolId() {
+		t.Errorf("unexpected encapsulation %+v", encapsulation)
+	}
+
+	demarcationPoint := portDemarcationPointTerraformToGo([]interface{}{map[string]interface{}{
+		"ibx":                     "SV1",
+		"cage_unique_space_id":    "SV1:01:000001",
+		"cabinet_unique_space_id": "",
+		"patch_panel":             "PP:0001",
+		"patch_panel_port_a":      "1",
+		"patch_panel_port_b":      "2",
+		"connector_type":          "SC",
+	}})
+	if demarcationPoint == nil || demarcationPoint.GetIbx() != "SV1" || demarcationPoint.HasCabinetUniqueSpaceId() || demarcationPoint.GetPatchPanelPortB() != "2" {
+		t.Errorf("unexpected demarcation point %+v", demarcationPoint)
+	}
+	if demarcationPoint := portDemarcationPointTerraformToGo(nil); demarcationPoint != nil {
+		t.Errorf("expected no demarcation point, got %+v", demarcationPoint)
+	}
+
+	order := portOrderTerraformToGo([]interface{}{map[string]interface{}{
+		"purchase_order_number": "PO-1",
+		"purchase_order_type":   "",
+		"customer_reference_id": "",
+	}})
+	purchaseOrder := order.GetPurchaseOrder()
+	if purchaseOrder.GetNumber() != "PO-1" || purchaseOrder.HasType() || order.HasCustomerReferenceId() {
+		t.Errorf("unexpected order %+v", order)
+	}
+	order = portOrderTerraformToGo([]interface{}{map[string]interface{}{
+		"purchase_order_number": "",
+		"purchase_order_type":   "",
+		"customer_reference_id": "ref",
+	}})
+	if order.HasPurchaseOrder() || order.GetCustomerReferenceId() != "ref" {
+		t.Errorf("unexpected order %+v", order)
+	}
+
+	notifications := portNotificationsTerraformToGo([]interface{}{map[string]interface{}{
+		"type":             "TECHNICAL",
+		"registered_users": []interface{}{"user"},
+	}})
+	if len(notifications) != 1 || notifications[0].GetType() != fabricv4.PORTNOTIFICATIONTYPE_TECHNICAL || !reflect.DeepEqual(notifications[0].GetRegisteredUsers(), []string{"user"}) {
+		t.Errorf("unexpected notifications %+v", notifications)
+	}
+}
+
+func TestPortOrderGoToTerraform(t *testing.T) {
+	purchaseOrder := fabricv4.PortOrderPurchaseOrder{}
+	purchaseOrder.SetNumber("PO-1")
+	order := fabricv4.PortOrder{}
+	order.SetPurchaseOrder(purchaseOrder)
+	order.SetOrderId("order-id")
+	mappedOrder := portOrderGoToTerraform(&order).List()[0].(map[string]interface{})
+	if mappedOrder["purchase_order_number"] != "PO-1" || mappedOrder["order_id"] != "order-id" || mappedOrder["purchase_order_type"] != "" {
+		t.Errorf("unexpected order %v", mappedOrder)
+	}
+	if portOrderGoToTerraform(nil) != nil {
+		t.Error("expected no order")
+	}
+
+	tether := fabricv4.PortTether{}
+	tether.SetCrossConnectId("cross-connect")
+	demarcationPoint := fabricv4.PortDemarcationPoint{}
+	demarcationPoint.SetIbx("SV1")
+	loa := fabricv4.PortLoa{}
+	loa.SetUuid("loa")
+	physicalPort := fabricv4.PhysicalPort{}
+	physicalPort.SetUuid("physical-port")
+	physicalPort.SetInterfaceSpeed(10000)
+	physicalPort.SetTether(tether)
+	physicalPort.SetDemarcationPoint(demarcationPoint)
+	physicalPort.SetLoas([]fabricv4.PortLoa{loa})
+
+	mappedPhysicalPorts := physicalPortsGoToTerraform([]fabricv4.PhysicalPort{physicalPort, {}})
+	if len(mappedPhysicalPorts) != 2 {
+		t.Fatalf("expected 2 physical ports, got %v", mappedPhysicalPorts)
+	}
+	mappedPhysicalPort := mappedPhysicalPorts[0]
+	if mappedPhysicalPort["uuid"] != "physical-port" || mappedPhysicalPort["interface_speed"] != 10000 {
+		t.Errorf("unexpected physical port %v", mappedPhysicalPort)
+	}
+	if tether := mappedPhysicalPort["tether"].([]map[string]interface{}); tether[0]["cross_connect_id"] != "cross-connect" {
+		t.Errorf("unexpected tether %v", tether)
+	}
+	if demarcationPoint := mappedPhysicalPort["demarcation_point"].([]map[string]interface{}); demarcationPoint[0]["ibx"] != "SV1" {
+		t.Errorf("unexpected demarcation point %v", demarcationPoint)
+	}
+	if loas := mappedPhysicalPort["loas"].([]map[string]interface{}); loas[0]["uuid"] != "loa" {
+		t.Errorf("unexpected loas %v", loas)
+	}
+	if _, ok := mappedPhysicalPorts[1]["tether"]; ok {
+		t.Errorf("expected no tether without one in the response, got %v", mappedPhysicalPorts[1])
+	}
+
+	notification := fabricv4.PortNotification{Type: fabricv4.PORTNOTIFICATIONTYPE_TECHNICAL, RegisteredUsers: []string{"user"}}
+	mappedNotifications := portNotificationsGoToTerraform([]fabricv4.PortNotification{notification})
+	if mappedNotifications[0]["type"] != "TECHNICAL" || !reflect.DeepEqual(mappedNotifications[0]["registered_users"], []string{"user"}) {
+		t.Errorf("unexpected notifications %v", mappedNotifications)
+	}
+}
+
+func TestPortOrderCustomizeDiff_addToLag(t *testing.T) {
+	ctx := context.Background()
+	r := resourceFabricPortOrder()
+	portConfig := func(lagEnabled bool, physicalPortsCount int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"type":                     "XF_PORT",
+			"connectivity_source_type": "COLO",
+			"physical_ports_type":      "1000BASE_LX",
+			"physical_ports_speed":     1000,
+			"physical_ports_count":     physicalPortsCount,
+			"lag_enabled":              lagEnabled,
+			"location":                 []interface{}{map[string]interface{}{"metro_code": "SV"}},
+			"encapsulation":            []interface{}{map[string]interface{}{"type": "DOT1Q"}},
+		})
+	}
+	portState := func(lagEnabled bool) *terraform.InstanceState {
+		diff, err := r.Diff(ctx, nil, portConfig(lagEnabled, 1), nil)
+		if err != nil {
+			t.Fatalf("unexpected error planning the port: %v", err)
+		}
+		state := &terraform.InstanceState{ID: "port", Attributes: map[string]string{}}
+		for key, attribute := range diff.Attributes {
+			state.Attributes[key] = attribute.New
+		}
+		return state
+	}
+
+	if _, err := r.Diff(ctx, portState(true), portConfig(true, 2), nil); err != nil {
+		t.Errorf("unexpected error adding physical ports to a LAG port: %v", err)
+	}
+
+	_, err := r.Diff(ctx, portState(false), portConfig(false, 2), nil)
+	if err == nil || !strings.Contains(err.Error(), "lag_enabled = true") {
+		t.Errorf("expected an error adding physical ports to a port without LAG, got %v", err)
+	}
+
+	if _, err := r.Diff(ctx, portState(false), portConfig(true, 2), nil); err != nil {
+		t.Errorf("unexpected error replacing the port with a LAG port: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/equinix/terraform-provider-equinix/internal/converters"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/serviceprofile"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
@@ -529,6 +531,22 @@ func createLinkProtocolConfigSch() map[string]*schema.Schema {
 	}
 }
 
+func resourceFabricServiceProfileSchema() map[string]*schema.Schema {
+	sch := fabricServiceProfileSchema()
+	sch["etag"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Version of the service profile when it was last read. Updates are rejected if the service profile was modified outside Terraform since then",
+	}
+	sch["force_overwrite"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Overwrite changes made to the service profile outside Terraform since it was last read instead of failing the update",
+	}
+	return sch
+}
+
 func resourceFabricServiceProfile() *schema.Resource {
 	return &schema.Resource{
 		Timeouts: &schema.ResourceTimeout{
@@ -544,7 +562,7 @@ func resourceFabricServiceProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: fabricServiceProfileSchema(),
+		Schema: resourceFabricServiceProfileSchema(),
 		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Service Profile
 
 Additional documentation:
@@ -555,7 +573,7 @@ Additional documentation:
 
 func resourceFabricServiceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	serviceProfile, _, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, d.Id()).Execute()
+	serviceProfile, res, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, d.Id()).Execute()
 	if err != nil {
 		if !strings.Contains(err.Error(), "500") {
 			d.SetId("")
@@ -563,6 +581,9 @@ func resourceFabricServiceProfileRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
 	}
 	d.SetId(serviceProfile.GetUuid())
+	if err = d.Set("etag", serviceprofile.ETag(res)); err != nil {
+		return diag.FromErr(err)
+	}
 	return setFabricServiceProfileMap(d, serviceProfile)
 }
 
@@ -662,14 +683,22 @@ func resourceFabricServiceProfileUpdate(ctx context.Context, d *schema.ResourceD
 		}
 		return diag.Errorf("Either timed out or errored out while fetching service profile for uuid %s and error %v", uuid, err)
 	}
-	_, _, err = client.ServiceProfilesApi.PutServiceProfileByUuid(ctx, uuid).IfMatch(strconv.FormatInt(eTag, 10)).ServiceProfileRequest(updateRequest).Execute()
+	ifMatch, modified := serviceProfileIfMatch(d.Get("etag").(string), strconv.FormatInt(eTag, 10), d.Get("force_overwrite").(bool))
+	if modified {
+		return serviceProfileModifiedDiag(d, uuid)
+	}
+	_, res, err := client.ServiceProfilesApi.PutServiceProfileByUuid(ctx, uuid).IfMatch(ifMatch).ServiceProfileRequest(updateRequest).Execute()
 	if err != nil {
+		if res != nil && res.StatusCode == http.StatusPreconditionFailed {
+			return serviceProfileModifiedDiag(d, uuid)
+		}
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
 	}
 
 	updateTimeout = d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
 	var updatedServiceProfile *fabricv4.ServiceProfile
-	updatedServiceProfile, err = waitForServiceProfileUpdateCompletion(uuid, meta, d, ctx, updateTimeout)
+	var updatedETag string
+	updatedServiceProfile, updatedETag, err = waitForServiceProfileUpdateCompletion(uuid, meta, d, ctx, updateTimeout)
 	if err != nil {
 		if !strings.Contains(err.Error(), "500") {
 			d.SetId("")
@@ -677,34 +706,63 @@ func resourceFabricServiceProfileUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("errored while waiting for successful service profile update, error %v", err))
 	}
 	d.SetId(updatedServiceProfile.GetUuid())
+	if err = d.Set("etag", updatedETag); err != nil {
+		return diag.FromErr(err)
+	}
 	return setFabricServiceProfileMap(d, updatedServiceProfile)
 }
 
-func waitForServiceProfileUpdateCompletion(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.ServiceProfile, error) {
+// serviceProfileIfMatch returns the ETag to send in the If-Match header of an
+// update, and whether the service profile was modified outside Terraform since
+// stateETag was read. Unless forced, the update is conditional on stateETag so a
+// concurrent edit is rejected instead of silently overwritten. Resources read
+// before the ETag was tracked have no stateETag and use the current one
+func serviceProfileIfMatch(stateETag, currentETag string, forceOverwrite bool) (string, bool) {
+	if forceOverwrite || stateETag == "" {
+		return currentETag, false
+	}
+	return stateETag, stateETag != currentETag
+}
+
+func serviceProfileModifiedDiag(d *schema.ResourceData, uuid string) diag.Diagnostics {
+	// Keep the prior state, the planned values were not applied
+	d.Partial(true)
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Service profile modified outside Terraform",
+			Detail: fmt.Sprintf("Service profile %s was modified outside Terraform since it was last read, so the update was not applied. "+
+				"Refresh the state (terraform apply -refresh-only) and review the plan again, or set force_overwrite = true to overwrite the changes.", uuid),
+		},
+	}
+}
+
+func waitForServiceProfileUpdateCompletion(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.ServiceProfile, string, error) {
 	log.Printf("Waiting for service profile update to complete, uuid %s", uuid)
+	var eTag string
 	stateConf := &retry.StateChangeConf{
 		Target: []string{"COMPLETED"},
 		Refresh: func() (interface{}, string, error) {
 			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-			dbServiceProfile, _, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, uuid).Execute()
+			dbServiceProfile, res, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, uuid).Execute()
 			if err != nil {
 				return "", "", equinix_errors.FormatFabricError(err)
 			}
+			eTag = serviceprofile.ETag(res)
 			updatableState := "COMPLETED"
 			return dbServiceProfile, updatableState, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbSp *fabricv4.ServiceProfile
 
 	if err == nil {
 		dbSp = inter.(*fabricv4.ServiceProfile)
 	}
-	return dbSp, err
+	return dbSp, eTag, err
 }
 
 func waitForActiveServiceProfileAndPopulateETag(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.ServiceProfile, error, int64) {
@@ -719,8 +777,7 @@ func waitForActiveServiceProfileAndPopulateETag(uuid string, meta interface{}, d
 				return nil, "", equinix_errors.FormatFabricError(err)
 			}
 
-			eTagStr := res.Header.Get("ETag")
-			eTag, err = strconv.ParseInt(strings.Trim(eTagStr, "\""), 10, 64)
+			eTag, err = strconv.ParseInt(serviceprofile.ETag(res), 10, 64)
 			if err != nil {
 				return nil, "", err
 			}
@@ -731,11 +788,10 @@ func waitForActiveServiceProfileAndPopulateETag(uuid string, meta interface{}, d
 			}
 			return dbServiceProfile, updatableState, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbServiceProfile *fabricv4.ServiceProfile
 	if err == nil {
 		dbServiceProfile = inter.(*fabricv4.ServiceProfile)
@@ -782,11 +838,10 @@ func WaitAndCheckServiceProfileDeleted(uuid string, meta interface{}, d *schema.
 			}
 			return dbConn, updatableState, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -67,6 +67,7 @@ func TestAccFabricCreateServiceProfile_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "access_point_type_configs.0.enable_auto_generate_service_key"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "access_point_type_configs.0.connection_redundancy_required"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "self_profile"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "etag"),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -98,6 +99,7 @@ func TestAccFabricCreateServiceProfile_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "access_point_type_configs.0.enable_auto_generate_service_key"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "access_point_type_configs.0.connection_redundancy_required"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "self_profile"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile.test", "etag"),
 				),
 				ExpectNonEmptyPlan: true,
 			},
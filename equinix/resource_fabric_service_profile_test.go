@@ -0,0 +1,49 @@
+package equinix
+
+import (
+	"testing"
+)
+
+func TestServiceProfileIfMatch(t *testing.T) {
+	tests := map[string]struct {
+		stateETag      string
+		currentETag    string
+		forceOverwrite bool
+		wantIfMatch    string
+		wantModified   bool
+	}{
+		"unchanged": {
+			stateETag:   "3",
+			currentETag: "3",
+			wantIfMatch: "3",
+		},
+		"modified outside terraform": {
+			stateETag:    "3",
+			currentETag:  "4",
+			wantIfMatch:  "3",
+			wantModified: true,
+		},
+		"force overwrite": {
+			stateETag:      "3",
+			currentETag:    "4",
+			forceOverwrite: true,
+			wantIfMatch:    "4",
+		},
+		"no etag in state": {
+			currentETag: "4",
+			wantIfMatch: "4",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ifMatch, modified := serviceProfileIfMatch(tc.stateETag, tc.currentETag, tc.forceOverwrite)
+			if ifMatch != tc.wantIfMatch {
+				t.Errorf("expected If-Match %q, got %q", tc.wantIfMatch, ifMatch)
+			}
+			if modified != tc.wantModified {
+				t.Errorf("expected modified %v, got %v", tc.wantModified, modified)
+			}
+		})
+	}
+}
@@ -309,13 +309,12 @@ func resourceMetalReservedIPBlockCreate(ctx context.Context, d *schema.ResourceD
 		target = append(target, wfs)
 	}
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{string(packngo.IPReservationStatePending)},
-		Target:     target,
-		Refresh:    reservedIPStateRefreshFunc(client, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start),
-		MinTimeout: 15 * time.Second,
+		Pending: []string{string(packngo.IPReservationStatePending)},
+		Target:  target,
+		Refresh: reservedIPStateRefreshFunc(client, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start),
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf); err != nil {
 		return diag.Errorf("error waiting for IP Reservation (%s) to become %s: %s", d.Id(), wfs, err)
 	}
 
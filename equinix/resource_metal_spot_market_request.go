@@ -351,12 +351,11 @@ func resourceMetalSpotMarketRequestCreate(ctx context.Context, d *schema.Resourc
 			Target:         []string{"done"},
 			Refresh:        resourceStateRefreshFunc(d, meta),
 			Timeout:        d.Timeout(schema.TimeoutCreate) - time.Since(start) - time.Second*10, // reduce 30s to avoid context deadline
-			MinTimeout:     5 * time.Second,
-			Delay:          3 * time.Second, // Wait 10 secs before starting
-			NotFoundChecks: 600,             // Setting high number, to support long timeouts
+			Delay:          3 * time.Second,                                                      // Wait 10 secs before starting
+			NotFoundChecks: 600,                                                                  // Setting high number, to support long timeouts
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -426,12 +425,11 @@ func resourceMetalSpotMarketRequestDelete(ctx context.Context, d *schema.Resourc
 			Target:         []string{"done"},
 			Refresh:        resourceStateRefreshFunc(d, meta),
 			Timeout:        d.Timeout(schema.TimeoutDelete) - 30*time.Second,
-			MinTimeout:     5 * time.Second,
 			Delay:          3 * time.Second, // Wait 10 secs before starting
 			NotFoundChecks: 600,             // Setting high number, to support long timeouts
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -145,7 +145,7 @@ func resourceNetworkBGPCreate(ctx context.Context, d *schema.ResourceData, m int
 		}
 		d.SetId(ne.StringValue(uuid))
 	}
-	if _, err := createBGPConfigStatusProvisioningWaitConfiguration(client.GetBGPConfiguration, d.Id(), 2*time.Second, d.Timeout(schema.TimeoutCreate)).WaitForStateContext(ctx); err != nil {
+	if _, err := m.(*config.Config).WaitForStateContext(ctx, createBGPConfigStatusProvisioningWaitConfiguration(client.GetBGPConfiguration, d.Id(), d.Timeout(schema.TimeoutCreate))); err != nil {
 		return diag.Errorf("error waiting for BGP configuration (%s) to be created: %s", d.Id(), err)
 	}
 	diags = append(diags, resourceNetworkBGPRead(ctx, d, m)...)
@@ -257,7 +257,7 @@ func createNetworkBGPUpdateRequest(requestFunc bgpUpdateRequest, bgp *ne.BGPConf
 
 type getBGPConfig func(uuid string) (*ne.BGPConfiguration, error)
 
-func createBGPConfigStatusProvisioningWaitConfiguration(fetchFunc getBGPConfig, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createBGPConfigStatusProvisioningWaitConfiguration(fetchFunc getBGPConfig, id string, timeout time.Duration) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
 		Pending: []string{
 			ne.BGPProvisioningStatusProvisioning,
@@ -266,9 +266,8 @@ func createBGPConfigStatusProvisioningWaitConfiguration(fetchFunc getBGPConfig,
 		Target: []string{
 			ne.BGPProvisioningStatusProvisioned,
 		},
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(id)
 			if err != nil {
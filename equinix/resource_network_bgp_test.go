@@ -132,14 +132,12 @@ func TestNetworkBGP_statusProvisioningWaitConfiguration(t *testing.T) {
 		queriedID = uuid
 		return &ne.BGPConfiguration{ProvisioningStatus: ne.String(ne.BGPProvisioningStatusProvisioned)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createBGPConfigStatusProvisioningWaitConfiguration(fetchFunc, bgpID, delay, timeout)
+	waitConfig := createBGPConfigStatusProvisioningWaitConfiguration(fetchFunc, bgpID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, bgpID, queriedID, "Queried device ID matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
 }
@@ -1082,30 +1082,30 @@ func resourceNetworkDeviceCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 	d.SetId(ne.StringValue(primary.UUID))
 	waitConfigs := []*retry.StateChangeConf{
-		createNetworkDeviceStatusProvisioningWaitConfiguration(client.GetDevice, ne.StringValue(primary.UUID), 5*time.Second, d.Timeout(schema.TimeoutCreate)),
-		createNetworkDeviceLicenseStatusWaitConfiguration(client.GetDevice, ne.StringValue(primary.UUID), 5*time.Second, d.Timeout(schema.TimeoutCreate)),
+		createNetworkDeviceStatusProvisioningWaitConfiguration(client.GetDevice, ne.StringValue(primary.UUID), d.Timeout(schema.TimeoutCreate)),
+		createNetworkDeviceLicenseStatusWaitConfiguration(client.GetDevice, ne.StringValue(primary.UUID), d.Timeout(schema.TimeoutCreate)),
 	}
 	if ne.StringValue(primary.ACLTemplateUUID) != "" || ne.StringValue(primary.MgmtAclTemplateUuid) != "" {
 		waitConfigs = append(waitConfigs,
-			createNetworkDeviceACLStatusWaitConfiguration(client.GetDeviceACLDetails, ne.StringValue(primary.UUID), 1*time.Second, d.Timeout(schema.TimeoutUpdate)),
+			createNetworkDeviceACLStatusWaitConfiguration(client.GetDeviceACLDetails, ne.StringValue(primary.UUID), d.Timeout(schema.TimeoutUpdate)),
 		)
 	}
 	if secondary != nil {
 		waitConfigs = append(waitConfigs,
-			createNetworkDeviceStatusProvisioningWaitConfiguration(client.GetDevice, ne.StringValue(secondary.UUID), 5*time.Second, d.Timeout(schema.TimeoutCreate)),
-			createNetworkDeviceLicenseStatusWaitConfiguration(client.GetDevice, ne.StringValue(secondary.UUID), 5*time.Second, d.Timeout(schema.TimeoutCreate)),
+			createNetworkDeviceStatusProvisioningWaitConfiguration(client.GetDevice, ne.StringValue(secondary.UUID), d.Timeout(schema.TimeoutCreate)),
+			createNetworkDeviceLicenseStatusWaitConfiguration(client.GetDevice, ne.StringValue(secondary.UUID), d.Timeout(schema.TimeoutCreate)),
 		)
 		if ne.StringValue(secondary.ACLTemplateUUID) != "" || ne.StringValue(secondary.MgmtAclTemplateUuid) != "" {
 			waitConfigs = append(waitConfigs,
-				createNetworkDeviceACLStatusWaitConfiguration(client.GetDeviceACLDetails, ne.StringValue(secondary.UUID), 1*time.Second, d.Timeout(schema.TimeoutUpdate)),
+				createNetworkDeviceACLStatusWaitConfiguration(client.GetDeviceACLDetails, ne.StringValue(secondary.UUID), d.Timeout(schema.TimeoutUpdate)),
 			)
 		}
 	}
-	for _, config := range waitConfigs {
-		if config == nil {
+	for _, waitConfig := range waitConfigs {
+		if waitConfig == nil {
 			continue
 		}
-		if _, err := config.WaitForStateContext(ctx); err != nil {
+		if _, err := m.(*config.Config).WaitForStateContext(ctx, waitConfig); err != nil {
 			return diag.Errorf("error waiting for network device (%s) to be created: %s", ne.StringValue(primary.UUID), err)
 		}
 	}
@@ -1180,13 +1180,13 @@ func resourceNetworkDeviceUpdate(ctx context.Context, d *schema.ResourceData, m
 			}
 
 			waitConfigs := []*retry.StateChangeConf{
-				createNetworkDeviceStatusDeleteWaitConfiguration(client.GetDevice, v.(string), 5*time.Second, d.Timeout(schema.TimeoutDelete)),
+				createNetworkDeviceStatusDeleteWaitConfiguration(client.GetDevice, v.(string), d.Timeout(schema.TimeoutDelete)),
 			}
-			for _, config := range waitConfigs {
-				if config == nil {
+			for _, waitConfig := range waitConfigs {
+				if waitConfig == nil {
 					continue
 				}
-				if _, err := config.WaitForStateContext(ctx); err != nil {
+				if _, err := m.(*config.Config).WaitForStateContext(ctx, waitConfig); err != nil {
 					return diag.Errorf("error waiting for network device (%s) to be deleted: %s", v.(string), err)
 				}
 			}
@@ -1201,12 +1201,12 @@ func resourceNetworkDeviceUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	for _, stateChangeConf := range getNetworkDeviceStateChangeConfigs(client, d.Id(), d.Timeout(schema.TimeoutUpdate), primaryChanges) {
-		if _, err := stateChangeConf.WaitForStateContext(ctx); err != nil {
+		if _, err := m.(*config.Config).WaitForStateContext(ctx, stateChangeConf); err != nil {
 			return diag.Errorf("error waiting for network device %q to be updated: %s", d.Id(), err)
 		}
 	}
 	for _, stateChangeConf := range getNetworkDeviceStateChangeConfigs(client, d.Get(neDeviceSchemaNames["RedundantUUID"]).(string), d.Timeout(schema.TimeoutUpdate), secondaryChanges) {
-		if _, err := stateChangeConf.WaitForStateContext(ctx); err != nil {
+		if _, err := m.(*config.Config).WaitForStateContext(ctx, stateChangeConf); err != nil {
 			return diag.Errorf("error waiting for network device %q to be updated: %s", d.Get(neDeviceSchemaNames["RedundantUUID"]), err)
 		}
 	}
@@ -1223,13 +1223,13 @@ func resourceNetworkDeviceUpdate(ctx context.Context, d *schema.ResourceData, m
 				return diag.FromErr(err)
 			}
 			waitConfigs := []*retry.StateChangeConf{
-				createNetworkDeviceStatusProvisioningWaitConfiguration(client.GetDevice, ne.StringValue(secondaryUUID), 5*time.Second, d.Timeout(schema.TimeoutCreate)),
+				createNetworkDeviceStatusProvisioningWaitConfiguration(client.GetDevice, ne.StringValue(secondaryUUID), d.Timeout(schema.TimeoutCreate)),
 			}
-			for _, config := range waitConfigs {
-				if config == nil {
+			for _, waitConfig := range waitConfigs {
+				if waitConfig == nil {
 					continue
 				}
-				if _, err := config.WaitForStateContext(ctx); err != nil {
+				if _, err := m.(*config.Config).WaitForStateContext(ctx, waitConfig); err != nil {
 					return diag.Errorf("error waiting for network device (%s) to be created: %s", ne.StringValue(secondaryUUID), err)
 				}
 			}
@@ -1245,12 +1245,12 @@ func resourceNetworkDeviceDelete(ctx context.Context, d *schema.ResourceData, m
 	m.(*config.Config).AddModuleToNEUserAgent(&client, d)
 	var diags diag.Diagnostics
 	waitConfigs := []*retry.StateChangeConf{
-		createNetworkDeviceStatusDeleteWaitConfiguration(client.GetDevice, d.Id(), 5*time.Second, d.Timeout(schema.TimeoutDelete)),
+		createNetworkDeviceStatusDeleteWaitConfiguration(client.GetDevice, d.Id(), d.Timeout(schema.TimeoutDelete)),
 	}
 	if v, ok := d.GetOk(neDeviceSchemaNames["Secondary"]); ok {
 		if secondary := expandNetworkDeviceSecondary(v.([]interface{})); secondary != nil {
 			waitConfigs = append(waitConfigs,
-				createNetworkDeviceStatusDeleteWaitConfiguration(client.GetDevice, ne.StringValue(secondary.UUID), 5*time.Second, d.Timeout(schema.TimeoutDelete)),
+				createNetworkDeviceStatusDeleteWaitConfiguration(client.GetDevice, ne.StringValue(secondary.UUID), d.Timeout(schema.TimeoutDelete)),
 			)
 		}
 	}
@@ -1264,8 +1264,8 @@ func resourceNetworkDeviceDelete(ctx context.Context, d *schema.ResourceData, m
 		}
 		return diag.FromErr(err)
 	}
-	for _, config := range waitConfigs {
-		if _, err := config.WaitForStateContext(ctx); err != nil {
+	for _, waitConfig := range waitConfigs {
+		if _, err := m.(*config.Config).WaitForStateContext(ctx, waitConfig); err != nil {
 			return diag.Errorf("error waiting for network device (%s) to be removed: %s", d.Id(), err)
 		}
 	}
@@ -1868,25 +1868,25 @@ func getNetworkDeviceStateChangeConfigs(c ne.Client, deviceID string, timeout ti
 		aclTemplateUUID, ok := changeValue.(string)
 		if ok && aclTemplateUUID != "" {
 			configs = append(configs,
-				createNetworkDeviceACLStatusWaitConfiguration(c.GetDeviceACLDetails, deviceID, 1*time.Second, timeout),
+				createNetworkDeviceACLStatusWaitConfiguration(c.GetDeviceACLDetails, deviceID, timeout),
 			)
 		}
 	} else if changeValue, found := changes[neDeviceSchemaNames["MgmtAclTemplateUuid"]]; found {
 		mgmtACLTemplateUUID, ok := changeValue.(string)
 		if ok && mgmtACLTemplateUUID != "" {
 			configs = append(configs,
-				createNetworkDeviceACLStatusWaitConfiguration(c.GetDeviceACLDetails, deviceID, 1*time.Second, timeout),
+				createNetworkDeviceACLStatusWaitConfiguration(c.GetDeviceACLDetails, deviceID, timeout),
 			)
 		}
 	}
 	if _, found := changes[neDeviceSchemaNames["AdditionalBandwidth"]]; found {
 		configs = append(configs,
-			createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(c.GetDeviceAdditionalBandwidthDetails, deviceID, 1*time.Second, timeout),
+			createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(c.GetDeviceAdditionalBandwidthDetails, deviceID, timeout),
 		)
 	}
 	if _, found := changes[neDeviceSchemaNames["CoreCount"]]; found {
 		configs = append(configs,
-			createNetworkDeviceStatusResourceUpgradeWaitConfiguration(c.GetDevice, deviceID, 5*time.Second, timeout),
+			createNetworkDeviceStatusResourceUpgradeWaitConfiguration(c.GetDevice, deviceID, timeout),
 		)
 	}
 	return configs
@@ -1925,7 +1925,7 @@ type (
 	getAdditionalBandwidthDetails func(uuid string) (*ne.DeviceAdditionalBandwidthDetails, error)
 )
 
-func createNetworkDeviceStatusProvisioningWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createNetworkDeviceStatusProvisioningWaitConfiguration(fetchFunc getDevice, id string, timeout time.Duration) *retry.StateChangeConf {
 	pending := []string{
 		ne.DeviceStateInitializing,
 		ne.DeviceStateProvisioning,
@@ -1936,20 +1936,20 @@ func createNetworkDeviceStatusProvisioningWaitConfiguration(fetchFunc getDevice,
 	target := []string{
 		ne.DeviceStateProvisioned,
 	}
-	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, delay, timeout, target, pending)
+	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, timeout, target, pending)
 }
 
-func createNetworkDeviceStatusDeleteWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createNetworkDeviceStatusDeleteWaitConfiguration(fetchFunc getDevice, id string, timeout time.Duration) *retry.StateChangeConf {
 	pending := []string{
 		ne.DeviceStateDeprovisioning,
 	}
 	target := []string{
 		ne.DeviceStateDeprovisioned,
 	}
-	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, delay, timeout, target, pending)
+	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, timeout, target, pending)
 }
 
-func createNetworkDeviceStatusResourceUpgradeWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createNetworkDeviceStatusResourceUpgradeWaitConfiguration(fetchFunc getDevice, id string, timeout time.Duration) *retry.StateChangeConf {
 	pending := []string{
 		ne.DeviceStateResourceUpgradeInProgress,
 		ne.DeviceStateWaitingPrimary,
@@ -1959,16 +1959,15 @@ func createNetworkDeviceStatusResourceUpgradeWaitConfiguration(fetchFunc getDevi
 	target := []string{
 		ne.DeviceStateProvisioned,
 	}
-	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, delay, timeout, target, pending)
+	return createNetworkDeviceStatusWaitConfiguration(fetchFunc, id, timeout, target, pending)
 }
 
-func createNetworkDeviceStatusWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration, target []string, pending []string) *retry.StateChangeConf {
+func createNetworkDeviceStatusWaitConfiguration(fetchFunc getDevice, id string, timeout time.Duration, target []string, pending []string) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
-		Pending:    pending,
-		Target:     target,
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Pending: pending,
+		Target:  target,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(id)
 			if err != nil {
@@ -1979,7 +1978,7 @@ func createNetworkDeviceStatusWaitConfiguration(fetchFunc getDevice, id string,
 	}
 }
 
-func createNetworkDeviceLicenseStatusWaitConfiguration(fetchFunc getDevice, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createNetworkDeviceLicenseStatusWaitConfiguration(fetchFunc getDevice, id string, timeout time.Duration) *retry.StateChangeConf {
 	pending := []string{
 		ne.DeviceLicenseStateApplying,
 		ne.DeviceLicenseStateWaitingClusterSetUp,
@@ -1991,11 +1990,10 @@ func createNetworkDeviceLicenseStatusWaitConfiguration(fetchFunc getDevice, id s
 		ne.DeviceLicenseStateNA,
 	}
 	return &retry.StateChangeConf{
-		Pending:    pending,
-		Target:     target,
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Pending: pending,
+		Target:  target,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(id)
 			if err != nil {
@@ -2006,7 +2004,7 @@ func createNetworkDeviceLicenseStatusWaitConfiguration(fetchFunc getDevice, id s
 	}
 }
 
-func createNetworkDeviceACLStatusWaitConfiguration(fetchFunc getACL, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createNetworkDeviceACLStatusWaitConfiguration(fetchFunc getACL, id string, timeout time.Duration) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
 		Pending: []string{
 			ne.ACLDeviceStatusProvisioning,
@@ -2014,9 +2012,8 @@ func createNetworkDeviceACLStatusWaitConfiguration(fetchFunc getACL, id string,
 		Target: []string{
 			ne.ACLDeviceStatusProvisioned,
 		},
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(id)
 			if err != nil {
@@ -2027,7 +2024,7 @@ func createNetworkDeviceACLStatusWaitConfiguration(fetchFunc getACL, id string,
 	}
 }
 
-func createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(fetchFunc getAdditionalBandwidthDetails, deviceID string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(fetchFunc getAdditionalBandwidthDetails, deviceID string, timeout time.Duration) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
 		Pending: []string{
 			ne.DeviceAdditionalBandwidthStatusProvisioning,
@@ -2035,9 +2032,8 @@ func createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(fetchFunc get
 		Target: []string{
 			ne.DeviceAdditionalBandwidthStatusProvisioned,
 		},
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(deviceID)
 			if err != nil {
@@ -313,7 +313,7 @@ func resourceNetworkDeviceLinkCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	d.SetId(ne.StringValue(uuid))
-	if _, err := createDeviceLinkStatusProvisioningWaitConfiguration(client.GetDeviceLinkGroup, d.Id(), 2*time.Second, d.Timeout(schema.TimeoutCreate)).WaitForStateContext(ctx); err != nil {
+	if _, err := m.(*config.Config).WaitForStateContext(ctx, createDeviceLinkStatusProvisioningWaitConfiguration(client.GetDeviceLinkGroup, d.Id(), d.Timeout(schema.TimeoutCreate))); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity:      diag.Error,
 			Summary:       "Failed to wait for device link to become provisioned",
@@ -380,7 +380,7 @@ func resourceNetworkDeviceLinkUpdate(ctx context.Context, d *schema.ResourceData
 	if err := updateReq.Execute(); err != nil {
 		return diag.FromErr(err)
 	}
-	if _, err := createDeviceLinkStatusProvisioningWaitConfiguration(client.GetDeviceLinkGroup, d.Id(), 2*time.Second, d.Timeout(schema.TimeoutCreate)).WaitForStateContext(ctx); err != nil {
+	if _, err := m.(*config.Config).WaitForStateContext(ctx, createDeviceLinkStatusProvisioningWaitConfiguration(client.GetDeviceLinkGroup, d.Id(), d.Timeout(schema.TimeoutCreate))); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity:      diag.Error,
 			Summary:       "Failed to wait for device link to become provisioned",
@@ -402,7 +402,7 @@ func resourceNetworkDeviceLinkDelete(ctx context.Context, d *schema.ResourceData
 		}
 		return diag.FromErr(err)
 	}
-	if _, err := createDeviceLinkStatusDeleteWaitConfiguration(client.GetDeviceLinkGroup, d.Id(), 2*time.Second, d.Timeout(schema.TimeoutDelete)).WaitForStateContext(ctx); err != nil {
+	if _, err := m.(*config.Config).WaitForStateContext(ctx, createDeviceLinkStatusDeleteWaitConfiguration(client.GetDeviceLinkGroup, d.Id(), d.Timeout(schema.TimeoutDelete))); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity:      diag.Error,
 			Summary:       "Failed to wait for device link to become deprovisioned",
@@ -575,7 +575,7 @@ func flattenNetworkDeviceLinkMetroLinks(currentConnections *schema.Set, connecti
 
 type getDeviceLinkGroup func(uuid string) (*ne.DeviceLinkGroup, error)
 
-func createDeviceLinkStatusProvisioningWaitConfiguration(fetchFunc getDeviceLinkGroup, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createDeviceLinkStatusProvisioningWaitConfiguration(fetchFunc getDeviceLinkGroup, id string, timeout time.Duration) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
 		Pending: []string{
 			ne.DeviceLinkGroupStatusProvisioning,
@@ -583,9 +583,8 @@ func createDeviceLinkStatusProvisioningWaitConfiguration(fetchFunc getDeviceLink
 		Target: []string{
 			ne.DeviceLinkGroupStatusProvisioned,
 		},
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(id)
 			if err != nil {
@@ -596,7 +595,7 @@ func createDeviceLinkStatusProvisioningWaitConfiguration(fetchFunc getDeviceLink
 	}
 }
 
-func createDeviceLinkStatusDeleteWaitConfiguration(fetchFunc getDeviceLinkGroup, id string, delay time.Duration, timeout time.Duration) *retry.StateChangeConf {
+func createDeviceLinkStatusDeleteWaitConfiguration(fetchFunc getDeviceLinkGroup, id string, timeout time.Duration) *retry.StateChangeConf {
 	return &retry.StateChangeConf{
 		Pending: []string{
 			ne.DeviceLinkGroupStatusDeprovisioning,
@@ -604,9 +603,8 @@ func createDeviceLinkStatusDeleteWaitConfiguration(fetchFunc getDeviceLinkGroup,
 		Target: []string{
 			ne.DeviceLinkGroupStatusDeprovisioned,
 		},
-		Timeout:    timeout,
-		Delay:      0,
-		MinTimeout: delay,
+		Timeout: timeout,
+		Delay:   0,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := fetchFunc(id)
 			if err != nil {
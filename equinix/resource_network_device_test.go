@@ -365,16 +365,14 @@ func TestNetworkDevice_statusProvisioningWaitConfiguration(t *testing.T) {
 		queriedDeviceID = uuid
 		return &ne.Device{Status: ne.String(ne.DeviceStateProvisioned)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createNetworkDeviceStatusProvisioningWaitConfiguration(fetchFunc, deviceID, delay, timeout)
+	waitConfig := createNetworkDeviceStatusProvisioningWaitConfiguration(fetchFunc, deviceID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, deviceID, queriedDeviceID, "Queried device ID matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
 }
 
 func TestNetworkDevice_statusDeleteWaitConfiguration(t *testing.T) {
@@ -385,16 +383,14 @@ func TestNetworkDevice_statusDeleteWaitConfiguration(t *testing.T) {
 		queriedDeviceID = uuid
 		return &ne.Device{Status: ne.String(ne.DeviceStateDeprovisioned)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createNetworkDeviceStatusDeleteWaitConfiguration(fetchFunc, deviceID, delay, timeout)
+	waitConfig := createNetworkDeviceStatusDeleteWaitConfiguration(fetchFunc, deviceID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, deviceID, queriedDeviceID, "Queried device ID matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
 }
 
 func TestNetworkDevice_statusResourceUpgradeWaitConfiguration(t *testing.T) {
@@ -405,16 +401,14 @@ func TestNetworkDevice_statusResourceUpgradeWaitConfiguration(t *testing.T) {
 		queriedDeviceID = uuid
 		return &ne.Device{Status: ne.String(ne.DeviceStateProvisioned)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createNetworkDeviceStatusResourceUpgradeWaitConfiguration(fetchFunc, deviceID, delay, timeout)
+	waitConfig := createNetworkDeviceStatusResourceUpgradeWaitConfiguration(fetchFunc, deviceID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, deviceID, queriedDeviceID, "Queried device ID matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
 }
 
 func TestNetworkDevice_licenseStatusWaitConfiguration(t *testing.T) {
@@ -425,16 +419,14 @@ func TestNetworkDevice_licenseStatusWaitConfiguration(t *testing.T) {
 		queriedDeviceID = uuid
 		return &ne.Device{LicenseStatus: ne.String(ne.DeviceLicenseStateApplied)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createNetworkDeviceLicenseStatusWaitConfiguration(fetchFunc, deviceID, delay, timeout)
+	waitConfig := createNetworkDeviceLicenseStatusWaitConfiguration(fetchFunc, deviceID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, deviceID, queriedDeviceID, "Queried device ID matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
 }
 
 func TestNetworkDevice_ACLStatusWaitConfiguration(t *testing.T) {
@@ -445,16 +437,14 @@ func TestNetworkDevice_ACLStatusWaitConfiguration(t *testing.T) {
 		receivedDeviceUUID = uuid
 		return &ne.DeviceACLDetails{Status: ne.String(ne.ACLDeviceStatusProvisioned)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createNetworkDeviceACLStatusWaitConfiguration(fetchFunc, deviceUUID, delay, timeout)
+	waitConfig := createNetworkDeviceACLStatusWaitConfiguration(fetchFunc, deviceUUID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, deviceUUID, receivedDeviceUUID, "Queried Device id matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Device status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Device status wait configuration min timeout matches")
 }
 
 func TestNetworkDevice_AdditionalBandwidthStatusWaitConfiguration(t *testing.T) {
@@ -465,14 +455,12 @@ func TestNetworkDevice_AdditionalBandwidthStatusWaitConfiguration(t *testing.T)
 		receivedID = uuid
 		return &ne.DeviceAdditionalBandwidthDetails{Status: ne.String(ne.DeviceAdditionalBandwidthStatusProvisioned)}, nil
 	}
-	delay := 100 * time.Millisecond
 	timeout := 10 * time.Minute
 	// when
-	waitConfig := createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(fetchFunc, deviceID, delay, timeout)
+	waitConfig := createNetworkDeviceAdditionalBandwidthStatusWaitConfiguration(fetchFunc, deviceID, timeout)
 	_, err := waitConfig.WaitForStateContext(context.Background())
 	// then
 	assert.Nil(t, err, "WaitForState does not return an error")
 	assert.Equal(t, deviceID, receivedID, "Queried Additional Bandwidth device id matches")
 	assert.Equal(t, timeout, waitConfig.Timeout, "Additional bandwidth status wait configuration timeout matches")
-	assert.Equal(t, delay, waitConfig.MinTimeout, "Additional bandwidth wait configuration min timeout matches")
 }
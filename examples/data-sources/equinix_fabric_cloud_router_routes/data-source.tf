@@ -0,0 +1,44 @@
+data "equinix_fabric_cloud_router_routes" "learned_routes" {
+  cloud_router_id = "<cloud_router_uuid>"
+  filters = [
+    {
+      property = "/type"
+      operator = "="
+      values   = ["IPv4_BGP_ROUTE"]
+    },
+    {
+      property = "/prefix"
+      operator = "LIKE"
+      values   = ["10.0.%"]
+    }
+  ]
+  sort = [
+    {
+      direction = "ASC"
+      property  = "/prefix"
+    }
+  ]
+  pagination = {
+    limit  = 50
+    offset = 0
+  }
+}
+
+output "learned_prefixes" {
+  value = [for route in data.equinix_fabric_cloud_router_routes.learned_routes.data : route.prefix]
+}
+
+output "first_route_next_hop" {
+  value = data.equinix_fabric_cloud_router_routes.learned_routes.data.0.next_hop
+}
+
+output "first_route_connection" {
+  value = data.equinix_fabric_cloud_router_routes.learned_routes.data.0.connection
+}
+
+check "route_propagation" {
+  assert {
+    condition     = data.equinix_fabric_cloud_router_routes.learned_routes.pagination.total > 0
+    error_message = "The Cloud Router has not learned any 10.0.0.0/16 BGP routes yet"
+  }
+}
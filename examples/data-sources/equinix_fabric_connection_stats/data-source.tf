@@ -0,0 +1,22 @@
+data "equinix_fabric_connection_stats" "last_day" {
+  connection_id   = "<connection_uuid>"
+  view_point      = "aSide"
+  start_date_time = "2024-01-01T00:00:00Z"
+  end_date_time   = "2024-01-02T00:00:00Z"
+  granularity     = "PT1H"
+}
+
+check "connection_utilization" {
+  assert {
+    condition     = data.equinix_fabric_connection_stats.last_day.outbound.p95 < 0.7 * 1000
+    error_message = "95th percentile outbound utilization is above 70% of the 1000 Mbps connection bandwidth"
+  }
+}
+
+output "inbound_peak" {
+  value = data.equinix_fabric_connection_stats.last_day.inbound.max
+}
+
+output "outbound_p95" {
+  value = data.equinix_fabric_connection_stats.last_day.outbound.p95
+}
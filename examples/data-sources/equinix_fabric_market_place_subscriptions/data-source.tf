@@ -0,0 +1,14 @@
+data "equinix_fabric_market_place_subscriptions" "aws_subscriptions" {
+  uuids                  = ["<uuid_of_subscription_1>", "<uuid_of_subscription_2>"]
+  marketplace            = "AWS"
+  status                 = "ACTIVE"
+  min_quantity_available = 1
+}
+
+output "subscription_with_most_capacity" {
+  value = data.equinix_fabric_market_place_subscriptions.aws_subscriptions.data[0].uuid
+}
+
+output "quantity_available" {
+  value = data.equinix_fabric_market_place_subscriptions.aws_subscriptions.data[0].quantity_available
+}
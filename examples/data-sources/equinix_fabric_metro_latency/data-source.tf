@@ -0,0 +1,21 @@
+data "equinix_fabric_metros" "amer_aws" {
+  regions            = ["AMER"]
+  service_profile_id = "<aws_service_profile_uuid>"
+  pagination = {
+    limit = 100
+  }
+}
+
+data "equinix_fabric_metro_latency" "closest_to_dallas" {
+  metro_codes    = ["DA"]
+  to_metro_codes = [for metro in data.equinix_fabric_metros.amer_aws.data : metro.code]
+  closest        = 3
+}
+
+output "secondary_metro_code" {
+  value = data.equinix_fabric_metro_latency.closest_to_dallas.data.0.connected_metro_code
+}
+
+output "secondary_metro_latency" {
+  value = data.equinix_fabric_metro_latency.closest_to_dallas.data.0.avg_latency
+}
@@ -0,0 +1,15 @@
+data "equinix_fabric_network_changes" "network_changes" {
+  network_id = "<uuid_of_network>"
+}
+
+output "change_statuses" {
+  value = [for change in data.equinix_fabric_network_changes.network_changes.data: change.status]
+}
+
+output "change_paths" {
+  value = flatten([for change in data.equinix_fabric_network_changes.network_changes.data: [for operation in change.data: operation.path]])
+}
+
+output "total" {
+  value = one(data.equinix_fabric_network_changes.network_changes.pagination).total
+}
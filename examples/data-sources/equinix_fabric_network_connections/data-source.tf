@@ -0,0 +1,15 @@
+data "equinix_fabric_network_connections" "network_connections" {
+  network_id = "<uuid_of_network>"
+}
+
+output "connection_names" {
+  value = [for connection in data.equinix_fabric_network_connections.network_connections.data: connection.name]
+}
+
+output "connection_states" {
+  value = [for connection in data.equinix_fabric_network_connections.network_connections.data: connection.state]
+}
+
+output "total" {
+  value = one(data.equinix_fabric_network_connections.network_connections.pagination).total
+}
@@ -0,0 +1,14 @@
+data "equinix_fabric_port_pairs" "redundant" {
+  metro_code         = "SV"
+  encapsulation_type = "DOT1Q"
+  bandwidth          = 1000
+  vlan_tags          = [1010, 1020]
+}
+
+output "primary_port_uuid" {
+  value = data.equinix_fabric_port_pairs.redundant.pairs[0].primary.uuid
+}
+
+output "secondary_port_uuid" {
+  value = data.equinix_fabric_port_pairs.redundant.pairs[0].secondary.uuid
+}
@@ -0,0 +1,18 @@
+data "equinix_fabric_port_stats" "last_day" {
+  port_id         = "<port_uuid>"
+  start_date_time = "2024-01-01T00:00:00Z"
+  end_date_time   = "2024-01-02T00:00:00Z"
+  granularity     = "PT1H"
+}
+
+output "unit" {
+  value = data.equinix_fabric_port_stats.last_day.unit
+}
+
+output "hourly_inbound_peaks" {
+  value = [for metric in data.equinix_fabric_port_stats.last_day.inbound.metrics : metric.max]
+}
+
+output "outbound_p95" {
+  value = data.equinix_fabric_port_stats.last_day.outbound.p95
+}
@@ -0,0 +1,7 @@
+data "equinix_fabric_precision_time_packages" "ptp_standard" {
+  code = "PTP_STANDARD"
+}
+
+output "ptp_package_bandwidth" {
+  value = data.equinix_fabric_precision_time_packages.ptp_standard.data.0.bandwidth
+}
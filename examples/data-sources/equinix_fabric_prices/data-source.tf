@@ -0,0 +1,54 @@
+data "equinix_fabric_prices" "connection_prices" {
+  type = "VIRTUAL_CONNECTION_PRODUCT"
+  virtual_connection = {
+    type      = "EVPL_VC"
+    bandwidth = 50
+    a_side = {
+      access_point = {
+        type = "COLO"
+        location = {
+          metro_code = "SV"
+        }
+      }
+    }
+    z_side = {
+      access_point = {
+        type = "SP"
+        location = {
+          metro_code = "SV"
+        }
+        profile = {
+          uuid = "<service_profile_uuid>"
+        }
+      }
+    }
+  }
+}
+
+output "first_price_code" {
+  value = data.equinix_fabric_prices.connection_prices.data.0.code
+}
+
+output "first_price_currency" {
+  value = data.equinix_fabric_prices.connection_prices.data.0.currency
+}
+
+output "first_price_charges" {
+  value = data.equinix_fabric_prices.connection_prices.data.0.charges
+}
+
+data "equinix_fabric_prices" "cloud_router_prices" {
+  type = "CLOUD_ROUTER_PRODUCT"
+  cloud_router = {
+    package = {
+      code = "STANDARD"
+    }
+    location = {
+      metro_code = "SV"
+    }
+  }
+}
+
+output "cloud_router_monthly_price" {
+  value = data.equinix_fabric_prices.cloud_router_prices.data.0.charges.0.price
+}
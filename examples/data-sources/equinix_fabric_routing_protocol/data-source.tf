@@ -50,3 +50,11 @@ output "bgp_ipv6_enabled" {
 output "customer_asn" {
   value = data.equinix_fabric_routing_protocol.routing_protocol_data_name.customer_asn
 }
+
+output "bgp_ipv4_operational_status" {
+  value = data.equinix_fabric_routing_protocol.routing_protocol_data_name.bgp_ipv4_operational_status
+}
+
+output "bgp_ipv6_operational_status" {
+  value = data.equinix_fabric_routing_protocol.routing_protocol_data_name.bgp_ipv6_operational_status
+}
@@ -0,0 +1,4 @@
+data "equinix_fabric_stream_alert_rule" "by_ids" {
+  stream_id     = "<stream_id>"
+  alert_rule_id = "<alert_rule_id>"
+}
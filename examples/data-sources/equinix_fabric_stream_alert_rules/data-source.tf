@@ -0,0 +1,7 @@
+data "equinix_fabric_stream_alert_rules" "all" {
+  stream_id = "<stream_id>"
+  pagination = {
+    limit  = 10
+    offset = 0
+  }
+}
@@ -0,0 +1,37 @@
+data "equinix_network_device_available_interfaces" "edge" {
+  device_id = "<device_uuid>"
+}
+
+resource "equinix_fabric_connection" "vd2network" {
+  name = "ConnectionName"
+  type = "EVPLAN_VC"
+  notifications {
+    type   = "ALL"
+    emails = ["example@equinix.com"]
+  }
+  bandwidth = 50
+  order {
+    purchase_order_number = "1-323292"
+  }
+  a_side {
+    access_point {
+      type = "VD"
+      virtual_device {
+        type = "EDGE"
+        uuid = data.equinix_network_device_available_interfaces.edge.device_id
+      }
+      interface {
+        type = "CLOUD"
+        id   = data.equinix_network_device_available_interfaces.edge.primary_interface_ids[0]
+      }
+    }
+  }
+  z_side {
+    access_point {
+      type = "NETWORK"
+      network {
+        uuid = "<network_uuid>"
+      }
+    }
+  }
+}
@@ -0,0 +1,21 @@
+resource "equinix_fabric_cloud_router_action" "route_table_refresh" {
+  cloud_router_id = "<cloud_router_uuid>"
+  type            = "ROUTE_TABLE_ENTRY_UPDATE"
+  triggers = {
+    routing_protocol = "<routing_protocol_uuid>"
+  }
+}
+
+resource "equinix_fabric_cloud_router_action" "bgp_session_refresh" {
+  cloud_router_id = "<cloud_router_uuid>"
+  type            = "BGP_SESSION_STATUS_UPDATE"
+  connection_id   = "<connection_uuid>"
+}
+
+output "route_table_refresh_state" {
+  value = equinix_fabric_cloud_router_action.route_table_refresh.state
+}
+
+output "bgp_ipv4_routes_count" {
+  value = equinix_fabric_cloud_router_action.route_table_refresh.operation.bgp_ipv4_routes_count
+}
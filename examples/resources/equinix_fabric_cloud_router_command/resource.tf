@@ -0,0 +1,31 @@
+resource "equinix_fabric_cloud_router_command" "ping" {
+  cloud_router_id = "<cloud_router_uuid>"
+  type            = "PING_COMMAND"
+  name            = "ping-aws-peer"
+  project_id      = "<project_id>"
+  request = {
+    destination          = "169.254.0.1"
+    source_connection_id = "<connection_uuid>"
+    count                = 5
+    timeout              = 2
+  }
+}
+
+output "ping_packets_loss_percent" {
+  value = equinix_fabric_cloud_router_command.ping.response.ping.packets_loss_percent
+}
+
+output "ping_rtt_avg" {
+  value = equinix_fabric_cloud_router_command.ping.response.ping.rtt_avg
+}
+
+output "ping_output" {
+  value = equinix_fabric_cloud_router_command.ping.response.output
+}
+
+check "peer_reachability" {
+  assert {
+    condition     = equinix_fabric_cloud_router_command.ping.response.ping.packets_received > 0
+    error_message = "The BGP peer is not reachable from the Cloud Router"
+  }
+}
@@ -0,0 +1,33 @@
+resource "equinix_fabric_connection" "port2aws" {
+  name = "ConnectionName"
+  type = "EVPL_VC"
+  notifications {
+    type = "ALL"
+    emails = ["example@equinix.com","test1@equinix.com"]
+  }
+  bandwidth = 50
+  redundancy { priority= "PRIMARY" }
+  order {
+    purchase_order_number= "1-323929"
+  }
+  a_side {
+    access_point {
+      type= "COLO"
+      port {
+        uuid = "<aside_port_uuid>"
+      }
+      link_protocol {
+        type = "QINQ"
+        vlan_s_tag = "2019"
+        vlan_c_tag = "2112"
+      }
+    }
+  }
+  aws {
+    account_id = "<aws_account_id>"
+    region     = "us-west-1"
+    metro_code = "SV"
+    access_key = "<aws_access_key>"
+    secret_key = "<aws_secret_key>"
+  }
+}
@@ -0,0 +1 @@
+terraform import equinix_fabric_connection_route_filters.policies {connection_id_1},{connection_id_2}/{route_filter_id_1}:INBOUND,{route_filter_id_2}:OUTBOUND
@@ -0,0 +1,20 @@
+resource "equinix_fabric_connection_route_filters" "policies" {
+  connection_ids = [
+    "<connection_uuid_1>",
+    "<connection_uuid_2>",
+  ]
+  route_filters = [
+    {
+      route_filter_id = "<inbound_route_filter_policy_id>"
+      direction       = "INBOUND"
+    },
+    {
+      route_filter_id = "<outbound_route_filter_policy_id>"
+      direction       = "OUTBOUND"
+    },
+  ]
+}
+
+output "connection_route_filter_attachments" {
+  value = equinix_fabric_connection_route_filters.policies.attachments
+}
@@ -0,0 +1,17 @@
+resource "equinix_metal_vlan" "example" {
+  project_id = "<metal_project_id>"
+  metro      = "sv"
+}
+
+resource "equinix_fabric_metal_interconnection" "cloud_router" {
+  project_id = "<metal_project_id>"
+  name       = "metal-to-fcr"
+  metro      = "sv"
+  speed      = "50Mbps"
+  vlans      = [equinix_metal_vlan.example.vxlan]
+  fabric = {
+    type                = "CLOUD_ROUTER"
+    id                  = "<cloud_router_uuid>"
+    notification_emails = ["network-team@example.com"]
+  }
+}
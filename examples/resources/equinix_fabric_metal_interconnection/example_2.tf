@@ -0,0 +1,28 @@
+resource "equinix_metal_vlan" "primary" {
+  project_id = "<metal_project_id>"
+  metro      = "da"
+}
+
+resource "equinix_metal_vlan" "secondary" {
+  project_id = "<metal_project_id>"
+  metro      = "da"
+}
+
+resource "equinix_fabric_metal_interconnection" "redundant_ports" {
+  project_id = "<metal_project_id>"
+  name       = "metal-to-ports"
+  metro      = "da"
+  speed      = "1Gbps"
+  redundancy = "redundant"
+  vlans = [
+    equinix_metal_vlan.primary.vxlan,
+    equinix_metal_vlan.secondary.vxlan,
+  ]
+  fabric = {
+    type                = "COLO"
+    id                  = "<primary_port_uuid>"
+    secondary_id        = "<secondary_port_uuid>"
+    vlan_tags           = [1001, 1002]
+    notification_emails = ["network-team@example.com"]
+  }
+}
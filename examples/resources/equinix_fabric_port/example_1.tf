@@ -0,0 +1,38 @@
+resource "equinix_fabric_port" "single_port" {
+  type                     = "XF_PORT"
+  description              = "Primary 10G port in SV1"
+  connectivity_source_type = "COLO"
+  physical_ports_speed     = 10000
+  physical_ports_type      = "10GBASE_LR"
+  location {
+    metro_code = "SV"
+    ibx        = "SV1"
+  }
+  encapsulation {
+    type            = "DOT1Q"
+    tag_protocol_id = "0x8100"
+  }
+  redundancy {
+    priority = "PRIMARY"
+  }
+  demarcation_point {
+    ibx                  = "SV1"
+    cage_unique_space_id = "SV1:01:002002"
+    patch_panel          = "PP:0000:1234567"
+    patch_panel_port_a   = "1"
+    patch_panel_port_b   = "2"
+    connector_type       = "LC"
+  }
+  order {
+    purchase_order_number = "1-323292"
+    purchase_order_type   = "NEW"
+  }
+  notifications {
+    type             = "TECHNICAL"
+    registered_users = ["example-user"]
+  }
+}
+
+output "cross_connect_loas" {
+  value = [for loa in equinix_fabric_port.single_port.loas : loa.href]
+}
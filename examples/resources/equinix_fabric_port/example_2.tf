@@ -0,0 +1,33 @@
+resource "equinix_fabric_port" "lag_port" {
+  type                     = "XF_PORT"
+  connectivity_source_type = "COLO"
+  physical_ports_speed     = 10000
+  physical_ports_type      = "10GBASE_LR"
+  lag_enabled              = true
+  # Increase to add LAG members in place
+  physical_ports_count = 2
+  location {
+    metro_code = "DC"
+    ibx        = "DC11"
+  }
+  encapsulation {
+    type = "QINQ"
+  }
+  demarcation_point {
+    ibx                  = "DC11"
+    cage_unique_space_id = "DC11:01:001001"
+    connector_type       = "LC"
+  }
+  project {
+    project_id = "776847000642406"
+  }
+
+  timeouts {
+    create = "6h"
+    update = "6h"
+  }
+}
+
+output "lag_member_cross_connects" {
+  value = [for physical_port in equinix_fabric_port.lag_port.physical_ports : physical_port.tether[0].cross_connect_id if length(physical_port.tether) > 0]
+}
@@ -0,0 +1 @@
+terraform import equinix_fabric_route_filter_rules.rf_rules {route_filter_id}
@@ -0,0 +1,27 @@
+# Two rules previously managed one resource each
+resource "equinix_fabric_route_filter_rules" "rf_rules" {
+  route_filter_id = "<route_filter_policy_id>"
+  rules = [
+    {
+      prefix = "192.168.0.0/24"
+    },
+    {
+      prefix = "192.168.1.0/24"
+    },
+  ]
+}
+
+# The state of the first rule is moved into the plural resource
+moved {
+  from = equinix_fabric_route_filter_rule.rule_a
+  to   = equinix_fabric_route_filter_rules.rf_rules
+}
+
+# The other rules are forgotten without deleting them
+removed {
+  from = equinix_fabric_route_filter_rule.rule_b
+
+  lifecycle {
+    destroy = false
+  }
+}
@@ -0,0 +1,18 @@
+resource "equinix_fabric_route_filter_rules" "rf_rules" {
+  route_filter_id = "<route_filter_policy_id>"
+  rules = [
+    {
+      name         = "Route Filter Rule A"
+      prefix       = "192.168.0.0/24"
+      prefix_match = "exact"
+      description  = "Route Filter Rule for X Purpose"
+    },
+    {
+      prefix = "192.168.1.0/24"
+    },
+  ]
+}
+
+output "route_filter_rule_uuids" {
+  value = equinix_fabric_route_filter_rules.rf_rules.rule_uuids
+}
@@ -0,0 +1,4 @@
+resource "equinix_fabric_service_profile_access" "buyer" {
+  service_profile_id = "<service_profile_uuid>"
+  email              = "network-team@buyer.example.com"
+}
@@ -2,6 +2,7 @@ resource "equinix_fabric_service_token" "test" {
   type                 = "VC_TOKEN"
   description          = "Aside COLO Service Token"
   expiration_date_time = "2025-01-18T06:43:49.981Z"
+  renew_before         = "72h"
   service_token_connection {
     type = "EVPL_VC"
     bandwidth_limit = 1000
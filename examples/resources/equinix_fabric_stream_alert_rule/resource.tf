@@ -0,0 +1,14 @@
+resource "equinix_fabric_stream_alert_rule" "connection_utilization" {
+  stream_id   = "<stream_id>"
+  name        = "<name>"
+  description = "<description>"
+  enabled     = true
+  metric_name = "equinix.fabric.connection.bandwidth_tx.usage"
+  resource_selector = {
+    include = ["*/connections/<connection_id>"]
+  }
+  window_size        = "PT15M"
+  operand            = "ABOVE"
+  warning_threshold  = "<warning_threshold>"
+  critical_threshold = "<critical_threshold>"
+}
@@ -11,15 +11,11 @@ resource "equinix_fabric_stream_subscription" "SPLUNK" {
     include = ["equinix.fabric.connection.*"]
   }
   sink = {
-    type = "SPLUNK_HEC"
-    uri  = "<splunk_uri>"
-    settings = {
+    splunk_hec = {
+      uri          = "<splunk_uri>"
       event_index  = "<splunk_event_index>"
       metric_index = "<splunk_metric_index>"
       source       = "<splunk_source>"
-    }
-    credential = {
-      type         = "ACCESS_TOKEN"
       access_token = "<splunk_access_token>"
     }
   }
@@ -32,8 +28,9 @@ resource "equinix_fabric_stream_subscription" "SLACK" {
   stream_id   = "<stream_id>"
   enabled     = true
   sink = {
-    type = "SLACK"
-    uri  = "<slack_uri>"
+    slack = {
+      uri = "<slack_uri>"
+    }
   }
 }
 
@@ -44,14 +41,10 @@ resource "equinix_fabric_stream_subscription" "PAGER_DUTY" {
   stream_id   = "<stream_id>"
   enabled     = true
   sink = {
-    type = "PAGERDUTY"
-    host = "<pager_duty_host"
-    settings = {
-      change_uri       = "<pager_duty_change_uri>"
-      alert_uri        = "<pager_duty_alert_uri>"
-    }
-    credential = {
-      type            = "INTEGRATION_KEY"
+    pagerduty = {
+      host            = "<pager_duty_host>"
+      event_uri       = "<pager_duty_change_uri>"
+      metric_uri      = "<pager_duty_alert_uri>"
       integration_key = "<pager_duty_integration_key>"
     }
   }
@@ -64,17 +57,13 @@ resource "equinix_fabric_stream_subscription" "DATADOG" {
   stream_id   = "<stream_id>"
   enabled     = true
   sink = {
-    type = "DATADOG"
-    host = "<datadog_host>"
-    settings = {
+    datadog = {
+      host            = "<datadog_host>"
       source          = "Equinix"
       application_key = "<datadog_application_key>"
       event_uri       = "<datadog_event_uri>"
       metric_uri      = "<datadog_metric_uri>"
-    }
-    credential = {
-      type    = "API_KEY"
-      api_key = "<datadog_api_key>"
+      api_key         = "<datadog_api_key>"
     }
   }
 }
@@ -86,8 +75,22 @@ resource "equinix_fabric_stream_subscription" "MSTEAMS" {
   stream_id   = "<stream_id>"
   enabled     = true
   sink = {
-    type = "TEAMS"
-    uri  = "<msteams_uri>"
+    teams = {
+      uri = "<msteams_uri>"
+    }
   }
 }
 
+resource "equinix_fabric_stream_subscription" "WEBHOOK" {
+  type        = "STREAM_SUBSCRIPTION"
+  name        = "<name>"
+  description = "<description>"
+  stream_id   = "<stream_id>"
+  enabled     = true
+  sink = {
+    webhook = {
+      uri          = "<webhook_uri>"
+      access_token = "<webhook_access_token>"
+    }
+  }
+}
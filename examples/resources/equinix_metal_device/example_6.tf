@@ -0,0 +1,9 @@
+resource "equinix_metal_device" "build" {
+  hostname         = "build-server"
+  plan             = "c3.small.x86"
+  metro            = "sv"
+  operating_system = "ubuntu_24_04"
+  billing_cycle    = "hourly"
+  project_id       = local.project_id
+  power_state      = var.build_window_open ? "on" : "off"
+}
@@ -0,0 +1,7 @@
+resource "equinix_metal_device_action" "reboot" {
+  device_id = equinix_metal_device.example.id
+  type      = "reboot"
+  triggers = {
+    kernel_version = var.kernel_version
+  }
+}
@@ -0,0 +1,8 @@
+resource "equinix_metal_device_action" "rescue" {
+  device_id = equinix_metal_device.example.id
+  type      = "rescue"
+}
+
+output "rescue_ssh_host" {
+  value = equinix_metal_device.example.access_public_ipv4
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/equinix/equinix-sdk-go/services/metalv1"
 	"github.com/equinix/ne-go"
 	"github.com/equinix/oauth2-go"
+	"github.com/equinix/terraform-provider-equinix/internal/polling"
 	"github.com/equinix/terraform-provider-equinix/version"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/packethost/packngo"
 	xoauth2 "golang.org/x/oauth2"
@@ -62,6 +64,9 @@ type Config struct {
 	PageSize       int
 	Token          string
 
+	PollMinInterval time.Duration
+	PollMaxInterval time.Duration
+
 	authClient *http.Client
 
 	Ne    ne.Client
@@ -265,6 +270,16 @@ func (c *Config) requestTimeout() time.Duration {
 	return c.RequestTimeout
 }
 
+// WaitForStateContext waits for conf to reach its target state, polling
+// with the provider-wide exponential backoff instead of the fixed schedule
+// set in conf
+func (c *Config) WaitForStateContext(ctx context.Context, conf *retry.StateChangeConf) (interface{}, error) {
+	return polling.WaitForStateContext(ctx, conf, polling.Backoff{
+		Min: c.PollMinInterval,
+		Max: c.PollMaxInterval,
+	})
+}
+
 func appendUserAgentFromEnv(ua string) string {
 	if add := os.Getenv(uaEnvVar); add != "" {
 		add = strings.TrimSpace(add)
@@ -294,6 +309,10 @@ func (c *Config) AddFwModuleToMetalUserAgent(ctx context.Context, meta tfsdk.Con
 }
 
 func generateFwModuleUserAgentString(ctx context.Context, meta tfsdk.Config, baseUserAgent string) string {
+	// Provider meta is not sent for every request, e.g. when importing
+	if meta.Schema == nil {
+		return baseUserAgent
+	}
 	var m ProviderMeta
 	diags := meta.Get(ctx, &m)
 	if diags.HasError() {
@@ -163,6 +163,12 @@ func (v ListNestedObjectValueOf[T]) ToObjectSlice(ctx context.Context) (any, dia
 	return v.ToSlice(ctx)
 }
 
+// IsEmpty reports whether the ListNestedObject is known and has no elements,
+// which is how a list block left out of the configuration is planned.
+func (v ListNestedObjectValueOf[T]) IsEmpty() bool {
+	return !v.IsNull() && !v.IsUnknown() && len(v.Elements()) == 0
+}
+
 // ToPtr returns a pointer to the single element of a ListNestedObject.
 func (v ListNestedObjectValueOf[T]) ToPtr(ctx context.Context) (*T, diag.Diagnostics) {
 	return nestedObjectValueObjectPtr[T](ctx, v.ListValue)
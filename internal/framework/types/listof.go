@@ -23,6 +23,9 @@ var (
 var (
 	// ListOfStringType is a custom type used for defining a List of strings.
 	ListOfStringType = listTypeOf[basetypes.StringValue]{basetypes.ListType{ElemType: basetypes.StringType{}}}
+
+	// ListOfInt64Type is a custom type used for defining a List of int64s.
+	ListOfInt64Type = listTypeOf[basetypes.Int64Value]{basetypes.ListType{ElemType: basetypes.Int64Type{}}}
 )
 
 type listTypeOf[T attr.Value] struct {
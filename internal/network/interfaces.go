@@ -0,0 +1,24 @@
+package network
+
+import (
+	"slices"
+
+	"github.com/equinix/ne-go"
+)
+
+// InterfaceStatusAvailable is the status of a device interface that is not
+// reserved or assigned to a connection
+const InterfaceStatusAvailable = "AVAILABLE"
+
+// AvailableInterfaceIDs returns the sorted IDs of the device interfaces that
+// can still be used by a connection
+func AvailableInterfaceIDs(interfaces []ne.DeviceInterface) []int {
+	ids := make([]int, 0, len(interfaces))
+	for _, deviceInterface := range interfaces {
+		if ne.StringValue(deviceInterface.Status) == InterfaceStatusAvailable && deviceInterface.ID != nil {
+			ids = append(ids, ne.IntValue(deviceInterface.ID))
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
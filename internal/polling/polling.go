@@ -0,0 +1,87 @@
+// Package polling waits for remote resources to reach a target state,
+// spacing refreshes with exponential backoff and jitter
+package polling
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// DefaultMinInterval is the base interval between refreshes when the
+	// provider does not configure poll_min_interval
+	DefaultMinInterval = 5 * time.Second
+	// DefaultMaxInterval is the longest interval between refreshes when the
+	// provider does not configure poll_max_interval
+	DefaultMaxInterval = 60 * time.Second
+)
+
+// Backoff describes the interval between two refreshes of a waiter
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Interval returns the time to wait before the refresh following the given
+// number of previous attempts. The upper bound doubles with every attempt,
+// starting from twice Min and capped at Max, and the returned value is
+// picked at random between Min and that bound so that concurrent waiters
+// do not refresh in lockstep.
+func (b Backoff) Interval(attempt int) time.Duration {
+	b = b.withDefaults()
+	upper := 2 * b.Min
+	for i := 0; i < attempt && upper < b.Max; i++ {
+		upper *= 2
+	}
+	upper = min(upper, b.Max)
+	if upper <= b.Min {
+		return b.Min
+	}
+	return b.Min + rand.N(upper-b.Min+1)
+}
+
+func (b Backoff) withDefaults() Backoff {
+	if b.Min <= 0 {
+		b.Min = DefaultMinInterval
+	}
+	if b.Max <= 0 {
+		b.Max = DefaultMaxInterval
+	}
+	if b.Max < b.Min {
+		b.Max = b.Min
+	}
+	return b
+}
+
+// WaitForStateContext waits for conf to reach its target state like
+// retry.StateChangeConf.WaitForStateContext does, but spaces the refreshes
+// with b instead of conf.MinTimeout and conf.PollInterval. conf.Delay is
+// still honoured before the first refresh and no wait extends past
+// conf.Timeout.
+func WaitForStateContext(ctx context.Context, conf *retry.StateChangeConf, b Backoff) (interface{}, error) {
+	deadline := time.Now().Add(conf.Timeout)
+	refresh := conf.Refresh
+	attempt := 0
+
+	waiter := *conf
+	waiter.MinTimeout = 0
+	waiter.PollInterval = time.Nanosecond
+	waiter.Refresh = func() (interface{}, string, error) {
+		if attempt > 0 {
+			wait := min(b.Interval(attempt-1), max(time.Until(deadline), 0))
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, "", ctx.Err()
+			case <-timer.C:
+			}
+		}
+		attempt++
+		return refresh()
+	}
+	return waiter.WaitForStateContext(ctx)
+}
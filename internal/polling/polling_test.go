@@ -0,0 +1,105 @@
+package polling_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/polling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Interval(t *testing.T) {
+	tests := map[string]struct {
+		backoff  polling.Backoff
+		attempt  int
+		minValue time.Duration
+		maxValue time.Duration
+	}{
+		"first attempt": {
+			backoff:  polling.Backoff{Min: time.Second, Max: time.Minute},
+			attempt:  0,
+			minValue: time.Second,
+			maxValue: 2 * time.Second,
+		},
+		"third attempt": {
+			backoff:  polling.Backoff{Min: time.Second, Max: time.Minute},
+			attempt:  2,
+			minValue: time.Second,
+			maxValue: 8 * time.Second,
+		},
+		"capped at max": {
+			backoff:  polling.Backoff{Min: time.Second, Max: 10 * time.Second},
+			attempt:  100,
+			minValue: time.Second,
+			maxValue: 10 * time.Second,
+		},
+		"max below min": {
+			backoff:  polling.Backoff{Min: 5 * time.Second, Max: time.Second},
+			attempt:  3,
+			minValue: 5 * time.Second,
+			maxValue: 5 * time.Second,
+		},
+		"defaults": {
+			attempt:  100,
+			minValue: polling.DefaultMinInterval,
+			maxValue: polling.DefaultMaxInterval,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				interval := tc.backoff.Interval(tc.attempt)
+				assert.GreaterOrEqual(t, interval, tc.minValue, "Interval is not below the lower bound")
+				assert.LessOrEqual(t, interval, tc.maxValue, "Interval is not above the upper bound")
+			}
+		})
+	}
+}
+
+func TestWaitForStateContext(t *testing.T) {
+	// given
+	states := []string{"PENDING", "PENDING", "DONE"}
+	calls := 0
+	conf := &retry.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			state := states[calls]
+			calls++
+			return state, state, nil
+		},
+		Timeout:    time.Second,
+		MinTimeout: time.Hour,
+	}
+	// when
+	start := time.Now()
+	result, err := polling.WaitForStateContext(context.Background(), conf, polling.Backoff{Min: time.Millisecond, Max: 2 * time.Millisecond})
+	// then
+	assert.Nil(t, err, "Wait does not return error")
+	assert.Equal(t, "DONE", result, "Result is the target state")
+	assert.Equal(t, 3, calls, "Refresh is called until the target state")
+	assert.Less(t, time.Since(start), time.Second, "MinTimeout is not used between refreshes")
+}
+
+func TestWaitForStateContext_timeout(t *testing.T) {
+	// given
+	conf := &retry.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			return "PENDING", "PENDING", nil
+		},
+		Timeout: 50 * time.Millisecond,
+	}
+	// when
+	start := time.Now()
+	_, err := polling.WaitForStateContext(context.Background(), conf, polling.Backoff{Min: time.Hour, Max: time.Hour})
+	// then
+	var timeoutErr *retry.TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr), "Wait returns timeout error")
+	assert.Less(t, time.Since(start), 10*time.Second, "Backoff does not extend past the timeout")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/polling"
 	"github.com/equinix/terraform-provider-equinix/internal/provider/services"
 	equinix_validation "github.com/equinix/terraform-provider-equinix/internal/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -87,6 +88,20 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "Maximum number of seconds to wait before retrying a request.",
 			},
+			"poll_min_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The base duration of time, in seconds, to wait between two status checks of a resource that is being created, updated or deleted. Waits grow exponentially with random jitter from this value. (Defaults to `%d`)", int(polling.DefaultMinInterval.Seconds())),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"poll_max_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum duration of time, in seconds, to wait between two status checks of a resource that is being created, updated or deleted. Must not be lower than `poll_min_interval`. (Defaults to `%d`)", int(polling.DefaultMaxInterval.Seconds())),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
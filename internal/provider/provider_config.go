@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/polling"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -24,6 +26,8 @@ type FrameworkProviderConfig struct {
 	PageSize            types.Int64  `tfsdk:"response_max_page_size"`
 	MaxRetries          types.Int64  `tfsdk:"max_retries"`
 	MaxRetryWaitSeconds types.Int64  `tfsdk:"max_retry_wait_seconds"`
+	PollMinInterval     types.Int64  `tfsdk:"poll_min_interval"`
+	PollMaxInterval     types.Int64  `tfsdk:"poll_max_interval"`
 }
 
 func (c *FrameworkProviderConfig) toOldStyleConfig() *config.Config {
@@ -38,6 +42,9 @@ func (c *FrameworkProviderConfig) toOldStyleConfig() *config.Config {
 		PageSize:       int(c.PageSize.ValueInt64()),
 		MaxRetries:     int(c.MaxRetries.ValueInt64()),
 		MaxRetryWait:   time.Duration(c.MaxRetryWaitSeconds.ValueInt64()) * time.Second,
+
+		PollMinInterval: time.Duration(c.PollMinInterval.ValueInt64()) * time.Second,
+		PollMaxInterval: time.Duration(c.PollMaxInterval.ValueInt64()) * time.Second,
 	}
 }
 
@@ -84,10 +91,26 @@ func (fp *FrameworkProvider) Configure(
 
 	fwconfig.MaxRetryWaitSeconds = determineIntConfValue(
 		fwconfig.MaxRetryWaitSeconds, "", 30, &resp.Diagnostics)
+
+	fwconfig.PollMinInterval = determineIntConfValue(
+		fwconfig.PollMinInterval, "", int64(polling.DefaultMinInterval.Seconds()), &resp.Diagnostics)
+
+	fwconfig.PollMaxInterval = determineIntConfValue(
+		fwconfig.PollMaxInterval, "", int64(polling.DefaultMaxInterval.Seconds()), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if fwconfig.PollMaxInterval.ValueInt64() < fwconfig.PollMinInterval.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("poll_max_interval"),
+			"Invalid polling configuration",
+			fmt.Sprintf("poll_max_interval (%d) must not be lower than poll_min_interval (%d)",
+				fwconfig.PollMaxInterval.ValueInt64(), fwconfig.PollMinInterval.ValueInt64()),
+		)
+		return
+	}
+
 	oldStyleConfig := fwconfig.toOldStyleConfig()
 	err := oldStyleConfig.Load(ctx)
 	if err != nil {
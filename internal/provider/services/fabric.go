@@ -2,12 +2,22 @@
 package services
 
 import (
+	cloudrouter "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/cloud_router"
+	connectionroutefilters "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connection_route_filters"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connectionrouteaggregation"
+	metalinterconnection "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/metal_interconnection"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/metro"
+	portpair "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/port_pair"
 	precisiontime "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/precision_time"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/price"
+	routefilterrules "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/route_filter_rules"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routeaggregation"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routeaggregationrule"
+	routingprotocol "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routing_protocol"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/serviceprofile"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/statistics"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream"
+	streamalertrule "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream_alert_rule"
 	streamattachment "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream_attachment"
 	streamsubscription "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream_subscription"
 
@@ -18,11 +28,20 @@ import (
 // FabricResources represents fabric resources
 func FabricResources() []func() resource.Resource {
 	return []func() resource.Resource{
+		cloudrouter.NewActionResource,
+		cloudrouter.NewCommandResource,
+		cloudrouter.NewResource,
 		connectionrouteaggregation.NewResource,
+		connectionroutefilters.NewResource,
+		metalinterconnection.NewResource,
 		precisiontime.NewResource,
 		routeaggregation.NewResource,
 		routeaggregationrule.NewResource,
+		routefilterrules.NewResource,
+		routingprotocol.NewResource,
+		serviceprofile.NewAccessResource,
 		stream.NewResource,
+		streamalertrule.NewResource,
 		streamattachment.NewResource,
 		streamsubscription.NewResource,
 	}
@@ -31,18 +50,27 @@ func FabricResources() []func() resource.Resource {
 // FabricDatasources represents fabric data source
 func FabricDatasources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		cloudrouter.NewDataSourceRoutes,
 		connectionrouteaggregation.NewDataSourceByConnectionRouteAggregationID,
 		connectionrouteaggregation.NewDataSourceAllConnectionRouteAggregations,
 		metro.NewDataSourceMetroCode,
+		metro.NewDataSourceMetroLatency,
 		metro.NewDataSourceMetros,
+		portpair.NewDataSourcePortPairs,
 		precisiontime.NewDataSourceByEptServiceID,
 		precisiontime.NewDataSourceAllEptServices,
+		precisiontime.NewDataSourcePackages,
+		price.NewDataSourcePrices,
 		routeaggregation.NewDataSourceByRouteAggregationID,
 		routeaggregation.NewDataSourceAllRouteAggregation,
 		routeaggregationrule.NewDataSourceByRouteAggregationRuleID,
 		routeaggregationrule.NewDataSourceAllRouteAggregationRule,
+		statistics.NewDataSourceConnectionStats,
+		statistics.NewDataSourcePortStats,
 		stream.NewDataSourceByStreamID,
 		stream.NewDataSourceAllStreams,
+		streamalertrule.NewDataSourceAllStreamAlertRules,
+		streamalertrule.NewDataSourceByIDs,
 		streamattachment.NewDataSourceAllStreamAttachments,
 		streamattachment.NewDataSourceByIDs,
 		streamsubscription.NewDataSourceAllStreamSubscriptions,
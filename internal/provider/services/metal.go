@@ -2,6 +2,7 @@ package services
 
 import (
 	metalconnection "github.com/equinix/terraform-provider-equinix/internal/resources/metal/connection"
+	metaldeviceaction "github.com/equinix/terraform-provider-equinix/internal/resources/metal/device_action"
 	metalgateway "github.com/equinix/terraform-provider-equinix/internal/resources/metal/gateway"
 	metalorganization "github.com/equinix/terraform-provider-equinix/internal/resources/metal/organization"
 	metalorganizationmember "github.com/equinix/terraform-provider-equinix/internal/resources/metal/organization_member"
@@ -20,6 +21,7 @@ func MetalResources() []func() resource.Resource {
 		metalprojectsshkey.NewResource,
 		metalsshkey.NewResource,
 		metalconnection.NewResource,
+		metaldeviceaction.NewResource,
 		metalorganization.NewResource,
 		metalorganizationmember.NewResource,
 		vlan.NewResource,
@@ -0,0 +1,150 @@
+package cloud_router
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func NewDataSourceRoutes() datasource.DataSource {
+	return &DataSourceRoutes{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_cloud_router_routes",
+			},
+		),
+	}
+}
+
+type DataSourceRoutes struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourceRoutes) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceRoutesSchema(ctx)
+}
+
+func (r *DataSourceRoutes) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	// Retrieve values from config
+	var data DataSourceRoutesModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	searchRequest, diags := buildRoutesSearchRequest(ctx, data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Use API client to search the route table of the Cloud Router
+	routes, _, err := client.CloudRoutersApi.SearchCloudRouterRoutes(ctx, data.CloudRouterID.ValueString()).
+		RouteTableEntrySearchRequest(searchRequest).
+		Execute()
+
+	if err != nil {
+		response.State.RemoveResource(ctx)
+		response.Diagnostics.AddError("api error retrieving cloud router routes data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// Set state to fully populated data
+	response.Diagnostics.Append(data.parse(ctx, routes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func buildRoutesSearchRequest(ctx context.Context, data DataSourceRoutesModel) (fabricv4.RouteTableEntrySearchRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	searchRequest := fabricv4.RouteTableEntrySearchRequest{}
+
+	offset, limit := int32(0), int32(20)
+	if !data.Pagination.IsNull() && !data.Pagination.IsUnknown() {
+		var pagination PaginationModel
+		diags = data.Pagination.As(ctx, &pagination, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return fabricv4.RouteTableEntrySearchRequest{}, diags
+		}
+		offset = pagination.Offset.ValueInt32()
+		if pagination.Limit.ValueInt32() != 0 {
+			limit = pagination.Limit.ValueInt32()
+		}
+	}
+	searchRequest.SetPagination(fabricv4.PaginationRequest{
+		Offset: &offset,
+		Limit:  &limit,
+	})
+
+	if !data.Filters.IsNull() && !data.Filters.IsUnknown() {
+		filterModels := make([]FilterModel, len(data.Filters.Elements()))
+		diags = data.Filters.ElementsAs(ctx, &filterModels, false)
+		if diags.HasError() {
+			return fabricv4.RouteTableEntrySearchRequest{}, diags
+		}
+		var routeFilters fabricv4.RouteTableEntryFilters
+		var filters []fabricv4.RouteTableEntryFilter
+		var orFilter fabricv4.RouteTableEntryOrFilter
+		for _, filter := range filterModels {
+			var expression fabricv4.RouteTableEntrySimpleExpression
+			expression.SetOperator(filter.Operator.ValueString())
+			expression.SetProperty(filter.Property.ValueString())
+			var values []string
+			diags = filter.Values.ElementsAs(ctx, &values, false)
+			if diags.HasError() {
+				return fabricv4.RouteTableEntrySearchRequest{}, diags
+			}
+			expression.SetValues(values)
+			if filter.Or.ValueBool() {
+				orFilter.SetOr(append(orFilter.GetOr(), expression))
+			} else {
+				filters = append(filters, fabricv4.RouteTableEntryFilter{
+					RouteTableEntrySimpleExpression: &expression,
+				})
+			}
+		}
+
+		if len(orFilter.GetOr()) > 0 {
+			filters = append(filters, fabricv4.RouteTableEntryFilter{
+				RouteTableEntryOrFilter: &orFilter,
+			})
+		}
+		routeFilters.SetAnd(filters)
+		searchRequest.SetFilter(routeFilters)
+	}
+
+	if !data.Sort.IsNull() && !data.Sort.IsUnknown() {
+		sortModels := make([]SortModel, len(data.Sort.Elements()))
+		diags = data.Sort.ElementsAs(ctx, &sortModels, false)
+		if diags.HasError() {
+			return fabricv4.RouteTableEntrySearchRequest{}, diags
+		}
+		routeSort := make([]fabricv4.RouteTableEntrySortCriteria, len(sortModels))
+		for i, criteria := range sortModels {
+			sort := fabricv4.RouteTableEntrySortCriteria{}
+			sort.SetDirection(fabricv4.RouteTableEntrySortDirection(criteria.Direction.ValueString()))
+			sort.SetProperty(fabricv4.RouteTableEntrySortBy(criteria.Property.ValueString()))
+			routeSort[i] = sort
+		}
+		searchRequest.SetSort(routeSort)
+	}
+
+	return searchRequest, diags
+}
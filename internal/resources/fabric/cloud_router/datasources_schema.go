@@ -0,0 +1,257 @@
+package cloud_router
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dataSourceRoutesSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data source that allows user to search the route table of an Equinix Fabric Cloud Router with filters and pagination details
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-intro.htm#HowItWorks
+* API: https://developer.equinix.com/catalog/fabricv4#operation/searchCloudRouterRoutes`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"cloud_router_id": schema.StringAttribute{
+				Description: "Equinix-assigned Cloud Router identifier whose route table is searched",
+				Required:    true,
+			},
+			"filters": schema.ListNestedAttribute{
+				Description: "List of filters to apply to the route table search request. Maximum of 8. All will be AND'd together with 1 of the 8 being a possible OR group of 3",
+				Optional:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[FilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(8),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"property": schema.StringAttribute{
+							Description: "Property to apply the filter to. One of /type, /prefix, /nextHop, /state, /connection/uuid, /connection/name, /_*",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("/type", "/prefix", "/nextHop", "/state", "/connection/uuid", "/connection/name", "/_*"),
+							},
+						},
+						"operator": schema.StringAttribute{
+							Description: "Operation applied to the values of the filter. One of =, !=, >, >=, <, <=, BETWEEN, NOT BETWEEN, LIKE, NOT LIKE, IN, NOT IN, ~*",
+							Required:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "List of values to apply the operation to for the specified property",
+							Required:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+						"or": schema.BoolAttribute{
+							Description: "Boolean value to specify if this filter is a part of the OR group. Has a maximum of 3 and only counts for 1 of the 8 possible filters",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"sort": schema.ListNestedAttribute{
+				Description: "The list of sort criteria for the route table search request",
+				Optional:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[SortModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							Description: "The sorting direction of the property chosen. ASC or DESC",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(fabricv4.ROUTETABLEENTRYSORTDIRECTION_ASC),
+									string(fabricv4.ROUTETABLEENTRYSORTDIRECTION_DESC),
+								),
+							},
+						},
+						"property": schema.StringAttribute{
+							Description: "The field name the sorting is performed on. One of /changeLog/createdDateTime, /changeLog/updatedDateTime, /prefix, /nextHop, /connection/name, /type",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(fabricv4.ROUTETABLEENTRYSORTBY_CHANGE_LOG_CREATED_DATE_TIME),
+									string(fabricv4.ROUTETABLEENTRYSORTBY_CHANGE_LOG_UPDATED_DATE_TIME),
+									string(fabricv4.ROUTETABLEENTRYSORTBY_PREFIX),
+									string(fabricv4.ROUTETABLEENTRYSORTBY_NEXT_HOP),
+									string(fabricv4.ROUTETABLEENTRYSORTBY_CONNECTION_NAME),
+									string(fabricv4.ROUTETABLEENTRYSORTBY_TYPE),
+								),
+							},
+						},
+					},
+				},
+			},
+			"pagination": schema.SingleNestedAttribute{
+				Description: "Pagination details for the returned route table entries",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[PaginationModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"offset": schema.Int32Attribute{
+						Description: "Index of the first item returned in the response. The default is 0",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"limit": schema.Int32Attribute{
+						Description: "Maximum number of search results returned per page. Number must be between 1 and 100, and the default is 20",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.Int32{
+							int32validator.Between(1, 100),
+						},
+					},
+					"total": schema.Int32Attribute{
+						Description: "The total number of route table entries matching the search",
+						Computed:    true,
+					},
+					"next": schema.StringAttribute{
+						Description: "The URL relative to the next item in the response",
+						Computed:    true,
+					},
+					"previous": schema.StringAttribute{
+						Description: "The URL relative to the previous item in the response",
+						Computed:    true,
+					},
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Returned list of route table entries",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[RouteEntryModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: routeEntrySchema(ctx),
+				},
+			},
+		},
+	}
+}
+
+func routeEntrySchema(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "Route table entry type like IPv4_BGP_ROUTE, IPv4_STATIC_ROUTE, IPv4_DIRECT_ROUTE, IPv6_BGP_ROUTE, IPv6_STATIC_ROUTE, IPv6_DIRECT_ROUTE",
+			Computed:    true,
+		},
+		"protocol_type": schema.StringAttribute{
+			Description: "Routing protocol the route was learned from - BGP, STATIC, DIRECT",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "Route table entry state",
+			Computed:    true,
+		},
+		"age": schema.StringAttribute{
+			Description: "Time the route has been in the route table",
+			Computed:    true,
+		},
+		"prefix": schema.StringAttribute{
+			Description: "Route prefix",
+			Computed:    true,
+		},
+		"next_hop": schema.StringAttribute{
+			Description: "Next hop address of the route",
+			Computed:    true,
+		},
+		"med": schema.Int32Attribute{
+			Description: "Multi Exit Discriminator attribute of the route",
+			Computed:    true,
+		},
+		"local_preference": schema.Int32Attribute{
+			Description: "Local preference attribute of the route",
+			Computed:    true,
+		},
+		"as_path": schema.ListAttribute{
+			Description: "AS path of the route",
+			Computed:    true,
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+		},
+		"connection": schema.SingleNestedAttribute{
+			Description: "Fabric connection the route was learned through",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[RouteConnectionModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"uuid": schema.StringAttribute{
+					Description: "Equinix-assigned connection identifier",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Connection name",
+					Computed:    true,
+				},
+			},
+		},
+		"change_log": schema.SingleNestedAttribute{
+			Description: "Details of the last change on the route table entry",
+			Computed:    true,
+			CustomType:  fwtypes.NewObjectTypeOf[ChangeLogModel](ctx),
+			Attributes: map[string]schema.Attribute{
+				"created_by": schema.StringAttribute{
+					Description: "User name of creator of the route table entry",
+					Computed:    true,
+				},
+				"created_by_full_name": schema.StringAttribute{
+					Description: "Legal name of creator of the route table entry",
+					Computed:    true,
+				},
+				"created_by_email": schema.StringAttribute{
+					Description: "Email of creator of the route table entry",
+					Computed:    true,
+				},
+				"created_date_time": schema.StringAttribute{
+					Description: "Creation time of the route table entry",
+					Computed:    true,
+				},
+				"updated_by": schema.StringAttribute{
+					Description: "User name of last updater of the route table entry",
+					Computed:    true,
+				},
+				"updated_by_full_name": schema.StringAttribute{
+					Description: "Legal name of last updater of the route table entry",
+					Computed:    true,
+				},
+				"updated_by_email": schema.StringAttribute{
+					Description: "Email of last updater of the route table entry",
+					Computed:    true,
+				},
+				"updated_date_time": schema.StringAttribute{
+					Description: "Last update time of the route table entry",
+					Computed:    true,
+				},
+				"deleted_by": schema.StringAttribute{
+					Description: "User name of deleter of the route table entry",
+					Computed:    true,
+				},
+				"deleted_by_full_name": schema.StringAttribute{
+					Description: "Legal name of deleter of the route table entry",
+					Computed:    true,
+				},
+				"deleted_by_email": schema.StringAttribute{
+					Description: "Email of deleter of the route table entry",
+					Computed:    true,
+				},
+				"deleted_date_time": schema.StringAttribute{
+					Description: "Deletion time of the route table entry",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package cloud_router_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricCloudRouterRoutesDataSource_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricCloudRouterRoutesDataSourceConfig("Routes_Test_PFCR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.equinix_fabric_cloud_router_routes.routes", "id", "equinix_fabric_cloud_router.this", "id"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_cloud_router_routes.routes", "pagination.%", "5"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_cloud_router_routes.routes", "pagination.limit", "10"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_cloud_router_routes.routes", "pagination.offset", "0"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_cloud_router_routes.routes", "data.#"),
+				),
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccFabricCloudRouterRoutesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "equinix_fabric_cloud_router" "this" {
+		type = "XF_ROUTER"
+		name = "%[1]s"
+		location {
+			metro_code = "SV"
+		}
+		order {
+			purchase_order_number = "123485"
+		}
+		notifications {
+			type   = "ALL"
+			emails = ["test@equinix.com"]
+		}
+		project {
+			project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+		}
+		account {
+			account_number = 201257
+		}
+		package {
+			code = "STANDARD"
+		}
+	}
+
+	data "equinix_fabric_cloud_router_routes" "routes" {
+		cloud_router_id = equinix_fabric_cloud_router.this.id
+		filters = [
+			{
+				property = "/type"
+				operator = "IN"
+				values   = ["IPv4_BGP_ROUTE", "IPv4_DIRECT_ROUTE"]
+			}
+		]
+		sort = [
+			{
+				direction = "ASC"
+				property  = "/prefix"
+			}
+		]
+		pagination = {
+			limit  = 10
+			offset = 0
+		}
+	}
+	`, name)
+}
@@ -0,0 +1,637 @@
+package cloud_router
+
+import (
+	"context"
+	"slices"
+
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceRoutesModel struct {
+	ID            types.String                                     `tfsdk:"id"`
+	CloudRouterID types.String                                     `tfsdk:"cloud_router_id"`
+	Filters       fwtypes.ListNestedObjectValueOf[FilterModel]     `tfsdk:"filters"`
+	Sort          fwtypes.ListNestedObjectValueOf[SortModel]       `tfsdk:"sort"`
+	Pagination    fwtypes.ObjectValueOf[PaginationModel]           `tfsdk:"pagination"`
+	Data          fwtypes.ListNestedObjectValueOf[RouteEntryModel] `tfsdk:"data"`
+}
+
+type FilterModel struct {
+	Property types.String                      `tfsdk:"property"`
+	Operator types.String                      `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+	Or       types.Bool                        `tfsdk:"or"`
+}
+
+type SortModel struct {
+	Direction types.String `tfsdk:"direction"`
+	Property  types.String `tfsdk:"property"`
+}
+
+type PaginationModel struct {
+	Offset   types.Int32  `tfsdk:"offset"`
+	Limit    types.Int32  `tfsdk:"limit"`
+	Total    types.Int32  `tfsdk:"total"`
+	Next     types.String `tfsdk:"next"`
+	Previous types.String `tfsdk:"previous"`
+}
+
+type CommandResourceModel struct {
+	ID            types.String                                `tfsdk:"id"`
+	Timeouts      timeouts.Value                              `tfsdk:"timeouts"`
+	CloudRouterID types.String                                `tfsdk:"cloud_router_id"`
+	Type          types.String                                `tfsdk:"type"`
+	Name          types.String                                `tfsdk:"name"`
+	Description   types.String                                `tfsdk:"description"`
+	ProjectID     types.String                                `tfsdk:"project_id"`
+	Request       fwtypes.ObjectValueOf[CommandRequestModel]  `tfsdk:"request"`
+	UUID          types.String                                `tfsdk:"uuid"`
+	Href          types.String                                `tfsdk:"href"`
+	State         types.String                                `tfsdk:"state"`
+	Response      fwtypes.ObjectValueOf[CommandResponseModel] `tfsdk:"response"`
+	ChangeLog     fwtypes.ObjectValueOf[ChangeLogModel]       `tfsdk:"change_log"`
+}
+
+type CommandRequestModel struct {
+	Destination        types.String `tfsdk:"destination"`
+	SourceConnectionID types.String `tfsdk:"source_connection_id"`
+	Timeout            types.Int32  `tfsdk:"timeout"`
+	DataBytes          types.Int32  `tfsdk:"data_bytes"`
+	Interval           types.Int32  `tfsdk:"interval"`
+	Count              types.Int32  `tfsdk:"count"`
+}
+
+type CommandResponseModel struct {
+	Output types.String                           `tfsdk:"output"`
+	Ping   fwtypes.ObjectValueOf[PingOutputModel] `tfsdk:"ping"`
+}
+
+type PingOutputModel struct {
+	DestinationIP      types.String                                       `tfsdk:"destination_ip"`
+	DestinationName    types.String                                       `tfsdk:"destination_name"`
+	DataBytes          types.Int32                                        `tfsdk:"data_bytes"`
+	PacketsTransmitted types.Int32                                        `tfsdk:"packets_transmitted"`
+	PacketsReceived    types.Int32                                        `tfsdk:"packets_received"`
+	PacketsLossPercent types.Float64                                      `tfsdk:"packets_loss_percent"`
+	RttMin             types.Float64                                      `tfsdk:"rtt_min"`
+	RttAvg             types.Float64                                      `tfsdk:"rtt_avg"`
+	RttMax             types.Float64                                      `tfsdk:"rtt_max"`
+	RttStdDev          types.Float64                                      `tfsdk:"rtt_std_dev"`
+	Responses          fwtypes.ListNestedObjectValueOf[PingResponseModel] `tfsdk:"responses"`
+}
+
+type PingResponseModel struct {
+	Bytes   types.Int32   `tfsdk:"bytes"`
+	IP      types.String  `tfsdk:"ip"`
+	IcmpSeq types.Int32   `tfsdk:"icmp_seq"`
+	TTL     types.Int32   `tfsdk:"ttl"`
+	Time    types.Float64 `tfsdk:"time"`
+}
+
+type ActionResourceModel struct {
+	ID            types.String                                `tfsdk:"id"`
+	Timeouts      timeouts.Value                              `tfsdk:"timeouts"`
+	CloudRouterID types.String                                `tfsdk:"cloud_router_id"`
+	Type          types.String                                `tfsdk:"type"`
+	ConnectionID  types.String                                `tfsdk:"connection_id"`
+	Triggers      types.Map                                   `tfsdk:"triggers"`
+	UUID          types.String                                `tfsdk:"uuid"`
+	Href          types.String                                `tfsdk:"href"`
+	Description   types.String                                `tfsdk:"description"`
+	State         types.String                                `tfsdk:"state"`
+	Operation     fwtypes.ObjectValueOf[ActionOperationModel] `tfsdk:"operation"`
+	ChangeLog     fwtypes.ObjectValueOf[ChangeLogModel]       `tfsdk:"change_log"`
+}
+
+type ActionOperationModel struct {
+	BgpIpv4RoutesCount types.Int32 `tfsdk:"bgp_ipv4_routes_count"`
+	BgpIpv6RoutesCount types.Int32 `tfsdk:"bgp_ipv6_routes_count"`
+}
+
+type RouteEntryModel struct {
+	Type            types.String                                `tfsdk:"type"`
+	ProtocolType    types.String                                `tfsdk:"protocol_type"`
+	State           types.String                                `tfsdk:"state"`
+	Age             types.String                                `tfsdk:"age"`
+	Prefix          types.String                                `tfsdk:"prefix"`
+	NextHop         types.String                                `tfsdk:"next_hop"`
+	MED             types.Int32                                 `tfsdk:"med"`
+	LocalPreference types.Int32                                 `tfsdk:"local_preference"`
+	AsPath          fwtypes.ListValueOf[types.String]           `tfsdk:"as_path"`
+	Connection      fwtypes.ObjectValueOf[RouteConnectionModel] `tfsdk:"connection"`
+	ChangeLog       fwtypes.ObjectValueOf[ChangeLogModel]       `tfsdk:"change_log"`
+}
+
+type RouteConnectionModel struct {
+	UUID types.String `tfsdk:"uuid"`
+	Name types.String `tfsdk:"name"`
+}
+
+type ChangeLogModel struct {
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedByFullName types.String `tfsdk:"created_by_full_name"`
+	CreatedByEmail    types.String `tfsdk:"created_by_email"`
+	CreatedDateTime   types.String `tfsdk:"created_date_time"`
+	UpdatedBy         types.String `tfsdk:"updated_by"`
+	UpdatedByFullName types.String `tfsdk:"updated_by_full_name"`
+	UpdatedByEmail    types.String `tfsdk:"updated_by_email"`
+	UpdatedDateTime   types.String `tfsdk:"updated_date_time"`
+	DeletedBy         types.String `tfsdk:"deleted_by"`
+	DeletedByFullName types.String `tfsdk:"deleted_by_full_name"`
+	DeletedByEmail    types.String `tfsdk:"deleted_by_email"`
+	DeletedDateTime   types.String `tfsdk:"deleted_date_time"`
+}
+
+func (m *DataSourceRoutesModel) parse(ctx context.Context, routesResponse *fabricv4.RouteTableEntrySearchResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// An empty route table is a valid answer; it is what check blocks and
+	// postconditions on route propagation need to see
+	routes := routesResponse.GetData()
+	data := make([]RouteEntryModel, len(routes))
+	for index, route := range routes {
+		var routeModel RouteEntryModel
+		diags = routeModel.parse(ctx, &route)
+		if diags.HasError() {
+			return diags
+		}
+		data[index] = routeModel
+	}
+
+	responsePagination := routesResponse.GetPagination()
+	pagination := PaginationModel{
+		Offset:   types.Int32Value(responsePagination.GetOffset()),
+		Limit:    types.Int32Value(responsePagination.GetLimit()),
+		Total:    types.Int32Value(responsePagination.GetTotal()),
+		Next:     types.StringValue(responsePagination.GetNext()),
+		Previous: types.StringValue(responsePagination.GetPrevious()),
+	}
+
+	m.ID = types.StringValue(m.CloudRouterID.ValueString())
+	m.Pagination = fwtypes.NewObjectValueOf[PaginationModel](ctx, &pagination)
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[RouteEntryModel](ctx, data)
+
+	return diags
+}
+
+func (m *RouteEntryModel) parse(ctx context.Context, route *fabricv4.RouteTableEntry) diag.Diagnostics {
+	m.Type = types.StringValue(string(route.GetType()))
+	m.ProtocolType = types.StringValue(string(route.GetProtocolType()))
+	m.State = types.StringValue(string(route.GetState()))
+	m.Age = types.StringValue(route.GetAge())
+	m.Prefix = types.StringValue(route.GetPrefix())
+	m.NextHop = types.StringValue(route.GetNextHop())
+	m.MED = types.Int32Value(route.GetMED())
+	m.LocalPreference = types.Int32Value(route.GetLocalPreference())
+	asPath, diags := parseAsPath(ctx, route.GetAsPath())
+	if diags.HasError() {
+		return diags
+	}
+	m.AsPath = asPath
+
+	routeConnection := route.GetConnection()
+	connection := RouteConnectionModel{
+		UUID: types.StringValue(routeConnection.GetUuid()),
+		Name: types.StringValue(routeConnection.GetName()),
+	}
+	m.Connection = fwtypes.NewObjectValueOf[RouteConnectionModel](ctx, &connection)
+
+	m.ChangeLog = parseChangeLog(ctx, route.GetChangeLog())
+
+	return diags
+}
+
+func parseAsPath(ctx context.Context, asPath []string) (fwtypes.ListValueOf[types.String], diag.Diagnostics) {
+	asPathList := make([]attr.Value, len(asPath))
+	for i, as := range asPath {
+		asPathList[i] = types.StringValue(as)
+	}
+	asPathValue, diags := fwtypes.NewListValueOf[types.String](ctx, asPathList)
+	if diags.HasError() {
+		return fwtypes.NewListValueOfNull[types.String](ctx), diags
+	}
+	return asPathValue, diags
+}
+
+func parseChangeLog(ctx context.Context, changeLog fabricv4.Changelog) fwtypes.ObjectValueOf[ChangeLogModel] {
+	changeLogModel := newChangeLogModel(changeLog)
+	return fwtypes.NewObjectValueOf[ChangeLogModel](ctx, &changeLogModel)
+}
+
+func newChangeLogModel(changeLog fabricv4.Changelog) ChangeLogModel {
+	return ChangeLogModel{
+		CreatedBy:         types.StringValue(changeLog.GetCreatedBy()),
+		CreatedByFullName: types.StringValue(changeLog.GetCreatedByFullName()),
+		CreatedByEmail:    types.StringValue(changeLog.GetCreatedByEmail()),
+		CreatedDateTime:   types.StringValue(changeLog.GetCreatedDateTime().Format(fabric.TimeFormat)),
+		UpdatedBy:         types.StringValue(changeLog.GetUpdatedBy()),
+		UpdatedByFullName: types.StringValue(changeLog.GetUpdatedByFullName()),
+		UpdatedByEmail:    types.StringValue(changeLog.GetUpdatedByEmail()),
+		UpdatedDateTime:   types.StringValue(changeLog.GetUpdatedDateTime().Format(fabric.TimeFormat)),
+		DeletedBy:         types.StringValue(changeLog.GetDeletedBy()),
+		DeletedByFullName: types.StringValue(changeLog.GetDeletedByFullName()),
+		DeletedByEmail:    types.StringValue(changeLog.GetDeletedByEmail()),
+		DeletedDateTime:   types.StringValue(changeLog.GetDeletedDateTime().Format(fabric.TimeFormat)),
+	}
+}
+
+func (m *CommandResourceModel) parse(ctx context.Context, command *fabricv4.CloudRouterCommand) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(command.GetUuid())
+	m.UUID = types.StringValue(command.GetUuid())
+	m.Type = types.StringValue(string(command.GetType()))
+	m.Href = types.StringValue(command.GetHref())
+	m.State = types.StringValue(string(command.GetState()))
+	m.Name = types.StringValue(command.GetName())
+	m.Description = types.StringValue(command.GetDescription())
+
+	commandResponse := command.GetResponse()
+	response := CommandResponseModel{
+		Output: types.StringValue(commandResponse.GetOutput()),
+		Ping:   fwtypes.NewObjectValueOfNull[PingOutputModel](ctx),
+	}
+	if commandResponse.OutputStructuredPing != nil {
+		ping := commandResponse.GetOutputStructuredPing()
+		pingResponses := make([]PingResponseModel, len(ping.GetResponses()))
+		for i, pingResponse := range ping.GetResponses() {
+			pingResponses[i] = PingResponseModel{
+				Bytes:   types.Int32Value(pingResponse.GetBytes()),
+				IP:      types.StringValue(pingResponse.GetIp()),
+				IcmpSeq: types.Int32Value(pingResponse.GetIcmpSeq()),
+				TTL:     types.Int32Value(pingResponse.GetTtl()),
+				Time:    types.Float64Value(float64(pingResponse.GetTime())),
+			}
+		}
+		pingOutput := PingOutputModel{
+			DestinationIP:      types.StringValue(ping.GetDestinationIp()),
+			DestinationName:    types.StringValue(ping.GetDestinationName()),
+			DataBytes:          types.Int32Value(ping.GetDataBytes()),
+			PacketsTransmitted: types.Int32Value(ping.GetPacketsTransmitted()),
+			PacketsReceived:    types.Int32Value(ping.GetPacketsReceived()),
+			PacketsLossPercent: types.Float64Value(float64(ping.GetPacketsLossPercent())),
+			RttMin:             types.Float64Value(float64(ping.GetRttMin())),
+			RttAvg:             types.Float64Value(float64(ping.GetRttAvg())),
+			RttMax:             types.Float64Value(float64(ping.GetRttMax())),
+			RttStdDev:          types.Float64Value(float64(ping.GetRttStdDev())),
+			Responses:          fwtypes.NewListNestedObjectValueOfValueSlice[PingResponseModel](ctx, pingResponses),
+		}
+		response.Ping = fwtypes.NewObjectValueOf[PingOutputModel](ctx, &pingOutput)
+	}
+	m.Response = fwtypes.NewObjectValueOf[CommandResponseModel](ctx, &response)
+	m.ChangeLog = parseChangeLog(ctx, command.GetChangeLog())
+
+	return diags
+}
+
+func (m *ActionResourceModel) parse(ctx context.Context, action *fabricv4.CloudRouterActionResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(action.GetUuid())
+	m.UUID = types.StringValue(action.GetUuid())
+	m.Type = types.StringValue(string(action.GetType()))
+	m.Href = types.StringValue(action.GetHref())
+	m.Description = types.StringValue(action.GetDescription())
+	m.State = types.StringValue(string(action.GetState()))
+
+	actionOperation := action.GetOperation()
+	operation := ActionOperationModel{
+		BgpIpv4RoutesCount: types.Int32Value(actionOperation.GetBgpIpv4RoutesCount()),
+		BgpIpv6RoutesCount: types.Int32Value(actionOperation.GetBgpIpv6RoutesCount()),
+	}
+	m.Operation = fwtypes.NewObjectValueOf[ActionOperationModel](ctx, &operation)
+	m.ChangeLog = parseChangeLog(ctx, action.GetChangeLog())
+
+	return diags
+}
+
+type ResourceModel struct {
+	ID                      types.String                                                  `tfsdk:"id"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+	UUID                    types.String                                                  `tfsdk:"uuid"`
+	Href                    types.String                                                  `tfsdk:"href"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	Description             types.String                                                  `tfsdk:"description"`
+	Type                    types.String                                                  `tfsdk:"type"`
+	State                   types.String                                                  `tfsdk:"state"`
+	EquinixAsn              types.Int64                                                   `tfsdk:"equinix_asn"`
+	ConnectionsCount        types.Int64                                                   `tfsdk:"connections_count"`
+	ChangeLog               fwtypes.ListNestedObjectValueOf[ChangeLogModel]               `tfsdk:"change_log"`
+	Package                 fwtypes.ListNestedObjectValueOf[PackageModel]                 `tfsdk:"package"`
+	Location                fwtypes.ListNestedObjectValueOf[LocationModel]                `tfsdk:"location"`
+	Project                 fwtypes.ListNestedObjectValueOf[ProjectModel]                 `tfsdk:"project"`
+	MarketplaceSubscription fwtypes.ListNestedObjectValueOf[MarketplaceSubscriptionModel] `tfsdk:"marketplace_subscription"`
+	Account                 fwtypes.ListNestedObjectValueOf[AccountModel]                 `tfsdk:"account"`
+	Order                   fwtypes.ListNestedObjectValueOf[OrderModel]                   `tfsdk:"order"`
+	Notifications           fwtypes.ListNestedObjectValueOf[NotificationModel]            `tfsdk:"notifications"`
+}
+
+type PackageModel struct {
+	Code types.String `tfsdk:"code"`
+}
+
+type LocationModel struct {
+	Region    types.String `tfsdk:"region"`
+	MetroName types.String `tfsdk:"metro_name"`
+	MetroCode types.String `tfsdk:"metro_code"`
+	IBX       types.String `tfsdk:"ibx"`
+}
+
+type ProjectModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Href      types.String `tfsdk:"href"`
+}
+
+type MarketplaceSubscriptionModel struct {
+	Type types.String `tfsdk:"type"`
+	UUID types.String `tfsdk:"uuid"`
+}
+
+type AccountModel struct {
+	AccountNumber types.Int64 `tfsdk:"account_number"`
+}
+
+type OrderModel struct {
+	PurchaseOrderNumber types.String `tfsdk:"purchase_order_number"`
+	BillingTier         types.String `tfsdk:"billing_tier"`
+	OrderID             types.String `tfsdk:"order_id"`
+	OrderNumber         types.String `tfsdk:"order_number"`
+	TermLength          types.Int64  `tfsdk:"term_length"`
+}
+
+type NotificationModel struct {
+	Type         types.String                      `tfsdk:"type"`
+	SendInterval types.String                      `tfsdk:"send_interval"`
+	Emails       fwtypes.ListValueOf[types.String] `tfsdk:"emails"`
+}
+
+func (m *ResourceModel) parse(ctx context.Context, cloudRouter *fabricv4.CloudRouter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(cloudRouter.GetUuid())
+	m.UUID = types.StringValue(cloudRouter.GetUuid())
+	m.Href = types.StringValue(cloudRouter.GetHref())
+	m.Name = types.StringValue(cloudRouter.GetName())
+	m.Type = types.StringValue(string(cloudRouter.GetType()))
+	m.State = types.StringValue(string(cloudRouter.GetState()))
+	m.EquinixAsn = types.Int64Value(cloudRouter.GetEquinixAsn())
+	m.ConnectionsCount = types.Int64Value(int64(cloudRouter.GetConnectionsCount()))
+
+	changeLog := newChangeLogModel(cloudRouter.GetChangeLog())
+	m.ChangeLog = fwtypes.NewListNestedObjectValueOfPtr(ctx, &changeLog)
+
+	cloudRouterPackage := cloudRouter.GetPackage()
+	m.Package = fwtypes.NewListNestedObjectValueOfPtr(ctx, &PackageModel{
+		Code: types.StringValue(string(cloudRouterPackage.GetCode())),
+	})
+
+	// IBX and project href are not returned by the API; keep whatever the
+	// configuration holds so that the plan is honoured
+	location := cloudRouter.GetLocation()
+	locationModel := LocationModel{
+		Region:    types.StringValue(location.GetRegion()),
+		MetroName: types.StringValue(location.GetMetroName()),
+		MetroCode: types.StringValue(location.GetMetroCode()),
+		IBX:       types.StringValue(""),
+	}
+	if existing, d := m.Location.ToPtr(ctx); !d.HasError() && existing != nil && !existing.IBX.IsUnknown() && !existing.IBX.IsNull() {
+		locationModel.IBX = existing.IBX
+	}
+	m.Location = fwtypes.NewListNestedObjectValueOfPtr(ctx, &locationModel)
+
+	project := cloudRouter.GetProject()
+	projectModel := ProjectModel{
+		ProjectID: types.StringValue(project.GetProjectId()),
+		Href:      types.StringValue(""),
+	}
+	if existing, d := m.Project.ToPtr(ctx); !d.HasError() && existing != nil && !existing.Href.IsUnknown() && !existing.Href.IsNull() {
+		projectModel.Href = existing.Href
+	}
+	m.Project = fwtypes.NewListNestedObjectValueOfPtr(ctx, &projectModel)
+
+	// The marketplace subscription is only reported when the Cloud Router
+	// was ordered through one, so it is always taken from the API
+	subscriptions := []MarketplaceSubscriptionModel{}
+	if subscription := cloudRouter.GetMarketplaceSubscription(); subscription.GetUuid() != "" {
+		subscriptions = append(subscriptions, MarketplaceSubscriptionModel{
+			Type: types.StringValue(string(subscription.GetType())),
+			UUID: types.StringValue(subscription.GetUuid()),
+		})
+	}
+	m.MarketplaceSubscription = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, subscriptions)
+
+	// The account and order are always returned by the API. They are filled
+	// in unless the block was planned empty, which only happens when the
+	// configuration leaves it out: the number of blocks in the plan has to
+	// match the configuration. After an import they are still null and get
+	// the values from the API
+	if !m.Account.IsEmpty() {
+		account := cloudRouter.GetAccount()
+		m.Account = fwtypes.NewListNestedObjectValueOfPtr(ctx, &AccountModel{
+			AccountNumber: types.Int64Value(account.GetAccountNumber()),
+		})
+	}
+
+	if !m.Order.IsEmpty() {
+		order := cloudRouter.GetOrder()
+		m.Order = fwtypes.NewListNestedObjectValueOfPtr(ctx, &OrderModel{
+			PurchaseOrderNumber: types.StringValue(order.GetPurchaseOrderNumber()),
+			BillingTier:         types.StringValue(order.GetBillingTier()),
+			OrderID:             types.StringValue(order.GetOrderId()),
+			OrderNumber:         types.StringValue(order.GetOrderNumber()),
+			TermLength:          types.Int64Value(int64(order.GetTermLength())),
+		})
+	}
+
+	notifications := cloudRouter.GetNotifications()
+	notificationModels := make([]NotificationModel, len(notifications))
+	for i, notification := range notifications {
+		emails, d := fwtypes.NewListValueOf[types.String](ctx, framework.StringSliceToAttrValue(notification.GetEmails()))
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		sendInterval := types.StringNull()
+		if notification.GetSendInterval() != "" {
+			sendInterval = types.StringValue(notification.GetSendInterval())
+		}
+		notificationModels[i] = NotificationModel{
+			Type:         types.StringValue(string(notification.GetType())),
+			SendInterval: sendInterval,
+			Emails:       emails,
+		}
+	}
+	m.Notifications = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, notificationModels)
+
+	return diags
+}
+
+func buildCreateRequest(ctx context.Context, plan ResourceModel) (fabricv4.CloudRouterPostRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := fabricv4.CloudRouterPostRequest{}
+
+	request.SetName(plan.Name.ValueString())
+	request.SetType(fabricv4.CloudRouterPostRequestType(plan.Type.ValueString()))
+
+	notifications, diags := buildNotifications(ctx, plan.Notifications)
+	if diags.HasError() {
+		return request, diags
+	}
+	request.SetNotifications(notifications)
+
+	cloudRouterPackage, diags := plan.Package.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	if cloudRouterPackage != nil {
+		request.SetPackage(fabricv4.CloudRouterPostRequestPackage{
+			Code: fabricv4.CloudRouterPostRequestPackageCode(cloudRouterPackage.Code.ValueString()),
+		})
+	}
+
+	location, diags := plan.Location.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	if location != nil {
+		simplifiedLocation := fabricv4.SimplifiedLocationWithoutIBX{}
+		simplifiedLocation.SetMetroCode(location.MetroCode.ValueString())
+		request.SetLocation(simplifiedLocation)
+	}
+
+	project, diags := plan.Project.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	if project != nil {
+		request.SetProject(fabricv4.Project{ProjectId: project.ProjectID.ValueString()})
+	}
+
+	account, diags := plan.Account.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	if account != nil {
+		simplifiedAccount := fabricv4.SimplifiedAccount{}
+		simplifiedAccount.SetAccountNumber(account.AccountNumber.ValueInt64())
+		request.SetAccount(simplifiedAccount)
+	}
+
+	order, diags := plan.Order.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	if order != nil {
+		fabricOrder := fabricv4.Order{}
+		if purchaseOrderNumber := order.PurchaseOrderNumber.ValueString(); purchaseOrderNumber != "" {
+			fabricOrder.SetPurchaseOrderNumber(purchaseOrderNumber)
+		}
+		if billingTier := order.BillingTier.ValueString(); billingTier != "" {
+			fabricOrder.SetBillingTier(billingTier)
+		}
+		if orderID := order.OrderID.ValueString(); orderID != "" {
+			fabricOrder.SetOrderId(orderID)
+		}
+		if orderNumber := order.OrderNumber.ValueString(); orderNumber != "" {
+			fabricOrder.SetOrderNumber(orderNumber)
+		}
+		if termLength := order.TermLength.ValueInt64(); termLength >= 1 {
+			fabricOrder.SetTermLength(int32(termLength))
+		}
+		request.SetOrder(fabricOrder)
+	}
+
+	subscription, diags := plan.MarketplaceSubscription.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	if subscription != nil {
+		marketplaceSubscription := fabricv4.MarketplaceSubscription{}
+		marketplaceSubscription.SetUuid(subscription.UUID.ValueString())
+		if subscriptionType := subscription.Type.ValueString(); subscriptionType != "" {
+			marketplaceSubscription.SetType(fabricv4.MarketplaceSubscriptionType(subscriptionType))
+		}
+		request.SetMarketplaceSubscription(marketplaceSubscription)
+	}
+
+	return request, diags
+}
+
+func buildNotifications(ctx context.Context, notificationsValue fwtypes.ListNestedObjectValueOf[NotificationModel]) ([]fabricv4.SimplifiedNotification, diag.Diagnostics) {
+	notificationModels, diags := notificationsValue.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	notifications := make([]fabricv4.SimplifiedNotification, len(notificationModels))
+	for i, notificationModel := range notificationModels {
+		var emails []string
+		diags = notificationModel.Emails.ElementsAs(ctx, &emails, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+		notification := fabricv4.SimplifiedNotification{}
+		notification.SetType(fabricv4.SimplifiedNotificationType(notificationModel.Type.ValueString()))
+		if sendInterval := notificationModel.SendInterval.ValueString(); sendInterval != "" {
+			notification.SetSendInterval(sendInterval)
+		}
+		notification.SetEmails(emails)
+		notifications[i] = notification
+	}
+	return notifications, diags
+}
+
+// buildUpdateRequests returns one change operation per updatable attribute,
+// since the API only accepts a single operation per request
+func buildUpdateRequests(ctx context.Context, existing *fabricv4.CloudRouter, plan ResourceModel) ([][]fabricv4.CloudRouterChangeOperation, diag.Diagnostics) {
+	var changeOps [][]fabricv4.CloudRouterChangeOperation
+
+	if name := plan.Name.ValueString(); existing.GetName() != name {
+		changeOps = append(changeOps, []fabricv4.CloudRouterChangeOperation{{Op: "replace", Path: "/name", Value: name}})
+	}
+
+	cloudRouterPackage, diags := plan.Package.ToPtr(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	existingPackage := existing.GetPackage()
+	if cloudRouterPackage != nil && string(existingPackage.GetCode()) != cloudRouterPackage.Code.ValueString() {
+		changeOps = append(changeOps, []fabricv4.CloudRouterChangeOperation{{Op: "replace", Path: "/package/code", Value: cloudRouterPackage.Code.ValueString()}})
+	}
+
+	notifications, diags := buildNotifications(ctx, plan.Notifications)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if notificationsChanged(existing.GetNotifications(), notifications) {
+		changeOps = append(changeOps, []fabricv4.CloudRouterChangeOperation{{Op: "replace", Path: "/notifications", Value: notifications}})
+	}
+
+	return changeOps, diags
+}
+
+func notificationsChanged(existing, planned []fabricv4.SimplifiedNotification) bool {
+	if len(existing) != len(planned) {
+		return true
+	}
+	for i := range existing {
+		if existing[i].GetType() != planned[i].GetType() || existing[i].GetSendInterval() != planned[i].GetSendInterval() {
+			return true
+		}
+		existingEmails, plannedEmails := slices.Clone(existing[i].GetEmails()), slices.Clone(planned[i].GetEmails())
+		slices.Sort(existingEmails)
+		slices.Sort(plannedEmails)
+		if !slices.Equal(existingEmails, plannedEmails) {
+			return true
+		}
+	}
+	return false
+}
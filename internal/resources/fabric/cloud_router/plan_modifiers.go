@@ -0,0 +1,37 @@
+package cloud_router
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// requiresReplaceIfKnownChange replaces the Cloud Router only when both the
+// state and the plan hold a known value that differs. Blocks that were left
+// empty, like after an import or a migration from the SDKv2 resource where
+// they were Optional and Computed, can be filled in without a replacement
+type requiresReplaceIfKnownChange struct{}
+
+func (m requiresReplaceIfKnownChange) Description(_ context.Context) string {
+	return "Requires the resource to be replaced if the known prior value is changed."
+}
+
+func (m requiresReplaceIfKnownChange) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m requiresReplaceIfKnownChange) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	resp.RequiresReplace = knownValueChanged(req.StateValue, req.PlanValue)
+}
+
+func (m requiresReplaceIfKnownChange) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	resp.RequiresReplace = knownValueChanged(req.StateValue, req.PlanValue)
+}
+
+func knownValueChanged(state, plan attr.Value) bool {
+	if state.IsNull() || state.IsUnknown() || plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+	return !state.Equal(plan)
+}
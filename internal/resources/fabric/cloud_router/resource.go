@@ -0,0 +1,284 @@
+package cloud_router
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_cloud_router",
+			},
+		),
+	}
+}
+
+type Resource struct {
+	framework.BaseResource
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	createRequest, diags := buildCreateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudRouter, _, err := client.CloudRoutersApi.CreateCloudRouter(ctx).CloudRouterPostRequest(createRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed creating Cloud Router", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	createWaiter := getCreateUpdateWaiter(ctx, client, cloudRouter.GetUuid(), createTimeout)
+	cloudRouterChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed creating Cloud Router %s", cloudRouter.GetUuid()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, cloudRouterChecked.(*fabricv4.CloudRouter))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	cloudRouter, httpResp, err := client.CloudRoutersApi.GetCloudRouterByUuid(ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Cloud Router %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// A deprovisioned Cloud Router can still be retrieved for some time
+	if cloudRouter.GetState() == fabricv4.CLOUDROUTERACCESSPOINTSTATE_DEPROVISIONED {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, cloudRouter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var state, plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Updates are rejected while the Cloud Router is still provisioning
+	cloudRouterChecked, err := r.Meta.WaitForStateContext(ctx, getCreateUpdateWaiter(ctx, client, id, updateTimeout))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed updating Cloud Router %s", id), err.Error())
+		return
+	}
+	cloudRouter := cloudRouterChecked.(*fabricv4.CloudRouter)
+
+	updates, diags := buildUpdateRequests(ctx, cloudRouter, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, update := range updates {
+		_, _, err = client.CloudRoutersApi.UpdateCloudRouterByUuid(ctx, id).CloudRouterChangeOperation(update).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed updating Cloud Router %s", id), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+
+		cloudRouterChecked, err = r.Meta.WaitForStateContext(ctx, getCreateUpdateWaiter(ctx, client, id, updateTimeout))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed updating Cloud Router %s", id), err.Error())
+			return
+		}
+		cloudRouter = cloudRouterChecked.(*fabricv4.CloudRouter)
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, cloudRouter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+
+	deleteResp, err := client.CloudRoutersApi.DeleteCloudRouterByUuid(ctx, id).Execute()
+	if err != nil {
+		if deleteResp != nil && deleteResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
+			if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
+				// EQ-3040055 = There is an existing update in REQUESTED state
+				if equinix_errors.HasErrorCode(fabricErrs, "EQ-3040055") {
+					return
+				}
+			}
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed deleting Cloud Router %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	deleteWaiter := GetDeleteWaiter(ctx, client, id, deleteTimeout)
+	if _, err = r.Meta.WaitForStateContext(ctx, deleteWaiter); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed deleting Cloud Router %s", id), err.Error())
+	}
+}
+
+func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_PROVISIONING),
+			string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_REPROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_PROVISIONED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			cloudRouter, _, err := client.CloudRoutersApi.GetCloudRouterByUuid(ctx, id).Execute()
+			if err != nil {
+				return nil, "", equinix_errors.FormatFabricError(err)
+			}
+			return cloudRouter, string(cloudRouter.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
+
+// GetDeleteWaiter is exported for the acceptance tests to check that Cloud
+// Routers are gone once destroyed
+func GetDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_PROVISIONED),
+			string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_DEPROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.CLOUDROUTERACCESSPOINTSTATE_DEPROVISIONED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			cloudRouter, _, err := client.CloudRoutersApi.GetCloudRouterByUuid(ctx, id).Execute()
+			if err != nil {
+				return nil, "", equinix_errors.FormatFabricError(err)
+			}
+			return cloudRouter, string(cloudRouter.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
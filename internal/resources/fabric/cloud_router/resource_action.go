@@ -0,0 +1,162 @@
+package cloud_router
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func NewActionResource() resource.Resource {
+	return &ActionResource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_cloud_router_action",
+			},
+		),
+	}
+}
+
+type ActionResource struct {
+	framework.BaseResource
+}
+
+func (r *ActionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = actionResourceSchema(ctx)
+}
+
+func (r *ActionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	cloudRouterID := plan.CloudRouterID.ValueString()
+
+	actionRequest := fabricv4.CloudRouterActionRequest{}
+	actionRequest.SetType(fabricv4.CloudRouterActionType(plan.Type.ValueString()))
+	if connectionID := plan.ConnectionID.ValueString(); connectionID != "" {
+		actionRequest.SetConnection(fabricv4.RouterActionsConnection{Uuid: &connectionID})
+	}
+
+	action, _, err := client.CloudRoutersApi.CreateCloudRouterAction(ctx, cloudRouterID).CloudRouterActionRequest(actionRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed requesting Cloud Router action", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	createWaiter := getActionCreateWaiter(ctx, client, cloudRouterID, action.GetUuid(), createTimeout)
+	actionChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed completing Cloud Router action %s", action.GetUuid()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, actionChecked.(*fabricv4.CloudRouterActionResponse))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ActionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ActionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	action, httpResp, err := client.CloudRoutersApi.GetCloudRouterActionsByUuid(ctx, state.CloudRouterID.ValueString(), id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Cloud Router action %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, action)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only persists changes to timeouts; every other argument requests
+// the action again
+func (r *ActionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var state, plan ActionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the computed attributes from the prior state, the API is not
+	// called again
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete only removes the action from the Terraform state; completed Cloud
+// Router actions cannot be deleted through the API
+func (r *ActionResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func getActionCreateWaiter(ctx context.Context, client *fabricv4.APIClient, cloudRouterID, actionID string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.CLOUDROUTERACTIONSTATE_PENDING),
+		},
+		Target: []string{
+			string(fabricv4.CLOUDROUTERACTIONSTATE_SUCCEEDED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			action, _, err := client.CloudRoutersApi.GetCloudRouterActionsByUuid(ctx, cloudRouterID, actionID).Execute()
+			if err != nil {
+				return 0, "", equinix_errors.FormatFabricError(err)
+			}
+			return action, string(action.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+}
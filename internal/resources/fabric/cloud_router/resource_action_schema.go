@@ -0,0 +1,120 @@
+package cloud_router
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func actionResourceSchema(ctx context.Context) schema.Schema {
+	actionTypes := make([]string, len(fabricv4.AllowedCloudRouterActionTypeEnumValues))
+	for i, actionType := range fabricv4.AllowedCloudRouterActionTypeEnumValues {
+		actionTypes[i] = string(actionType)
+	}
+
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows requesting actions, such as a BGP session reset or a route table refresh, on an Equinix Fabric Cloud Router. The action is requested on create and waited on until it completes; like terraform_data, changing triggers requests the action again. Destroying the resource only removes it from the Terraform state
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-intro.htm#HowItWorks
+* API: https://developer.equinix.com/catalog/fabricv4#operation/createCloudRouterAction`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"cloud_router_id": schema.StringAttribute{
+				Description: "Equinix-assigned Cloud Router identifier to request the action on",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Cloud Router action type like BGP_SESSION_STATUS_UPDATE, ROUTE_TABLE_ENTRY_UPDATE, RECEIVED_ROUTE_ENTRY_UPDATE, ADVERTISED_ROUTE_ENTRY_UPDATE",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(actionTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Equinix-assigned identifier of the Cloud Router connection the action applies to",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will request the action again",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Equinix-assigned Cloud Router action identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"href": schema.StringAttribute{
+				Description: "Cloud Router action URI",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Cloud Router action description",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Cloud Router action state - PENDING, SUCCEEDED, FAILED",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation": schema.SingleNestedAttribute{
+				Description: "Operational data returned by the Cloud Router action",
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[ActionOperationModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"bgp_ipv4_routes_count": schema.Int32Attribute{
+						Description: "IPv4 route count",
+						Computed:    true,
+					},
+					"bgp_ipv6_routes_count": schema.Int32Attribute{
+						Description: "IPv6 route count",
+						Computed:    true,
+					},
+				},
+			},
+			"change_log": changeLogSchema(ctx, "Cloud Router action"),
+		},
+	}
+}
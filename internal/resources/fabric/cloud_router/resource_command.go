@@ -0,0 +1,298 @@
+package cloud_router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_cloud_router_command",
+			},
+		),
+	}
+}
+
+type CommandResource struct {
+	framework.BaseResource
+}
+
+func (r *CommandResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = commandResourceSchema(ctx)
+}
+
+func (r *CommandResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan CommandResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	cloudRouterID := plan.CloudRouterID.ValueString()
+
+	createRequest, diags := buildCommandCreateRequest(ctx, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	command, _, err := client.CloudRoutersApi.CreateCloudRouterCommand(ctx, cloudRouterID).CloudRouterCommandPostRequest(createRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed creating Cloud Router command", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// Save the command right away so that it is not orphaned if waiting for
+	// it fails
+	resp.Diagnostics.Append(plan.parse(ctx, command)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	createWaiter := getCommandCreateWaiter(ctx, client, cloudRouterID, command.GetUuid(), createTimeout)
+	commandChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed running Cloud Router command %s", command.GetUuid()), err.Error())
+		return
+	}
+
+	command = commandChecked.(*fabricv4.CloudRouterCommand)
+	resp.Diagnostics.Append(plan.parse(ctx, command)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	// A failed command is kept in state with its response so that the output
+	// can be inspected; it is run again once tainted
+	if command.GetState() == fabricv4.CLOUDROUTERCOMMANDSTATE_FAILED {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Cloud Router command %s failed", command.GetUuid()), commandFailureDetail(command))
+	}
+}
+
+// commandFailureDetail describes why a command failed from its response
+func commandFailureDetail(command *fabricv4.CloudRouterCommand) string {
+	response := command.GetResponse()
+	if output := response.GetOutput(); output != "" {
+		return output
+	}
+	if len(response.AdditionalProperties) > 0 {
+		if detail, err := json.Marshal(response.AdditionalProperties); err == nil {
+			return string(detail)
+		}
+	}
+	return "The command did not return any output"
+}
+
+func (r *CommandResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state CommandResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	command, httpResp, err := client.CloudRoutersApi.GetCloudRouterCommand(ctx, state.CloudRouterID.ValueString(), id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Cloud Router command %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	if command.GetState() == fabricv4.CLOUDROUTERCOMMANDSTATE_DELETED {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, command)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only persists changes to timeouts; every other argument forces the
+// command to be run again
+func (r *CommandResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var state, plan CommandResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the computed attributes from the prior state, the API is not
+	// called again
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CommandResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	var state CommandResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	cloudRouterID := state.CloudRouterID.ValueString()
+	deleteResp, err := client.CloudRoutersApi.DeleteCloudRouterCommandByUuid(ctx, cloudRouterID, id).Execute()
+	if err != nil {
+		if deleteResp == nil || !slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, deleteResp.StatusCode) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed deleting Cloud Router command %s", id), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	deleteWaiter := getCommandDeleteWaiter(ctx, client, cloudRouterID, id, deleteTimeout)
+	if _, err = r.Meta.WaitForStateContext(ctx, deleteWaiter); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed deleting Cloud Router command %s", id), err.Error())
+		return
+	}
+}
+
+func buildCommandCreateRequest(ctx context.Context, plan CommandResourceModel) (fabricv4.CloudRouterCommandPostRequest, diag.Diagnostics) {
+	request := fabricv4.CloudRouterCommandPostRequest{}
+
+	request.SetType(fabricv4.CloudRouterCommandType(plan.Type.ValueString()))
+	if name := plan.Name.ValueString(); name != "" {
+		request.SetName(name)
+	}
+	if description := plan.Description.ValueString(); description != "" {
+		request.SetDescription(description)
+	}
+	request.SetProject(fabricv4.Project{ProjectId: plan.ProjectID.ValueString()})
+
+	commandRequestModel, diags := plan.Request.ToPtr(ctx)
+	if diags.HasError() {
+		return request, diags
+	}
+	commandRequest := fabricv4.CloudRouterCommandRequest{}
+	commandRequest.SetDestination(commandRequestModel.Destination.ValueString())
+	if sourceConnectionID := commandRequestModel.SourceConnectionID.ValueString(); sourceConnectionID != "" {
+		commandRequest.SetSourceConnection(fabricv4.CloudRouterCommandRequestConnection{Uuid: &sourceConnectionID})
+	}
+	if !commandRequestModel.Timeout.IsNull() && !commandRequestModel.Timeout.IsUnknown() {
+		commandRequest.SetTimeout(commandRequestModel.Timeout.ValueInt32())
+	}
+	if !commandRequestModel.DataBytes.IsNull() && !commandRequestModel.DataBytes.IsUnknown() {
+		commandRequest.SetDataBytes(commandRequestModel.DataBytes.ValueInt32())
+	}
+	if !commandRequestModel.Interval.IsNull() && !commandRequestModel.Interval.IsUnknown() {
+		commandRequest.SetInterval(commandRequestModel.Interval.ValueInt32())
+	}
+	if !commandRequestModel.Count.IsNull() && !commandRequestModel.Count.IsUnknown() {
+		commandRequest.SetCount(commandRequestModel.Count.ValueInt32())
+	}
+	request.SetRequest(commandRequest)
+
+	return request, diags
+}
+
+func getCommandCreateWaiter(ctx context.Context, client *fabricv4.APIClient, cloudRouterID, commandID string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_PENDING),
+		},
+		Target: []string{
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_SUCCEEDED),
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_FAILED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			command, _, err := client.CloudRoutersApi.GetCloudRouterCommand(ctx, cloudRouterID, commandID).Execute()
+			if err != nil {
+				return 0, "", equinix_errors.FormatFabricError(err)
+			}
+			return command, string(command.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+}
+
+func getCommandDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, cloudRouterID, commandID string, timeout time.Duration) *retry.StateChangeConf {
+	// deletedMarker is a terraform-provider-only value that is used by the waiter
+	// to indicate that the resource appears to be deleted successfully based on
+	// status code
+	deletedMarker := "tf-marker-for-deleted-cloud-router-command"
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_PENDING),
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_SUCCEEDED),
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_FAILED),
+		},
+		Target: []string{
+			string(fabricv4.CLOUDROUTERCOMMANDSTATE_DELETED),
+			deletedMarker,
+		},
+		Refresh: func() (interface{}, string, error) {
+			command, resp, err := client.CloudRoutersApi.GetCloudRouterCommand(ctx, cloudRouterID, commandID).Execute()
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return command, deletedMarker, nil
+				}
+				return 0, "", equinix_errors.FormatFabricError(err)
+			}
+			return command, string(command.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+}
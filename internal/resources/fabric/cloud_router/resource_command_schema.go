@@ -0,0 +1,302 @@
+package cloud_router
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func commandResourceSchema(ctx context.Context) schema.Schema {
+	commandTypes := make([]string, len(fabricv4.AllowedCloudRouterCommandTypeEnumValues))
+	for i, commandType := range fabricv4.AllowedCloudRouterCommandTypeEnumValues {
+		commandTypes[i] = string(commandType)
+	}
+
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows execution of diagnostic commands, such as ping, from an Equinix Fabric Cloud Router. The command is run on create, waited on until it completes and its output is exposed as attributes; changing any argument runs the command again
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-intro.htm#HowItWorks
+* API: https://developer.equinix.com/catalog/fabricv4#operation/createCloudRouterCommand`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"cloud_router_id": schema.StringAttribute{
+				Description: "Equinix-assigned Cloud Router identifier to run the command from",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Cloud Router command type like PING_COMMAND",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(commandTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Customer-provided Cloud Router command name",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Customer-provided Cloud Router command description",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Customer resource hierarchy project identification of the Cloud Router",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"request": schema.SingleNestedAttribute{
+				Description: "Cloud Router command request details",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[CommandRequestModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"destination": schema.StringAttribute{
+						Description: "IP address or hostname the command is run against",
+						Required:    true,
+					},
+					"source_connection_id": schema.StringAttribute{
+						Description: "Equinix-assigned identifier of the Cloud Router connection the command is sourced from",
+						Optional:    true,
+					},
+					"timeout": schema.Int32Attribute{
+						Description: "Time in seconds to wait for a response",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"data_bytes": schema.Int32Attribute{
+						Description: "Number of data bytes to send with each ping request",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"interval": schema.Int32Attribute{
+						Description: "Time in milliseconds between sending each packet",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"count": schema.Int32Attribute{
+						Description: "Total number of ping requests",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Equinix-assigned Cloud Router command identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"href": schema.StringAttribute{
+				Description: "Cloud Router command URI",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Cloud Router command state - PENDING, SUCCEEDED, FAILED, DELETED",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"response": schema.SingleNestedAttribute{
+				Description: "Output of the Cloud Router command",
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[CommandResponseModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"output": schema.StringAttribute{
+						Description: "Raw command output",
+						Computed:    true,
+					},
+					"ping": schema.SingleNestedAttribute{
+						Description: "Structured output of a ping command",
+						Computed:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[PingOutputModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"destination_ip": schema.StringAttribute{
+								Description: "IP address of the destination",
+								Computed:    true,
+							},
+							"destination_name": schema.StringAttribute{
+								Description: "Name of the destination",
+								Computed:    true,
+							},
+							"data_bytes": schema.Int32Attribute{
+								Description: "Number of data bytes sent with each request",
+								Computed:    true,
+							},
+							"packets_transmitted": schema.Int32Attribute{
+								Description: "Number of packets transmitted",
+								Computed:    true,
+							},
+							"packets_received": schema.Int32Attribute{
+								Description: "Number of packets received",
+								Computed:    true,
+							},
+							"packets_loss_percent": schema.Float64Attribute{
+								Description: "Percentage of packets lost",
+								Computed:    true,
+							},
+							"rtt_min": schema.Float64Attribute{
+								Description: "Minimum round trip time in milliseconds",
+								Computed:    true,
+							},
+							"rtt_avg": schema.Float64Attribute{
+								Description: "Average round trip time in milliseconds",
+								Computed:    true,
+							},
+							"rtt_max": schema.Float64Attribute{
+								Description: "Maximum round trip time in milliseconds",
+								Computed:    true,
+							},
+							"rtt_std_dev": schema.Float64Attribute{
+								Description: "Standard deviation of the round trip time in milliseconds",
+								Computed:    true,
+							},
+							"responses": schema.ListNestedAttribute{
+								Description: "Individual ping responses",
+								Computed:    true,
+								CustomType:  fwtypes.NewListNestedObjectTypeOf[PingResponseModel](ctx),
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"bytes": schema.Int32Attribute{
+											Description: "Number of bytes received",
+											Computed:    true,
+										},
+										"ip": schema.StringAttribute{
+											Description: "IP address the response was received from",
+											Computed:    true,
+										},
+										"icmp_seq": schema.Int32Attribute{
+											Description: "ICMP sequence number",
+											Computed:    true,
+										},
+										"ttl": schema.Int32Attribute{
+											Description: "Time to live of the response",
+											Computed:    true,
+										},
+										"time": schema.Float64Attribute{
+											Description: "Round trip time in milliseconds",
+											Computed:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"change_log": changeLogSchema(ctx, "Cloud Router command"),
+		},
+	}
+}
+
+func changeLogSchema(ctx context.Context, resourceName string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Details of the last change on the " + resourceName,
+		Computed:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[ChangeLogModel](ctx),
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"created_by": schema.StringAttribute{
+				Description: "User name of creator of the " + resourceName,
+				Computed:    true,
+			},
+			"created_by_full_name": schema.StringAttribute{
+				Description: "Legal name of creator of the " + resourceName,
+				Computed:    true,
+			},
+			"created_by_email": schema.StringAttribute{
+				Description: "Email of creator of the " + resourceName,
+				Computed:    true,
+			},
+			"created_date_time": schema.StringAttribute{
+				Description: "Creation time of the " + resourceName,
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "User name of last updater of the " + resourceName,
+				Computed:    true,
+			},
+			"updated_by_full_name": schema.StringAttribute{
+				Description: "Legal name of last updater of the " + resourceName,
+				Computed:    true,
+			},
+			"updated_by_email": schema.StringAttribute{
+				Description: "Email of last updater of the " + resourceName,
+				Computed:    true,
+			},
+			"updated_date_time": schema.StringAttribute{
+				Description: "Last update time of the " + resourceName,
+				Computed:    true,
+			},
+			"deleted_by": schema.StringAttribute{
+				Description: "User name of deleter of the " + resourceName,
+				Computed:    true,
+			},
+			"deleted_by_full_name": schema.StringAttribute{
+				Description: "Legal name of deleter of the " + resourceName,
+				Computed:    true,
+			},
+			"deleted_by_email": schema.StringAttribute{
+				Description: "Email of deleter of the " + resourceName,
+				Computed:    true,
+			},
+			"deleted_date_time": schema.StringAttribute{
+				Description: "Deletion time of the " + resourceName,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,323 @@
+package cloud_router
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows creation and management of [Equinix Fabric Cloud Router](https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-intro.htm#HowItWorks).
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-intro.htm#HowItWorks
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#fabric-cloud-routers`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"uuid": schema.StringAttribute{
+				Description: "Equinix-assigned Fabric Cloud Router identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"href": schema.StringAttribute{
+				Description: "Fabric Cloud Router URI information",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Fabric Cloud Router name. An alpha-numeric 24 characters string which can include only hyphens and underscores",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 24),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Customer-provided Fabric Cloud Router description",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Defines the FCR type like; XF_ROUTER",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("XF_ROUTER"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Fabric Cloud Router overall state",
+				Computed:    true,
+			},
+			"equinix_asn": schema.Int64Attribute{
+				Description: "Equinix ASN",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"connections_count": schema.Int64Attribute{
+				Description: "Number of connections associated with this Fabric Cloud Router instance",
+				Computed:    true,
+			},
+			"change_log": schema.ListNestedAttribute{
+				Description: "Captures Fabric Cloud Router lifecycle change information",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ChangeLogModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: changeLogSchema(ctx, "Fabric Cloud Router").Attributes,
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"package": schema.ListNestedBlock{
+				Description: "Fabric Cloud Router Package Type",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[PackageModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: "Fabric Cloud Router package code",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"location": schema.ListNestedBlock{
+				Description: "Fabric Cloud Router location",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[LocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Description: "Access point region",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"metro_name": schema.StringAttribute{
+							Description: "Access point metro name",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"metro_code": schema.StringAttribute{
+							Description: "Access point metro code",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ibx": schema.StringAttribute{
+							Description: "IBX Code",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"project": schema.ListNestedBlock{
+				Description: "Customer resource hierarchy project information. Applicable to customers onboarded to Equinix Identity and Access Management. For more information see Identity and Access Management: Projects",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ProjectModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Description: "Project Id",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"href": schema.StringAttribute{
+							Description: "Unique Resource URL",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"marketplace_subscription": schema.ListNestedBlock{
+				Description: "Equinix Fabric Entity for Marketplace Subscription",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[MarketplaceSubscriptionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Marketplace Subscription type like; AWS_MARKETPLACE_SUBSCRIPTION",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								requiresReplaceIfKnownChange{},
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"uuid": schema.StringAttribute{
+							Description: "Equinix-assigned Marketplace Subscription identifier",
+							Required:    true,
+							PlanModifiers: []planmodifier.String{
+								requiresReplaceIfKnownChange{},
+							},
+						},
+					},
+				},
+			},
+			"account": schema.ListNestedBlock{
+				Description: "Customer account information that is associated with this Fabric Cloud Router",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[AccountModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_number": schema.Int64Attribute{
+							Description: "Account Number",
+							Required:    true,
+							PlanModifiers: []planmodifier.Int64{
+								requiresReplaceIfKnownChange{},
+							},
+						},
+					},
+				},
+			},
+			"order": schema.ListNestedBlock{
+				Description: "Order information related to this Fabric Cloud Router",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[OrderModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"purchase_order_number": schema.StringAttribute{
+							Description: "Purchase order number",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								requiresReplaceIfKnownChange{},
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"billing_tier": schema.StringAttribute{
+							Description: "Billing tier for connection bandwidth",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								requiresReplaceIfKnownChange{},
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"order_id": schema.StringAttribute{
+							Description: "Order Identification",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								requiresReplaceIfKnownChange{},
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"order_number": schema.StringAttribute{
+							Description: "Order Reference Number",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								requiresReplaceIfKnownChange{},
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"term_length": schema.Int64Attribute{
+							Description: "Term length in months; valid values are 1, 12, 24, 36 where 1 is the default value (for on-demand case)",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(1),
+							Validators: []validator.Int64{
+								int64validator.Between(1, 36),
+							},
+							PlanModifiers: []planmodifier.Int64{
+								requiresReplaceIfKnownChange{},
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"notifications": schema.ListNestedBlock{
+				Description: "Preferences for notifications on Fabric Cloud Router configuration or status changes",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[NotificationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Notification Type - ALL,CONNECTION_APPROVAL,SALES_REP_NOTIFICATIONS, NOTIFICATIONS",
+							Required:    true,
+						},
+						"send_interval": schema.StringAttribute{
+							Description: "Send interval",
+							Optional:    true,
+						},
+						"emails": schema.ListAttribute{
+							Description: "Array of contact emails",
+							Required:    true,
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -1,4 +1,4 @@
-package equinix_test
+package cloud_router_test
 
 import (
 	"context"
@@ -6,30 +6,36 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/equinix/terraform-provider-equinix/equinix"
 	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/cloud_router"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
-func init() {
-	resource.AddTestSweepers("equinix_fabric_cloud_router_PFCR", &resource.Sweeper{
-		Name: "equinix_fabric_cloud_router",
-		F:    testSweepCloudRouters,
-	})
-}
+func CheckCloudRouterDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewFabricClientForTesting(ctx)
 
-func testSweepCloudRouters(_ string) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_cloud_router" {
+			continue
+		}
+
+		if _, err := cloud_router.GetDeleteWaiter(ctx, client, rs.Primary.ID, 10*time.Minute).WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("API call failed while waiting for resource deletion")
+		}
+	}
 	return nil
 }
 
 func TestAccCloudRouterCreateOnlyRequiredParameters_PFCR(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
-		Providers:    acceptance.TestAccProviders,
-		CheckDestroy: checkCloudRouterDelete,
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckCloudRouterDelete,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudRouterCreateOnlyRequiredParameterConfig_PFCR("fcr_acctest_PFCR"),
@@ -97,11 +103,41 @@ func testAccCloudRouterCreateOnlyRequiredParameterConfig_PFCR(name string) strin
 	}`, name)
 }
 
-func TestAccCloudRouterCreateMixedParameters_PFCR(t *testing.T) {
+func TestAccCloudRouter_upgradeFromSDKv2_PFCR(t *testing.T) {
+	name := "fcr_upgrade_PFCR"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
-		Providers:    acceptance.TestAccProviders,
-		CheckDestroy: checkCloudRouterDelete,
+		CheckDestroy: CheckCloudRouterDelete,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"equinix": {
+						VersionConstraint: "3.8.0", // latest version with resource defined on SDKv2
+						Source:            "equinix/equinix",
+					},
+				},
+				Config: testAccCloudRouterCreateOnlyRequiredParameterConfig_PFCR(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("equinix_fabric_cloud_router.test", "name", name),
+					resource.TestCheckResourceAttrSet("equinix_fabric_cloud_router.test", "account.0.account_number"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+				Config:                   testAccCloudRouterCreateOnlyRequiredParameterConfig_PFCR(name),
+				PlanOnly:                 true,
+				ExpectNonEmptyPlan:       false,
+			},
+		},
+	})
+}
+
+func TestAccCloudRouterCreateMixedParameters_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckCloudRouterDelete,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudRouterCreateMixedParameterConfig_PFCR(),
@@ -130,6 +166,12 @@ func TestAccCloudRouterCreateMixedParameters_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttrSet("equinix_fabric_cloud_router.example", "change_log.0.deleted_date_time"),
 				),
 			},
+			{
+				ResourceName:            "equinix_fabric_cloud_router.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
 		},
 	})
 }
@@ -165,16 +207,67 @@ func testAccCloudRouterCreateMixedParameterConfig_PFCR() string {
 	}`
 }
 
-func checkCloudRouterDelete(s *terraform.State) error {
-	ctx := context.Background()
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "equinix_fabric_cloud_router" {
-			continue
+func TestAccFabricCloudRouterAction_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricCloudRouterActionConfig("Action_Test_PFCR", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("equinix_fabric_cloud_router_action.refresh", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_cloud_router_action.refresh", "type", "ROUTE_TABLE_ENTRY_UPDATE"),
+					resource.TestCheckResourceAttr("equinix_fabric_cloud_router_action.refresh", "state", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_cloud_router_action.refresh", "href"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_cloud_router_action.refresh", "change_log.created_date_time"),
+				),
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccFabricCloudRouterActionConfig("Action_Test_PFCR", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("equinix_fabric_cloud_router_action.refresh", "triggers.run", "second"),
+					resource.TestCheckResourceAttr("equinix_fabric_cloud_router_action.refresh", "state", "SUCCEEDED"),
+				),
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccFabricCloudRouterActionConfig(name, run string) string {
+	return fmt.Sprintf(`
+	resource "equinix_fabric_cloud_router" "this" {
+		type = "XF_ROUTER"
+		name = "%[1]s"
+		location {
+			metro_code = "SV"
 		}
-		err := equinix.WaitUntilCloudRouterDeprovisioned(rs.Primary.ID, acceptance.TestAccProvider.Meta(), &schema.ResourceData{}, ctx, 10*time.Minute)
-		if err != nil {
-			return fmt.Errorf("API call failed while waiting for resource deletion")
+		order {
+			purchase_order_number = "123485"
+		}
+		notifications {
+			type   = "ALL"
+			emails = ["test@equinix.com"]
+		}
+		project {
+			project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+		}
+		account {
+			account_number = 201257
+		}
+		package {
+			code = "STANDARD"
 		}
 	}
-	return nil
+
+	resource "equinix_fabric_cloud_router_action" "refresh" {
+		cloud_router_id = equinix_fabric_cloud_router.this.id
+		type            = "ROUTE_TABLE_ENTRY_UPDATE"
+		triggers = {
+			run = "%[2]s"
+		}
+	}
+	`, name, run)
 }
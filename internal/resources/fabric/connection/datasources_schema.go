@@ -11,6 +11,9 @@ import (
 
 func readFabricConnectionResourceSchema() map[string]*schema.Schema {
 	sch := fabricConnectionResourceSchema()
+	for _, key := range []string{"aws", "azure", "google", "oracle"} {
+		delete(sch, key)
+	}
 	for key := range sch {
 		if key == "uuid" {
 			sch[key].Required = true
@@ -20,8 +23,10 @@ func readFabricConnectionResourceSchema() map[string]*schema.Schema {
 			sch[key].Required = false
 			sch[key].Optional = false
 			sch[key].Computed = true
+			sch[key].ForceNew = false
 			sch[key].MaxItems = 0
 			sch[key].ValidateFunc = nil
+			sch[key].ExactlyOneOf = nil
 		}
 	}
 	return sch
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
+	"slices"
 	"sort"
+	"strings"
 
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -280,6 +284,36 @@ func accessPointTerraformToGo(accessPointTerraform []interface{}) fabricv4.Acces
 	return accessPoint
 }
 
+// virtualDeviceWithoutInterface returns the UUID of the virtual device of the
+// connection side when its access point has an interface block that omits
+// both the interface id and uuid
+func virtualDeviceWithoutInterface(side fabricv4.ConnectionSide) (string, bool) {
+	accessPoint := side.GetAccessPoint()
+	virtualDevice, ok := accessPoint.GetVirtualDeviceOk()
+	if !ok || virtualDevice.GetUuid() == "" {
+		return "", false
+	}
+	_interface, ok := accessPoint.GetInterfaceOk()
+	if !ok || _interface.GetId() != 0 || _interface.GetUuid() != "" {
+		return "", false
+	}
+	return virtualDevice.GetUuid(), true
+}
+
+// setVirtualDeviceInterface selects the device interface with the given id on
+// the connection side, as a CLOUD interface unless another type is configured
+func setVirtualDeviceInterface(side *fabricv4.ConnectionSide, id int) {
+	accessPoint := side.GetAccessPoint()
+	_interface := accessPoint.GetInterface()
+	_interface.Uuid = nil
+	_interface.SetId(int32(id))
+	if _interface.GetType() == "" {
+		_interface.SetType(fabricv4.INTERFACETYPE_CLOUD)
+	}
+	accessPoint.SetInterface(_interface)
+	side.SetAccessPoint(accessPoint)
+}
+
 func cloudRouterTerraformToGo(cloudRouterRequest []interface{}) fabricv4.CloudRouter {
 	if len(cloudRouterRequest) == 0 {
 		return fabricv4.CloudRouter{}
@@ -658,9 +692,7 @@ func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) ([][]f
 	existingBandwidth := int(conn.GetBandwidth())
 	updateNameVal := d.Get("name").(string)
 	updateBandwidthVal := d.Get("bandwidth").(int)
-	additionalInfo := d.Get("additional_info").([]interface{})
-
-	awsSecrets, hasAWSSecrets := additionalInfoContainsAWSSecrets(additionalInfo)
+	awsSecrets, hasAWSSecrets := cloudProviderAWSSecrets(d)
 
 	existingNotifications := conn.GetNotifications()
 	schemaNotifications := d.Get("notifications").([]interface{})
@@ -703,8 +735,38 @@ func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) ([][]f
 				Value: map[string]interface{}{"additionalInfo": awsSecrets},
 			},
 		})
+	} else if d.HasChange("additional_info") {
+		updateAdditionalInfoVal := additionalInfoTerraformToGo(d.Get("additional_info").([]interface{}))
+		if updateAdditionalInfoVal == nil {
+			updateAdditionalInfoVal = []fabricv4.ConnectionSideAdditionalInfo{}
+		}
+		changeOps = append(changeOps, []fabricv4.ConnectionChangeOperation{
+			{
+				Op:    "replace",
+				Path:  "/additionalInfo",
+				Value: updateAdditionalInfoVal,
+			},
+		})
 	}
 
+	if orderSchema, ok := d.GetOk("order"); ok {
+		updateOrderVal := equinix_fabric_schema.OrderTerraformToGo(orderSchema.(*schema.Set).List())
+		existingOrder := conn.GetOrder()
+		if purchaseOrderNumber := updateOrderVal.GetPurchaseOrderNumber(); purchaseOrderNumber != "" &&
+			purchaseOrderNumber != existingOrder.GetPurchaseOrderNumber() {
+			changeOps = append(changeOps, []fabricv4.ConnectionChangeOperation{
+				{
+					Op:    "replace",
+					Path:  "/order/purchaseOrderNumber",
+					Value: purchaseOrderNumber,
+				},
+			})
+		}
+	}
+
+	changeOps = append(changeOps, linkProtocolUpdateRequests("/aSide", conn.GetASide(), connectionSideTerraformToGo(d.Get("a_side").(*schema.Set).List()))...)
+	changeOps = append(changeOps, linkProtocolUpdateRequests("/zSide", conn.GetZSide(), connectionSideTerraformToGo(d.Get("z_side").(*schema.Set).List()))...)
+
 	if notificationsNeedsUpdate {
 		changeOps = append(changeOps, []fabricv4.ConnectionChangeOperation{
 			{
@@ -722,6 +784,113 @@ func getUpdateRequests(conn *fabricv4.Connection, d *schema.ResourceData) ([][]f
 	return changeOps, nil
 }
 
+// linkProtocolUpdateRequests returns the change operations for the VLAN tags of
+// a connection side, which are the only access point attributes that can be
+// updated in place
+func linkProtocolUpdateRequests(sidePath string, existingSide, updateSide fabricv4.ConnectionSide) [][]fabricv4.ConnectionChangeOperation {
+	var changeOps [][]fabricv4.ConnectionChangeOperation
+	existingAccessPoint, updateAccessPoint := existingSide.GetAccessPoint(), updateSide.GetAccessPoint()
+	if updateAccessPoint.LinkProtocol == nil {
+		return changeOps
+	}
+	existingLinkProtocol, updateLinkProtocol := existingAccessPoint.GetLinkProtocol(), updateAccessPoint.GetLinkProtocol()
+
+	vlanTags := []struct {
+		path             string
+		existing, update int32
+	}{
+		{"vlanTag", existingLinkProtocol.GetVlanTag(), updateLinkProtocol.GetVlanTag()},
+		{"vlanSTag", existingLinkProtocol.GetVlanSTag(), updateLinkProtocol.GetVlanSTag()},
+		{"vlanCTag", existingLinkProtocol.GetVlanCTag(), updateLinkProtocol.GetVlanCTag()},
+	}
+	for _, vlanTag := range vlanTags {
+		if vlanTag.update != 0 && vlanTag.update != vlanTag.existing {
+			changeOps = append(changeOps, []fabricv4.ConnectionChangeOperation{
+				{
+					Op:    "replace",
+					Path:  sidePath + "/accessPoint/linkProtocol/" + vlanTag.path,
+					Value: vlanTag.update,
+				},
+			})
+		}
+	}
+	return changeOps
+}
+
+// configuredValuesChanged reports whether any value set in the configuration
+// differs from the prior state. Values left out of the configuration are
+// filled in by the Fabric API and don't count as changes, while values that
+// are explicitly set to false, 0 or "" do. Attributes named in ignored are
+// skipped at any depth
+func configuredValuesChanged(old interface{}, config cty.Value, ignored ...string) bool {
+	if config.IsNull() {
+		return false
+	}
+	if !config.IsKnown() {
+		return true
+	}
+
+	configType := config.Type()
+	switch {
+	case configType.IsObjectType():
+		oldMap, _ := old.(map[string]interface{})
+		for name := range configType.AttributeTypes() {
+			if slices.Contains(ignored, name) {
+				continue
+			}
+			if configuredValuesChanged(oldMap[name], config.GetAttr(name), ignored...) {
+				return true
+			}
+		}
+		return false
+	case configType.IsListType(), configType.IsSetType(), configType.IsTupleType():
+		var oldList []interface{}
+		switch oldVal := old.(type) {
+		case *schema.Set:
+			oldList = oldVal.List()
+		case []interface{}:
+			oldList = oldVal
+		}
+		if config.LengthInt() != len(oldList) {
+			return true
+		}
+		i := 0
+		for it := config.ElementIterator(); it.Next(); i++ {
+			_, element := it.Element()
+			if !configType.IsSetType() {
+				if configuredValuesChanged(oldList[i], element, ignored...) {
+					return true
+				}
+				continue
+			}
+			// Set elements are not ordered, any matching element will do
+			if !slices.ContainsFunc(oldList, func(oldElement interface{}) bool {
+				return !configuredValuesChanged(oldElement, element, ignored...)
+			}) {
+				return true
+			}
+		}
+		return false
+	case configType == cty.String:
+		oldVal, _ := old.(string)
+		return config.AsString() != oldVal
+	case configType == cty.Bool:
+		oldVal, _ := old.(bool)
+		return config.True() != oldVal
+	case configType == cty.Number:
+		var oldVal float64
+		switch v := old.(type) {
+		case int:
+			oldVal = float64(v)
+		case float64:
+			oldVal = v
+		}
+		configVal, _ := config.AsBigFloat().Float64()
+		return configVal != oldVal
+	}
+	return true
+}
+
 func portTerraformToGo(portList []interface{}) fabricv4.SimplifiedPort {
 	if len(portList) == 0 {
 		return fabricv4.SimplifiedPort{}
@@ -764,3 +933,222 @@ func portRedundancyGoToTerraform(redundancy *fabricv4.PortRedundancy) *schema.Se
 	)
 	return redundancySet
 }
+
+const (
+	awsServiceProfileName         = "AWS Direct Connect"
+	azureServiceProfileName       = "Azure ExpressRoute"
+	googleServiceProfileNameZone1 = "Google Cloud Partner Interconnect Zone 1"
+	googleServiceProfileNameZone2 = "Google Cloud Partner Interconnect Zone 2"
+	oracleServiceProfileName      = "Oracle Cloud Infrastructure -OCI- FastConnect"
+)
+
+var (
+	awsAccountIDRegex             = regexp.MustCompile(`^\d{12}$`)
+	cloudRegionRegex              = regexp.MustCompile(`^[a-z]{2,}(-[a-z]+)+-\d+$`)
+	metroCodeRegex                = regexp.MustCompile(`^[A-Z]{2}$`)
+	googlePairingKeyRegex         = regexp.MustCompile(`^[0-9a-f-]+/[a-z0-9-]+/[12]$`)
+	oracleVirtualCircuitOCIDRegex = regexp.MustCompile(`^ocid1\.virtualcircuit\.[a-z0-9-]+\.[a-z0-9-]*\.[a-z0-9]+$`)
+)
+
+// cloudProvider is the provider neutral form of the aws, azure, google and oracle
+// blocks. It carries everything needed to build the z_side access point once the
+// service profile has been resolved by name.
+type cloudProvider struct {
+	Name               string
+	ServiceProfileName string
+	AuthenticationKey  string
+	SellerRegion       string
+	PeeringType        fabricv4.PeeringType
+	MetroCode          string
+	AdditionalInfo     []interface{}
+}
+
+func cloudProviderTerraformToGo(d *schema.ResourceData) (*cloudProvider, error) {
+	if aws, ok := d.GetOk("aws"); ok {
+		awsMap := aws.([]interface{})[0].(map[string]interface{})
+		provider := &cloudProvider{
+			Name:               "aws",
+			ServiceProfileName: awsMap["service_profile_name"].(string),
+			AuthenticationKey:  awsMap["account_id"].(string),
+			SellerRegion:       awsMap["region"].(string),
+			MetroCode:          awsMap["metro_code"].(string),
+		}
+		accessKey, secretKey := awsMap["access_key"].(string), awsMap["secret_key"].(string)
+		if accessKey != "" && secretKey != "" {
+			provider.AdditionalInfo = []interface{}{
+				map[string]interface{}{"key": "accessKey", "value": accessKey},
+				map[string]interface{}{"key": "secretKey", "value": secretKey},
+			}
+		}
+		return provider, nil
+	}
+	if azure, ok := d.GetOk("azure"); ok {
+		azureMap := azure.([]interface{})[0].(map[string]interface{})
+		return &cloudProvider{
+			Name:               "azure",
+			ServiceProfileName: azureMap["service_profile_name"].(string),
+			AuthenticationKey:  azureMap["service_key"].(string),
+			PeeringType:        fabricv4.PeeringType(azureMap["peering_type"].(string)),
+			MetroCode:          azureMap["metro_code"].(string),
+		}, nil
+	}
+	if google, ok := d.GetOk("google"); ok {
+		googleMap := google.([]interface{})[0].(map[string]interface{})
+		pairingKey := googleMap["pairing_key"].(string)
+		profileName := googleMap["service_profile_name"].(string)
+		if profileName == "" {
+			var err error
+			if profileName, err = googleServiceProfileNameForPairingKey(pairingKey); err != nil {
+				return nil, err
+			}
+		}
+		return &cloudProvider{
+			Name:               "google",
+			ServiceProfileName: profileName,
+			AuthenticationKey:  pairingKey,
+			MetroCode:          googleMap["metro_code"].(string),
+		}, nil
+	}
+	if oracle, ok := d.GetOk("oracle"); ok {
+		oracleMap := oracle.([]interface{})[0].(map[string]interface{})
+		return &cloudProvider{
+			Name:               "oracle",
+			ServiceProfileName: oracleMap["service_profile_name"].(string),
+			AuthenticationKey:  oracleMap["virtual_circuit_ocid"].(string),
+			SellerRegion:       oracleMap["region"].(string),
+			MetroCode:          oracleMap["metro_code"].(string),
+		}, nil
+	}
+	return nil, nil
+}
+
+// googleServiceProfileNameForPairingKey picks the Zone 1 or Zone 2 profile from the
+// edge availability domain, which Google encodes as the last segment of the key.
+func googleServiceProfileNameForPairingKey(pairingKey string) (string, error) {
+	if !googlePairingKeyRegex.MatchString(pairingKey) {
+		return "", fmt.Errorf("google pairing key %q is not in the <key>/<region>/<1|2> format", pairingKey)
+	}
+	if strings.HasSuffix(pairingKey, "/2") {
+		return googleServiceProfileNameZone2, nil
+	}
+	return googleServiceProfileNameZone1, nil
+}
+
+func cloudProviderAccessPointTerraformToGo(provider *cloudProvider, serviceProfile *fabricv4.ServiceProfile) fabricv4.AccessPoint {
+	var accessPoint fabricv4.AccessPoint
+	accessPoint.SetType(fabricv4.ACCESSPOINTTYPE_SP)
+	accessPoint.SetAuthenticationKey(provider.AuthenticationKey)
+	if provider.SellerRegion != "" {
+		accessPoint.SetSellerRegion(provider.SellerRegion)
+	}
+	if provider.PeeringType != "" {
+		accessPoint.SetPeeringType(provider.PeeringType)
+	}
+
+	var profile fabricv4.SimplifiedServiceProfile
+	profile.SetUuid(serviceProfile.GetUuid())
+	profile.SetType(serviceProfile.GetType())
+	accessPoint.SetProfile(profile)
+
+	var location fabricv4.SimplifiedLocation
+	location.SetMetroCode(provider.MetroCode)
+	accessPoint.SetLocation(location)
+
+	return accessPoint
+}
+
+// azureRedundancyTerraformToGo derives the ExpressRoute redundancy settings. Both
+// connections of a circuit share the service key, so the first one becomes PRIMARY
+// and a second one joins the primary's redundancy group as SECONDARY.
+func azureRedundancyTerraformToGo(existing []fabricv4.Connection) fabricv4.ConnectionRedundancy {
+	var redundancy fabricv4.ConnectionRedundancy
+	for _, conn := range existing {
+		connRedundancy := conn.GetRedundancy()
+		if connRedundancy.GetPriority() == fabricv4.CONNECTIONPRIORITY_PRIMARY {
+			redundancy.SetPriority(fabricv4.CONNECTIONPRIORITY_SECONDARY)
+			redundancy.SetGroup(connRedundancy.GetGroup())
+			return redundancy
+		}
+	}
+	redundancy.SetPriority(fabricv4.CONNECTIONPRIORITY_PRIMARY)
+	return redundancy
+}
+
+func cloudProviderAWSSecrets(d *schema.ResourceData) ([]interface{}, bool) {
+	if provider, err := cloudProviderTerraformToGo(d); err == nil && provider != nil && len(provider.AdditionalInfo) > 0 {
+		return additionalInfoContainsAWSSecrets(provider.AdditionalInfo)
+	}
+	return additionalInfoContainsAWSSecrets(d.Get("additional_info").([]interface{}))
+}
+
+var cloudProviderNames = []string{"aws", "azure", "google", "oracle"}
+
+// cloudProviderForServiceProfile returns the cloud provider block a service profile
+// belongs to, or "" when it isn't one of the cloud provider profiles
+func cloudProviderForServiceProfile(profileName string) string {
+	switch profileName {
+	case awsServiceProfileName:
+		return "aws"
+	case azureServiceProfileName:
+		return "azure"
+	case googleServiceProfileNameZone1, googleServiceProfileNameZone2:
+		return "google"
+	case oracleServiceProfileName:
+		return "oracle"
+	}
+	return ""
+}
+
+// setCloudProvider sets the cloud provider block matching the z_side access point.
+// A block already in state keeps its provider, which covers custom service profile
+// names; otherwise, e.g. on import, the provider is found from the profile name.
+func setCloudProvider(d *schema.ResourceData, conn *fabricv4.Connection) error {
+	zSide := conn.GetZSide()
+	accessPoint := zSide.GetAccessPoint()
+	if accessPoint.GetType() != fabricv4.ACCESSPOINTTYPE_SP {
+		return nil
+	}
+	profile := accessPoint.GetProfile()
+	provider := cloudProviderForServiceProfile(profile.GetName())
+	var prior map[string]interface{}
+	for _, name := range cloudProviderNames {
+		if block, ok := d.Get(name).([]interface{}); ok && len(block) > 0 && block[0] != nil {
+			provider, prior = name, block[0].(map[string]interface{})
+		}
+	}
+	if provider == "" {
+		return nil
+	}
+	return d.Set(provider, cloudProviderGoToTerraform(provider, accessPoint, prior))
+}
+
+func cloudProviderGoToTerraform(provider string, accessPoint fabricv4.AccessPoint, prior map[string]interface{}) []interface{} {
+	profile := accessPoint.GetProfile()
+	location := accessPoint.GetLocation()
+	profileName := profile.GetName()
+	if profileName == "" {
+		profileName, _ = prior["service_profile_name"].(string)
+	}
+	mappedProvider := map[string]interface{}{
+		"metro_code":           location.GetMetroCode(),
+		"service_profile_name": profileName,
+	}
+	switch provider {
+	case "aws":
+		mappedProvider["account_id"] = accessPoint.GetAuthenticationKey()
+		mappedProvider["region"] = accessPoint.GetSellerRegion()
+		// The access and secret keys are only sent to accept the connection and
+		// are never returned by the API
+		mappedProvider["access_key"], _ = prior["access_key"].(string)
+		mappedProvider["secret_key"], _ = prior["secret_key"].(string)
+	case "azure":
+		mappedProvider["service_key"] = accessPoint.GetAuthenticationKey()
+		mappedProvider["peering_type"] = string(accessPoint.GetPeeringType())
+	case "google":
+		mappedProvider["pairing_key"] = accessPoint.GetAuthenticationKey()
+	case "oracle":
+		mappedProvider["virtual_circuit_ocid"] = accessPoint.GetAuthenticationKey()
+		mappedProvider["region"] = accessPoint.GetSellerRegion()
+	}
+	return []interface{}{mappedProvider}
+}
@@ -0,0 +1,553 @@
+package connection
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/ne-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testConnectionSide(portUUID string, vlanTag int32) fabricv4.ConnectionSide {
+	port := fabricv4.SimplifiedPort{}
+	port.SetUuid(portUUID)
+	linkProtocol := fabricv4.SimplifiedLinkProtocol{}
+	linkProtocol.SetType(fabricv4.LINKPROTOCOLTYPE_DOT1_Q)
+	linkProtocol.SetVlanTag(vlanTag)
+	accessPoint := fabricv4.AccessPoint{}
+	accessPoint.SetType(fabricv4.ACCESSPOINTTYPE_COLO)
+	accessPoint.SetPort(port)
+	accessPoint.SetLinkProtocol(linkProtocol)
+	connectionSide := fabricv4.ConnectionSide{}
+	connectionSide.SetAccessPoint(accessPoint)
+	return connectionSide
+}
+
+func TestLinkProtocolUpdateRequests(t *testing.T) {
+	changeOps := linkProtocolUpdateRequests("/zSide", testConnectionSide("port", 100), testConnectionSide("port", 101))
+	if len(changeOps) != 1 {
+		t.Fatalf("expected a single change operation, got %v", changeOps)
+	}
+	if op := changeOps[0][0]; op.Op != "replace" || op.Path != "/zSide/accessPoint/linkProtocol/vlanTag" || op.Value != int32(101) {
+		t.Errorf("unexpected change operation %+v", op)
+	}
+
+	if changeOps := linkProtocolUpdateRequests("/aSide", testConnectionSide("port", 100), testConnectionSide("port", 100)); len(changeOps) != 0 {
+		t.Errorf("expected no change operations for matching VLAN tags, got %v", changeOps)
+	}
+	if changeOps := linkProtocolUpdateRequests("/aSide", testConnectionSide("port", 100), fabricv4.ConnectionSide{}); len(changeOps) != 0 {
+		t.Errorf("expected no change operations without a configured link protocol, got %v", changeOps)
+	}
+}
+
+func TestConfiguredValuesChanged(t *testing.T) {
+	oldSide := []interface{}{
+		map[string]interface{}{
+			"access_point": []interface{}{
+				map[string]interface{}{
+					"type":          "COLO",
+					"port":          []interface{}{map[string]interface{}{"uuid": "port", "name": "port-name"}},
+					"link_protocol": []interface{}{map[string]interface{}{"type": "DOT1Q", "vlan_tag": 100}},
+					"location":      []interface{}{map[string]interface{}{"metro_code": "SV"}},
+					"peering_type":  "PRIVATE",
+				},
+			},
+		},
+	}
+	configSide := func(portUUID string, vlanTag int64, peeringType cty.Value) cty.Value {
+		return cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"access_point": cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"type": cty.StringVal("COLO"),
+				"port": cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"uuid": cty.StringVal(portUUID),
+					"name": cty.NullVal(cty.String),
+				})}),
+				"link_protocol": cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"type":     cty.StringVal("DOT1Q"),
+					"vlan_tag": cty.NumberIntVal(vlanTag),
+				})}),
+				"location":     cty.NullVal(cty.Set(cty.Object(map[string]cty.Type{"metro_code": cty.String}))),
+				"peering_type": peeringType,
+			})}),
+		})})
+	}
+	oldRedundancy := []interface{}{map[string]interface{}{"priority": "PRIMARY", "enabled": true}}
+	configRedundancy := func(enabled cty.Value) cty.Value {
+		return cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"priority": cty.NullVal(cty.String),
+			"enabled":  enabled,
+		})})
+	}
+
+	tests := map[string]struct {
+		old     interface{}
+		config  cty.Value
+		ignored []string
+		want    bool
+	}{
+		"values filled in by the api": {
+			old:    oldSide,
+			config: configSide("port", 100, cty.NullVal(cty.String)),
+			want:   false,
+		},
+		"different vlan tag": {
+			old:    oldSide,
+			config: configSide("port", 101, cty.NullVal(cty.String)),
+			want:   true,
+		},
+		"vlan tags ignored": {
+			old:     oldSide,
+			config:  configSide("port", 101, cty.NullVal(cty.String)),
+			ignored: []string{"vlan_tag"},
+			want:    false,
+		},
+		"different port": {
+			old:    oldSide,
+			config: configSide("other-port", 100, cty.NullVal(cty.String)),
+			want:   true,
+		},
+		"string set to empty": {
+			old:    oldSide,
+			config: configSide("port", 100, cty.StringVal("")),
+			want:   true,
+		},
+		"unknown value": {
+			old:    oldSide,
+			config: configSide("port", 100, cty.UnknownVal(cty.String)),
+			want:   true,
+		},
+		"bool unchanged": {
+			old:    oldRedundancy,
+			config: configRedundancy(cty.True),
+			want:   false,
+		},
+		"bool set to false": {
+			old:    oldRedundancy,
+			config: configRedundancy(cty.False),
+			want:   true,
+		},
+		"block removed from configuration": {
+			old:    oldRedundancy,
+			config: cty.NullVal(cty.Set(cty.Object(map[string]cty.Type{"priority": cty.String, "enabled": cty.Bool}))),
+			want:   false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := configuredValuesChanged(tc.old, tc.config, tc.ignored...); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func testVirtualDeviceSide(deviceUUID string, _interface *fabricv4.Interface) fabricv4.ConnectionSide {
+	virtualDevice := fabricv4.VirtualDevice{}
+	virtualDevice.SetUuid(deviceUUID)
+	accessPoint := fabricv4.AccessPoint{}
+	accessPoint.SetType(fabricv4.ACCESSPOINTTYPE_VD)
+	accessPoint.SetVirtualDevice(virtualDevice)
+	if _interface != nil {
+		accessPoint.SetInterface(*_interface)
+	}
+	connectionSide := fabricv4.ConnectionSide{}
+	connectionSide.SetAccessPoint(accessPoint)
+	return connectionSide
+}
+
+func TestVirtualDeviceInterfaceSelection(t *testing.T) {
+	withID := fabricv4.Interface{}
+	withID.SetType(fabricv4.INTERFACETYPE_CLOUD)
+	withID.SetId(7)
+	withoutID := interfaceTerraformToGo([]interface{}{map[string]interface{}{"uuid": "", "type": "", "id": 0}})
+
+	tests := map[string]struct {
+		side       fabricv4.ConnectionSide
+		wantDevice string
+		wantSelect bool
+	}{
+		"interface id omitted":  {side: testVirtualDeviceSide("device", &withoutID), wantDevice: "device", wantSelect: true},
+		"interface id set":      {side: testVirtualDeviceSide("device", &withID)},
+		"no interface block":    {side: testVirtualDeviceSide("device", nil)},
+		"not a virtual device":  {side: testConnectionSide("port", 100)},
+		"empty connection side": {side: fabricv4.ConnectionSide{}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			device, ok := virtualDeviceWithoutInterface(tc.side)
+			if device != tc.wantDevice || ok != tc.wantSelect {
+				t.Errorf("expected (%q, %v), got (%q, %v)", tc.wantDevice, tc.wantSelect, device, ok)
+			}
+		})
+	}
+
+	side := testVirtualDeviceSide("device", &withoutID)
+	setVirtualDeviceInterface(&side, 3)
+	accessPoint := side.GetAccessPoint()
+	selected := accessPoint.GetInterface()
+	if selected.GetId() != 3 || selected.GetType() != fabricv4.INTERFACETYPE_CLOUD || selected.Uuid != nil {
+		t.Errorf("expected CLOUD interface 3 without uuid, got %+v", selected)
+	}
+	if _, ok := virtualDeviceWithoutInterface(side); ok {
+		t.Errorf("expected no selection once the interface id is set")
+	}
+}
+
+func TestGoogleServiceProfileNameForPairingKey(t *testing.T) {
+	tests := map[string]struct {
+		pairingKey string
+		want       string
+		wantErr    bool
+	}{
+		"zone 1":          {pairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/1", want: googleServiceProfileNameZone1},
+		"zone 2":          {pairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/2", want: googleServiceProfileNameZone2},
+		"unknown zone":    {pairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/3", wantErr: true},
+		"missing region":  {pairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/1", wantErr: true},
+		"empty key":       {pairingKey: "", wantErr: true},
+		"uppercase chars": {pairingKey: "7E51371E/US-WEST1/1", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := googleServiceProfileNameForPairingKey(tc.pairingKey)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %t, got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAzureRedundancyTerraformToGo(t *testing.T) {
+	connection := func(priority fabricv4.ConnectionPriority, group string) fabricv4.Connection {
+		redundancy := fabricv4.ConnectionRedundancy{}
+		redundancy.SetPriority(priority)
+		redundancy.SetGroup(group)
+		conn := fabricv4.Connection{}
+		conn.SetRedundancy(redundancy)
+		return conn
+	}
+
+	tests := map[string]struct {
+		existing     []fabricv4.Connection
+		wantPriority fabricv4.ConnectionPriority
+		wantGroup    string
+	}{
+		"first connection": {
+			wantPriority: fabricv4.CONNECTIONPRIORITY_PRIMARY,
+		},
+		"second connection": {
+			existing:     []fabricv4.Connection{connection(fabricv4.CONNECTIONPRIORITY_PRIMARY, "group")},
+			wantPriority: fabricv4.CONNECTIONPRIORITY_SECONDARY,
+			wantGroup:    "group",
+		},
+		"only a secondary connection": {
+			existing:     []fabricv4.Connection{connection(fabricv4.CONNECTIONPRIORITY_SECONDARY, "group")},
+			wantPriority: fabricv4.CONNECTIONPRIORITY_PRIMARY,
+		},
+		"primary after a secondary": {
+			existing: []fabricv4.Connection{
+				connection(fabricv4.CONNECTIONPRIORITY_SECONDARY, "other"),
+				connection(fabricv4.CONNECTIONPRIORITY_PRIMARY, "group"),
+			},
+			wantPriority: fabricv4.CONNECTIONPRIORITY_SECONDARY,
+			wantGroup:    "group",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			redundancy := azureRedundancyTerraformToGo(tc.existing)
+			if redundancy.GetPriority() != tc.wantPriority || redundancy.GetGroup() != tc.wantGroup {
+				t.Errorf("expected (%s, %q), got (%s, %q)", tc.wantPriority, tc.wantGroup, redundancy.GetPriority(), redundancy.GetGroup())
+			}
+		})
+	}
+}
+
+func TestCloudProviderTerraformToGo(t *testing.T) {
+	tests := map[string]struct {
+		raw     map[string]interface{}
+		want    *cloudProvider
+		wantErr bool
+	}{
+		"aws": {
+			raw: map[string]interface{}{"aws": []interface{}{map[string]interface{}{
+				"account_id": "123456789012",
+				"region":     "us-west-1",
+				"metro_code": "SV",
+				"access_key": "access",
+				"secret_key": "secret",
+			}}},
+			want: &cloudProvider{
+				Name:               "aws",
+				ServiceProfileName: awsServiceProfileName,
+				AuthenticationKey:  "123456789012",
+				SellerRegion:       "us-west-1",
+				MetroCode:          "SV",
+				AdditionalInfo: []interface{}{
+					map[string]interface{}{"key": "accessKey", "value": "access"},
+					map[string]interface{}{"key": "secretKey", "value": "secret"},
+				},
+			},
+		},
+		"aws without secrets": {
+			raw: map[string]interface{}{"aws": []interface{}{map[string]interface{}{
+				"account_id": "123456789012",
+				"region":     "us-west-1",
+				"metro_code": "SV",
+			}}},
+			want: &cloudProvider{
+				Name:               "aws",
+				ServiceProfileName: awsServiceProfileName,
+				AuthenticationKey:  "123456789012",
+				SellerRegion:       "us-west-1",
+				MetroCode:          "SV",
+			},
+		},
+		"azure": {
+			raw: map[string]interface{}{"azure": []interface{}{map[string]interface{}{
+				"service_key": "7e51371e-72a3-40b5-b844-2e3efefaee59",
+				"metro_code":  "SV",
+			}}},
+			want: &cloudProvider{
+				Name:               "azure",
+				ServiceProfileName: azureServiceProfileName,
+				AuthenticationKey:  "7e51371e-72a3-40b5-b844-2e3efefaee59",
+				PeeringType:        fabricv4.PEERINGTYPE_PRIVATE,
+				MetroCode:          "SV",
+			},
+		},
+		"google zone from pairing key": {
+			raw: map[string]interface{}{"google": []interface{}{map[string]interface{}{
+				"pairing_key": "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/2",
+				"metro_code":  "SV",
+			}}},
+			want: &cloudProvider{
+				Name:               "google",
+				ServiceProfileName: googleServiceProfileNameZone2,
+				AuthenticationKey:  "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/2",
+				MetroCode:          "SV",
+			},
+		},
+		"google custom profile": {
+			raw: map[string]interface{}{"google": []interface{}{map[string]interface{}{
+				"pairing_key":          "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/2",
+				"metro_code":           "SV",
+				"service_profile_name": "Custom",
+			}}},
+			want: &cloudProvider{
+				Name:               "google",
+				ServiceProfileName: "Custom",
+				AuthenticationKey:  "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/2",
+				MetroCode:          "SV",
+			},
+		},
+		"google invalid pairing key": {
+			raw: map[string]interface{}{"google": []interface{}{map[string]interface{}{
+				"pairing_key": "invalid",
+				"metro_code":  "SV",
+			}}},
+			wantErr: true,
+		},
+		"oracle": {
+			raw: map[string]interface{}{"oracle": []interface{}{map[string]interface{}{
+				"virtual_circuit_ocid": "ocid1.virtualcircuit.oc1.iad.abc",
+				"region":               "us-ashburn-1",
+				"metro_code":           "DC",
+			}}},
+			want: &cloudProvider{
+				Name:               "oracle",
+				ServiceProfileName: oracleServiceProfileName,
+				AuthenticationKey:  "ocid1.virtualcircuit.oc1.iad.abc",
+				SellerRegion:       "us-ashburn-1",
+				MetroCode:          "DC",
+			},
+		},
+		"z_side only": {
+			raw: map[string]interface{}{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, fabricConnectionResourceSchema(), tc.raw)
+			got, err := cloudProviderTerraformToGo(d)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %t, got %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSetCloudProvider(t *testing.T) {
+	zSide := func(profileName, authenticationKey string) fabricv4.ConnectionSide {
+		profile := fabricv4.SimplifiedServiceProfile{}
+		profile.SetName(profileName)
+		location := fabricv4.SimplifiedLocation{}
+		location.SetMetroCode("SV")
+		accessPoint := fabricv4.AccessPoint{}
+		accessPoint.SetType(fabricv4.ACCESSPOINTTYPE_SP)
+		accessPoint.SetProfile(profile)
+		accessPoint.SetLocation(location)
+		accessPoint.SetAuthenticationKey(authenticationKey)
+		accessPoint.SetSellerRegion("us-west-1")
+		accessPoint.SetPeeringType(fabricv4.PEERINGTYPE_PRIVATE)
+		side := fabricv4.ConnectionSide{}
+		side.SetAccessPoint(accessPoint)
+		return side
+	}
+
+	tests := map[string]struct {
+		state    map[string]interface{}
+		zSide    fabricv4.ConnectionSide
+		provider string
+		want     map[string]interface{}
+	}{
+		"import aws": {
+			zSide:    zSide(awsServiceProfileName, "123456789012"),
+			provider: "aws",
+			want: map[string]interface{}{
+				"account_id":           "123456789012",
+				"region":               "us-west-1",
+				"metro_code":           "SV",
+				"access_key":           "",
+				"secret_key":           "",
+				"service_profile_name": awsServiceProfileName,
+			},
+		},
+		"import azure": {
+			zSide:    zSide(azureServiceProfileName, "7e51371e-72a3-40b5-b844-2e3efefaee59"),
+			provider: "azure",
+			want: map[string]interface{}{
+				"service_key":          "7e51371e-72a3-40b5-b844-2e3efefaee59",
+				"peering_type":         "PRIVATE",
+				"metro_code":           "SV",
+				"service_profile_name": azureServiceProfileName,
+			},
+		},
+		"import google zone 2": {
+			zSide:    zSide(googleServiceProfileNameZone2, "key/us-west1/2"),
+			provider: "google",
+			want: map[string]interface{}{
+				"pairing_key":          "key/us-west1/2",
+				"metro_code":           "SV",
+				"service_profile_name": googleServiceProfileNameZone2,
+			},
+		},
+		"aws secrets are kept": {
+			state: map[string]interface{}{"aws": []interface{}{map[string]interface{}{
+				"account_id":           "123456789012",
+				"region":               "us-west-1",
+				"metro_code":           "SV",
+				"access_key":           "access",
+				"secret_key":           "secret",
+				"service_profile_name": "Custom AWS",
+			}}},
+			zSide:    zSide("Custom AWS", "123456789012"),
+			provider: "aws",
+			want: map[string]interface{}{
+				"account_id":           "123456789012",
+				"region":               "us-west-1",
+				"metro_code":           "SV",
+				"access_key":           "access",
+				"secret_key":           "secret",
+				"service_profile_name": "Custom AWS",
+			},
+		},
+		"other service profile": {
+			zSide: zSide("Other Provider", "key"),
+		},
+		"not a service provider": {
+			zSide: testConnectionSide("port", 100),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, fabricConnectionResourceSchema(), tc.state)
+			conn := fabricv4.Connection{}
+			conn.SetZSide(tc.zSide)
+			if err := setCloudProvider(d, &conn); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, provider := range cloudProviderNames {
+				block := d.Get(provider).([]interface{})
+				if provider != tc.provider {
+					if len(block) != 0 {
+						t.Errorf("expected no %s block, got %v", provider, block)
+					}
+					continue
+				}
+				if len(block) != 1 || !reflect.DeepEqual(block[0], tc.want) {
+					t.Errorf("expected %s block %v, got %v", provider, tc.want, block)
+				}
+			}
+		})
+	}
+}
+
+type testDeviceClient struct {
+	ne.Client
+	interfaces []ne.DeviceInterface
+}
+
+func (c testDeviceClient) GetDevice(uuid string) (*ne.Device, error) {
+	return &ne.Device{UUID: ne.String(uuid), Interfaces: c.interfaces}, nil
+}
+
+func TestSelectVirtualDeviceInterfaces(t *testing.T) {
+	withoutID := interfaceTerraformToGo([]interface{}{map[string]interface{}{"uuid": "", "type": "", "id": 0}})
+	conf := &config.Config{Ne: testDeviceClient{interfaces: []ne.DeviceInterface{
+		{ID: ne.Int(4), Status: ne.String(network.InterfaceStatusAvailable)},
+		{ID: ne.Int(2), Status: ne.String("ASSIGNED")},
+		{ID: ne.Int(3), Status: ne.String(network.InterfaceStatusAvailable)},
+	}}}
+
+	aSide, zSide := testVirtualDeviceSide("device", &withoutID), testVirtualDeviceSide("device", &withoutID)
+	unlock, err := selectVirtualDeviceInterfaces(conf, &aSide, &zSide)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if getDeviceInterfaceLock("device").TryLock() {
+		t.Fatal("expected the device to stay locked until unlocked")
+	}
+	unlock()
+	if !getDeviceInterfaceLock("device").TryLock() {
+		t.Fatal("expected the device to be unlocked")
+	}
+	getDeviceInterfaceLock("device").Unlock()
+	// Releasing again, like the deferred release after the request, is a no-op
+	unlock()
+	if !getDeviceInterfaceLock("device").TryLock() {
+		t.Fatal("expected the device to stay unlocked")
+	}
+	getDeviceInterfaceLock("device").Unlock()
+
+	for side, want := range map[string]struct {
+		side fabricv4.ConnectionSide
+		id   int32
+	}{"a_side": {aSide, 3}, "z_side": {zSide, 4}} {
+		accessPoint := want.side.GetAccessPoint()
+		selected := accessPoint.GetInterface()
+		if selected.GetId() != want.id {
+			t.Errorf("expected interface %d on %s, got %d", want.id, side, selected.GetId())
+		}
+	}
+
+	first, second, third := testVirtualDeviceSide("device", &withoutID), testVirtualDeviceSide("device", &withoutID), testVirtualDeviceSide("device", &withoutID)
+	if _, err := selectVirtualDeviceInterfaces(conf, &first, &second, &third); err == nil {
+		t.Error("expected an error once the available interfaces run out")
+	}
+	if !getDeviceInterfaceLock("device").TryLock() {
+		t.Fatal("expected the device to be unlocked after an error")
+	}
+	getDeviceInterfaceLock("device").Unlock()
+}
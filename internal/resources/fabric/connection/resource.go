@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+	"github.com/equinix/terraform-provider-equinix/internal/network"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -32,7 +35,8 @@ func Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: fabricConnectionResourceSchema(),
+		Schema:        fabricConnectionResourceSchema(),
+		CustomizeDiff: resourceFabricConnectionCustomizeDiff,
 
 		Description: "Fabric V4 API compatible resource allows creation and management of Equinix Fabric connection",
 	}
@@ -73,14 +77,39 @@ func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData,
 
 	aSide := d.Get("a_side").(*schema.Set).List()
 	connectionASide := connectionSideTerraformToGo(aSide)
-	createConnectionRequest.SetASide(connectionASide)
 
 	zSide := d.Get("z_side").(*schema.Set).List()
 	connectionZSide := connectionSideTerraformToGo(zSide)
+
+	provider, err := cloudProviderTerraformToGo(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if provider != nil {
+		serviceProfile, err := searchCloudProviderServiceProfile(ctx, client, provider.ServiceProfileName)
+		if err != nil {
+			return diag.Errorf("error resolving %s service profile: %s", provider.Name, err)
+		}
+		connectionZSide = fabricv4.ConnectionSide{}
+		connectionZSide.SetAccessPoint(cloudProviderAccessPointTerraformToGo(provider, serviceProfile))
+
+		if _, ok := d.GetOk("redundancy"); !ok && provider.Name == "azure" {
+			existing, err := searchConnectionsByAuthenticationKey(ctx, client, provider.AuthenticationKey)
+			if err != nil {
+				return diag.Errorf("error looking up existing ExpressRoute connections: %s", err)
+			}
+			createConnectionRequest.SetRedundancy(azureRedundancyTerraformToGo(existing))
+		}
+	}
+	unlockDevices, err := selectVirtualDeviceInterfaces(meta.(*config.Config), &connectionASide, &connectionZSide)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlockDevices()
+	createConnectionRequest.SetASide(connectionASide)
 	createConnectionRequest.SetZSide(connectionZSide)
 
-	additionalInfoTerraConfig, ok := d.GetOk("additional_info")
-	if ok {
+	if additionalInfoTerraConfig, ok := d.GetOk("additional_info"); ok {
 		zSideAccessPoint := connectionZSide.GetAccessPoint()
 		zSideAccessPointServiceProfile := zSideAccessPoint.GetProfile()
 		serviceProfile, _, _ := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, zSideAccessPointServiceProfile.GetUuid()).Execute()
@@ -97,6 +126,9 @@ func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
 	}
+	// The selected interfaces are assigned to the connection from here on, so
+	// other connections to the same devices no longer wait for it to provision
+	unlockDevices()
 	d.SetId(conn.GetUuid())
 
 	createTimeout := d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
@@ -104,7 +136,7 @@ func resourceFabricConnectionCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("error waiting for connection (%s) to be created: %s", d.Id(), err)
 	}
 
-	awsSecrets, hasAWSSecrets := additionalInfoContainsAWSSecrets(additionalInfoTerraConfig.([]interface{}))
+	awsSecrets, hasAWSSecrets := cloudProviderAWSSecrets(d)
 	if hasAWSSecrets {
 		patchChangeOperation := []fabricv4.ConnectionChangeOperation{
 			{
@@ -139,7 +171,10 @@ func resourceFabricConnectionRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
 	}
 	d.SetId(conn.GetUuid())
-	return setFabricMap(d, conn)
+	if diags := setFabricMap(d, conn); diags.HasError() {
+		return diags
+	}
+	return diag.FromErr(setCloudProvider(d, conn))
 }
 
 func resourceFabricConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -171,7 +206,7 @@ func resourceFabricConnectionUpdate(ctx context.Context, d *schema.ResourceData,
 
 		var waitFunction func(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.Connection, error)
 		if update[0].Op == "replace" {
-			// Update type is either name or bandwidth
+			// Update type is name, bandwidth, notifications, additionalInfo, purchase order or VLAN tag
 			waitFunction = waitForConnectionUpdateCompletion
 		} else if update[0].Op == "add" {
 			// Update type is aws secret additionalInfo
@@ -192,6 +227,96 @@ func resourceFabricConnectionUpdate(ctx context.Context, d *schema.ResourceData,
 	return append(diags, setFabricMap(d, updatedConn)...)
 }
 
+// resourceFabricConnectionCustomizeDiff forces a new connection for the configured
+// changes the Fabric API can't apply in place. Of the connection sides and the
+// order, only the VLAN tags and the purchase order number can be updated
+func resourceFabricConnectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, side := range []string{"a_side", "z_side"} {
+		if !d.HasChange(side) {
+			continue
+		}
+		oldSide, _ := d.GetChange(side)
+		if configuredValuesChanged(oldSide, d.GetRawConfig().GetAttr(side), "vlan_tag", "vlan_s_tag", "vlan_c_tag") {
+			if err := d.ForceNew(side); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, attribute := range []string{"redundancy", "project"} {
+		if !d.HasChange(attribute) {
+			continue
+		}
+		oldValue, _ := d.GetChange(attribute)
+		if configuredValuesChanged(oldValue, d.GetRawConfig().GetAttr(attribute)) {
+			if err := d.ForceNew(attribute); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("order") && d.NewValueKnown("order") {
+		oldOrder, newOrder := d.GetChange("order")
+		oldOrderVal := equinix_fabric_schema.OrderTerraformToGo(oldOrder.(*schema.Set).List())
+		newOrderVal := equinix_fabric_schema.OrderTerraformToGo(newOrder.(*schema.Set).List())
+		if oldOrderVal.GetTermLength() != newOrderVal.GetTermLength() {
+			if err := d.ForceNew("order"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func searchCloudProviderServiceProfile(ctx context.Context, client *fabricv4.APIClient, name string) (*fabricv4.ServiceProfile, error) {
+	expression := fabricv4.ServiceProfileSimpleExpression{}
+	expression.SetProperty("/name")
+	expression.SetOperator("=")
+	expression.SetValues([]string{name})
+	searchRequest := fabricv4.ServiceProfileSearchRequest{}
+	searchRequest.SetFilter(fabricv4.ServiceProfileFilter{ServiceProfileSimpleExpression: &expression})
+
+	serviceProfiles, _, err := client.ServiceProfilesApi.SearchServiceProfiles(ctx).ViewPoint(fabricv4.GETSERVICEPROFILESVIEWPOINTPARAMETER_A_SIDE).ServiceProfileSearchRequest(searchRequest).Execute()
+	if err != nil {
+		return nil, equinix_errors.FormatFabricError(err)
+	}
+	for _, serviceProfile := range serviceProfiles.GetData() {
+		if serviceProfile.GetName() == name {
+			return &serviceProfile, nil
+		}
+	}
+	return nil, fmt.Errorf("no service profile named %q is visible to this account", name)
+}
+
+func searchConnectionsByAuthenticationKey(ctx context.Context, client *fabricv4.APIClient, authenticationKey string) ([]fabricv4.Connection, error) {
+	keyExpression := fabricv4.Expression{}
+	keyExpression.SetProperty(fabricv4.SEARCHFIELDNAME_Z_SIDE_ACCESS_POINT_AUTHENTICATION_KEY)
+	keyExpression.SetOperator(fabricv4.EXPRESSIONOPERATOR_EQUAL)
+	keyExpression.SetValues([]string{authenticationKey})
+	stateExpression := fabricv4.Expression{}
+	stateExpression.SetProperty(fabricv4.SEARCHFIELDNAME_OPERATION_EQUINIX_STATUS)
+	stateExpression.SetOperator(fabricv4.EXPRESSIONOPERATOR_NOT_IN)
+	stateExpression.SetValues([]string{
+		string(fabricv4.EQUINIXSTATUS_DEPROVISIONED),
+		string(fabricv4.EQUINIXSTATUS_DEPROVISIONING),
+	})
+	filter := fabricv4.Expression{}
+	filter.SetAnd([]fabricv4.Expression{keyExpression, stateExpression})
+	searchRequest := fabricv4.SearchRequest{}
+	searchRequest.SetFilter(filter)
+
+	connections, _, err := client.ConnectionsApi.SearchConnections(ctx).SearchRequest(searchRequest).Execute()
+	if err != nil {
+		return nil, equinix_errors.FormatFabricError(err)
+	}
+	return connections.GetData(), nil
+}
+
 func waitForConnectionUpdateCompletion(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.Connection, error) {
 	log.Printf("[DEBUG] Waiting for connection update to complete, uuid %s", uuid)
 	stateConf := &retry.StateChangeConf{
@@ -210,12 +335,11 @@ func waitForConnectionUpdateCompletion(uuid string, meta interface{}, d *schema.
 			}
 			return dbConn, updatableState, nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbConn *fabricv4.Connection
 
 	if err == nil {
@@ -224,9 +348,85 @@ func waitForConnectionUpdateCompletion(uuid string, meta interface{}, d *schema.
 	return dbConn, err
 }
 
+var (
+	deviceInterfaceLocksMutex sync.Mutex
+	deviceInterfaceLocks      = make(map[string]*sync.Mutex)
+)
+
+func getDeviceInterfaceLock(deviceUUID string) *sync.Mutex {
+	deviceInterfaceLocksMutex.Lock()
+	defer deviceInterfaceLocksMutex.Unlock()
+	lock, ok := deviceInterfaceLocks[deviceUUID]
+	if !ok {
+		lock = &sync.Mutex{}
+		deviceInterfaceLocks[deviceUUID] = lock
+	}
+	return lock
+}
+
+// selectVirtualDeviceInterfaces picks the lowest available interfaces of the
+// virtual devices whose access point omits the interface id. The devices stay
+// locked until the returned unlock func is called, so that connections created
+// concurrently on the same device don't select the same interface.
+func selectVirtualDeviceInterfaces(conf *config.Config, sides ...*fabricv4.ConnectionSide) (func(), error) {
+	var deviceUUIDs []string
+	for _, side := range sides {
+		if deviceUUID, ok := virtualDeviceWithoutInterface(*side); ok {
+			deviceUUIDs = append(deviceUUIDs, deviceUUID)
+		}
+	}
+	// Lock in a consistent order to avoid deadlocks between connections
+	// joining the same two devices
+	slices.Sort(deviceUUIDs)
+	deviceUUIDs = slices.Compact(deviceUUIDs)
+	locks := make([]*sync.Mutex, len(deviceUUIDs))
+	for index, deviceUUID := range deviceUUIDs {
+		locks[index] = getDeviceInterfaceLock(deviceUUID)
+		locks[index].Lock()
+	}
+	// The locks are released once the connection request was sent, with the
+	// deferred release on the error paths before it being a no-op
+	unlock := sync.OnceFunc(func() {
+		for _, lock := range locks {
+			lock.Unlock()
+		}
+	})
+
+	selected := make(map[string]int)
+	for _, side := range sides {
+		deviceUUID, ok := virtualDeviceWithoutInterface(*side)
+		if !ok {
+			continue
+		}
+		device, err := conf.Ne.GetDevice(deviceUUID)
+		if err != nil {
+			unlock()
+			return nil, fmt.Errorf("cannot fetch network device %s to select an interface due to '%v'", deviceUUID, err)
+		}
+		interfaceIDs := network.AvailableInterfaceIDs(device.Interfaces)
+		if len(interfaceIDs) <= selected[deviceUUID] {
+			unlock()
+			return nil, fmt.Errorf("network device %s has no available interfaces, set interface.id on the access point", deviceUUID)
+		}
+		interfaceID := interfaceIDs[selected[deviceUUID]]
+		selected[deviceUUID]++
+		log.Printf("[DEBUG] Selected interface %d of network device %s", interfaceID, deviceUUID)
+		setVirtualDeviceInterface(side, interfaceID)
+	}
+	return unlock, nil
+}
+
 func waitUntilConnectionIsCreated(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) error {
 	log.Printf("Waiting for connection to be created, uuid %s", uuid)
-	stateConf := &retry.StateChangeConf{
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, GetCreateWaiter(ctx, client, uuid, timeout))
+
+	return err
+}
+
+// GetCreateWaiter waits for a Fabric Connection to leave the PROVISIONING state
+func GetCreateWaiter(ctx context.Context, client *fabricv4.APIClient, uuid string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
 		Pending: []string{
 			string(fabricv4.CONNECTIONSTATE_PROVISIONING),
 		},
@@ -236,21 +436,15 @@ func waitUntilConnectionIsCreated(uuid string, meta interface{}, d *schema.Resou
 			string(fabricv4.CONNECTIONSTATE_ACTIVE),
 		},
 		Refresh: func() (interface{}, string, error) {
-			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 			dbConn, _, err := client.ConnectionsApi.GetConnectionByUuid(ctx, uuid).Execute()
 			if err != nil {
 				return "", "", equinix_errors.FormatFabricError(err)
 			}
 			return dbConn, string(dbConn.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
 }
 
 func waitForConnectionProviderStatusChange(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) (*fabricv4.Connection, error) {
@@ -273,12 +467,11 @@ func waitForConnectionProviderStatusChange(uuid string, meta interface{}, d *sch
 			providerStatus := operation.GetProviderStatus()
 			return dbConn, string(providerStatus), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbConn *fabricv4.Connection
 
 	if err == nil {
@@ -303,12 +496,11 @@ func verifyConnectionCreated(uuid string, meta interface{}, d *schema.ResourceDa
 			}
 			return dbConn, string(dbConn.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbConn *fabricv4.Connection
 
 	if err == nil {
@@ -323,13 +515,8 @@ func resourceFabricConnectionDelete(ctx context.Context, d *schema.ResourceData,
 	start := time.Now()
 	_, _, err := client.ConnectionsApi.DeleteConnectionByUuid(ctx, d.Id()).Execute()
 	if err != nil {
-		if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
-			if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
-				// EQ-3142509 = Connection already deleted
-				if equinix_errors.HasErrorCode(fabricErrs, "EQ-3142509") {
-					return diags
-				}
-			}
+		if IsAlreadyDeleted(err) {
+			return diags
 		}
 		return diag.FromErr(equinix_errors.FormatFabricError(err))
 	}
@@ -344,7 +531,14 @@ func resourceFabricConnectionDelete(ctx context.Context, d *schema.ResourceData,
 
 func WaitUntilConnectionDeprovisioned(uuid string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) error {
 	log.Printf("Waiting for connection to be deprovisioned, uuid %s", uuid)
-	stateConf := &retry.StateChangeConf{
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, GetDeleteWaiter(ctx, client, uuid, timeout))
+	return err
+}
+
+// GetDeleteWaiter waits for a Fabric Connection to reach the DEPROVISIONED state
+func GetDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, uuid string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
 		Pending: []string{
 			string(fabricv4.CONNECTIONSTATE_DEPROVISIONING),
 			string(fabricv4.CONNECTIONSTATE_ACTIVE),
@@ -354,18 +548,25 @@ func WaitUntilConnectionDeprovisioned(uuid string, meta interface{}, d *schema.R
 			string(fabricv4.CONNECTIONSTATE_DEPROVISIONED),
 		},
 		Refresh: func() (interface{}, string, error) {
-			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 			dbConn, _, err := client.ConnectionsApi.GetConnectionByUuid(ctx, uuid).Execute()
 			if err != nil {
 				return "", "", equinix_errors.FormatFabricError(err)
 			}
 			return dbConn, string(dbConn.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
+}
 
-	_, err := stateConf.WaitForStateContext(ctx)
-	return err
+// IsAlreadyDeleted reports whether a delete request failed because the
+// Fabric Connection had already been deleted
+func IsAlreadyDeleted(err error) bool {
+	if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
+		if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
+			// EQ-3142509 = Connection already deleted
+			return equinix_errors.HasErrorCode(fabricErrs, "EQ-3142509")
+		}
+	}
+	return false
 }
@@ -2,6 +2,8 @@ package connection
 
 import (
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -11,6 +13,7 @@ func fabricConnectionResourceSchema() map[string]*schema.Schema {
 		"type": {
 			Type:         schema.TypeString,
 			Required:     true,
+			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice([]string{"EVPL_VC", "EPL_VC", "IP_VC", "IPWAN_VC", "ACCESS_EPL_VC", "EVPLAN_VC", "EPLAN_VC", "EIA_VC", "IA_VC", "EC_VC"}, false),
 			Description:  "Defines the connection type like EVPL_VC, EPL_VC, IPWAN_VC, IP_VC, ACCESS_EPL_VC, EVPLAN_VC, EPLAN_VC, EIA_VC, IA_VC, EC_VC",
 		},
@@ -67,12 +70,50 @@ func fabricConnectionResourceSchema() map[string]*schema.Schema {
 			Set:         schema.HashResource(accessPointSch()),
 		},
 		"z_side": {
-			Type:        schema.TypeSet,
-			Required:    true,
-			Description: "Destination or Provider side connection configuration object of the multi-segment connection",
-			MaxItems:    1,
-			Elem:        connectionSideSch(),
-			Set:         schema.HashResource(accessPointSch()),
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Destination or Provider side connection configuration object of the multi-segment connection",
+			MaxItems:     1,
+			Elem:         connectionSideSch(),
+			Set:          schema.HashResource(accessPointSch()),
+			ExactlyOneOf: cloudProviderExactlyOneOf,
+		},
+		"aws": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			Computed:     true,
+			Description:  "AWS Direct Connect destination. Resolves the AWS service profile and builds the z_side access point; conflicts with `z_side`",
+			MaxItems:     1,
+			Elem:         &schema.Resource{Schema: awsCloudProviderSch()},
+			ExactlyOneOf: cloudProviderExactlyOneOf,
+		},
+		"azure": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Azure ExpressRoute destination. Resolves the Azure service profile, builds the z_side access point and sets the redundancy priority from existing connections on the same service key; conflicts with `z_side`",
+			MaxItems:     1,
+			Elem:         &schema.Resource{Schema: azureCloudProviderSch()},
+			ExactlyOneOf: cloudProviderExactlyOneOf,
+		},
+		"google": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Google Cloud Partner Interconnect destination. Resolves the Google service profile for the pairing key's edge availability domain and builds the z_side access point; conflicts with `z_side`",
+			MaxItems:     1,
+			Elem:         &schema.Resource{Schema: googleCloudProviderSch()},
+			ExactlyOneOf: cloudProviderExactlyOneOf,
+		},
+		"oracle": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Oracle Cloud Infrastructure FastConnect destination. Resolves the Oracle service profile and builds the z_side access point; conflicts with `z_side`",
+			MaxItems:     1,
+			Elem:         &schema.Resource{Schema: oracleCloudProviderSch()},
+			ExactlyOneOf: cloudProviderExactlyOneOf,
 		},
 		"project": {
 			Type:        schema.TypeSet,
@@ -149,6 +190,148 @@ func fabricConnectionResourceSchema() map[string]*schema.Schema {
 	}
 }
 
+var cloudProviderExactlyOneOf = []string{"z_side", "aws", "azure", "google", "oracle"}
+
+func awsCloudProviderSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(awsAccountIDRegex, "must be a 12 digit AWS account id"),
+			Description:  "AWS account id that will own the hosted Direct Connect connection. Sent as the z_side authentication key",
+		},
+		"region": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(cloudRegionRegex, "must be an AWS region code like us-west-1"),
+			Description:  "AWS region of the Direct Connect location. Sent as the z_side seller region",
+		},
+		"metro_code": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(metroCodeRegex, "must be a 2 letter metro code"),
+			Description:  "Metro code of the AWS Direct Connect location",
+		},
+		"access_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"aws.0.secret_key"},
+			Description:  "AWS access key used to accept the hosted connection on the customer's behalf",
+		},
+		"secret_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"aws.0.access_key"},
+			Description:  "AWS secret key used to accept the hosted connection on the customer's behalf",
+		},
+		"service_profile_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     awsServiceProfileName,
+			Description: "Name of the service profile to search for",
+		},
+	}
+}
+
+func azureCloudProviderSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"service_key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "ExpressRoute circuit service key. Sent as the z_side authentication key",
+		},
+		"peering_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(fabricv4.PEERINGTYPE_PRIVATE),
+			ValidateFunc: validation.StringInSlice([]string{string(fabricv4.PEERINGTYPE_PRIVATE), string(fabricv4.PEERINGTYPE_MICROSOFT)}, false),
+			Description:  "ExpressRoute peering type - PRIVATE, MICROSOFT",
+		},
+		"metro_code": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(metroCodeRegex, "must be a 2 letter metro code"),
+			Description:  "Metro code of the ExpressRoute peering location",
+		},
+		"service_profile_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     azureServiceProfileName,
+			Description: "Name of the service profile to search for",
+		},
+	}
+}
+
+func googleCloudProviderSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"pairing_key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(googlePairingKeyRegex, "must be a Partner Interconnect pairing key like <key>/<region>/<1|2>"),
+			Description:  "Partner Interconnect VLAN attachment pairing key. Sent as the z_side authentication key; the edge availability domain is read from its last segment",
+		},
+		"metro_code": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(metroCodeRegex, "must be a 2 letter metro code"),
+			Description:  "Metro code of the Partner Interconnect location",
+		},
+		"service_profile_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Name of the service profile to search for. Defaults to the Zone 1 or Zone 2 profile matching the pairing key's edge availability domain",
+		},
+	}
+}
+
+func oracleCloudProviderSch() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"virtual_circuit_ocid": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(oracleVirtualCircuitOCIDRegex, "must be a FastConnect virtual circuit OCID"),
+			Description:  "FastConnect virtual circuit OCID. Sent as the z_side authentication key",
+		},
+		"region": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(cloudRegionRegex, "must be an OCI region identifier like us-ashburn-1"),
+			Description:  "OCI region of the FastConnect location. Sent as the z_side seller region",
+		},
+		"metro_code": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(metroCodeRegex, "must be a 2 letter metro code"),
+			Description:  "Metro code of the FastConnect location",
+		},
+		"service_profile_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     oracleServiceProfileName,
+			Description: "Name of the service profile to search for",
+		},
+	}
+}
+
 func connectionSideSch() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -451,7 +634,7 @@ func accessPointInterface() map[string]*schema.Schema {
 			Type:        schema.TypeInt,
 			Computed:    true,
 			Optional:    true,
-			Description: "id",
+			Description: "id. When omitted for a virtual device access point, the lowest available interface of the device is selected. Connections created on the same device in one run select their interfaces one after another",
 		},
 		"type": {
 			Type:        schema.TypeString,
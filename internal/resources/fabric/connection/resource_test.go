@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -178,7 +179,7 @@ func TestAccFabricCreatePort2PortConnection_PFCR(t *testing.T) {
 		CheckDestroy: CheckConnectionDelete,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFabricCreatePort2PortConnectionConfig(50, aSidePortUUID, zSidePortUUID),
+				Config: testAccFabricCreatePort2PortConnectionConfig(50, 101, "1-129105284100", aSidePortUUID, zSidePortUUID),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("equinix_fabric_connection.test", "id"),
 					resource.TestCheckResourceAttr(
@@ -205,7 +206,7 @@ func TestAccFabricCreatePort2PortConnection_PFCR(t *testing.T) {
 				ExpectNonEmptyPlan: true,
 			},
 			{
-				Config: testAccFabricCreatePort2PortConnectionConfig(100, aSidePortUUID, zSidePortUUID),
+				Config: testAccFabricCreatePort2PortConnectionConfig(100, 101, "1-129105284100", aSidePortUUID, zSidePortUUID),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"equinix_fabric_connection.test", "name", "port_test_PFCR"),
@@ -230,12 +231,29 @@ func TestAccFabricCreatePort2PortConnection_PFCR(t *testing.T) {
 				),
 				ExpectNonEmptyPlan: true,
 			},
+			{
+				Config: testAccFabricCreatePort2PortConnectionConfig(100, 102, "1-129105284101", aSidePortUUID, zSidePortUUID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "order.0.purchase_order_number", "1-129105284101"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "a_side.0.access_point.0.link_protocol.0.vlan_tag", "100"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "z_side.0.access_point.0.link_protocol.0.vlan_tag", "102"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("equinix_fabric_connection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 
 }
 
-func testAccFabricCreatePort2PortConnectionConfig(bandwidth int32, aSidePortUUID, zSidePortUUID string) string {
+func testAccFabricCreatePort2PortConnectionConfig(bandwidth, zSideVlanTag int32, purchaseOrderNumber, aSidePortUUID, zSidePortUUID string) string {
 	return fmt.Sprintf(`resource "equinix_fabric_connection" "test" {
 		type = "EVPL_VC"
 		name = "port_test_PFCR"
@@ -244,7 +262,7 @@ func testAccFabricCreatePort2PortConnectionConfig(bandwidth int32, aSidePortUUID
 			emails = ["test@equinix.com","test1@equinix.com"]
 		}
 		order {
-			purchase_order_number = "1-129105284100"
+			purchase_order_number = "%s"
 		}
 		bandwidth = %d
 		a_side {
@@ -270,14 +288,14 @@ func testAccFabricCreatePort2PortConnectionConfig(bandwidth int32, aSidePortUUID
 				}
 				link_protocol {
 					type= "DOT1Q"
-					vlan_tag= 101
+					vlan_tag= %d
 				}
 				location {
 					metro_code= "SV"
 				}
 			}
 		}
-	}`, bandwidth, aSidePortUUID, zSidePortUUID)
+	}`, purchaseOrderNumber, bandwidth, aSidePortUUID, zSidePortUUID, zSideVlanTag)
 }
 
 func TestAccFabricCreateCloudRouter2PortConnection_PFCR(t *testing.T) {
@@ -287,9 +305,10 @@ func TestAccFabricCreateCloudRouter2PortConnection_PFCR(t *testing.T) {
 		portUUID = ports["pfcr"]["dot1q"][1].GetUuid()
 	}
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
-		Providers:    acceptance.TestAccProviders,
-		CheckDestroy: CheckConnectionDelete,
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckConnectionDelete,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFabricCreateCloudRouter2PortConnectionConfig("fcr_test_PFCR", portUUID),
@@ -500,6 +519,86 @@ func testAccFabricCreateVirtualDevice2NetworkConnectionConfig(name, virtualDevic
 	}`, name, virtualDeviceUUID)
 }
 
+func TestAccFabricCreatePort2AWSConnectionCloudProviderBlock_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	connectionsTestData := testinghelpers.GetFabricEnvConnectionTestData(t)
+	var awsAccountID, portUUID string
+	if len(ports) > 0 && len(connectionsTestData) > 0 {
+		awsAccountID = connectionsTestData["pfcr"]["awsAccountID"]
+		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CheckConnectionDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricCreatePort2AWSConnectionCloudProviderBlockConfig("port2aws_PFCR", portUUID, awsAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("equinix_fabric_connection.test", "id"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "name", "port2aws_PFCR"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "aws.0.region", "us-west-1"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "z_side.0.access_point.0.type", "SP"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "z_side.0.access_point.0.seller_region", "us-west-1"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "z_side.0.access_point.0.authentication_key", awsAccountID),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "z_side.0.access_point.0.profile.0.name", "AWS Direct Connect"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_connection.test", "z_side.0.access_point.0.location.0.metro_code", "SV"),
+				),
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            "equinix_fabric_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccFabricCreatePort2AWSConnectionCloudProviderBlockConfig(name, portUUID, awsAccountID string) string {
+	return fmt.Sprintf(`
+	resource "equinix_fabric_connection" "test" {
+		type = "EVPL_VC"
+		name = "%s"
+		notifications{
+			type = "ALL"
+			emails = ["test@equinix.com","test1@equinix.com"]
+		}
+		order {
+			purchase_order_number = "1-323292"
+		}
+		bandwidth = 50
+		redundancy {
+			priority= "PRIMARY"
+		}
+		a_side {
+			access_point {
+				type= "COLO"
+				port {
+					uuid = "%s"
+				}
+				link_protocol {
+					type= "DOT1Q"
+					vlan_tag= 2029
+				}
+			}
+		}
+		aws {
+			account_id = "%s"
+			region     = "us-west-1"
+			metro_code = "SV"
+		}
+	}`, name, portUUID, awsAccountID)
+}
+
 func CheckConnectionDelete(s *terraform.State) error {
 	ctx := context.Background()
 	for _, rs := range s.RootModule().Resources {
@@ -142,7 +142,20 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 func waitForStability(connectionId, routeFilterId string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) error {
-	log.Printf("Waiting for route filter policy (%x) attachment to connection (%s) to be stable", connectionId, routeFilterId)
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	_, err := WaitForAttachment(ctx, meta.(*config.Config), client, connectionId, routeFilterId, timeout)
+	return err
+}
+
+func WaitForDeletion(connectionId, routeFilterId string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) error {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	return WaitForDetachment(ctx, meta.(*config.Config), client, connectionId, routeFilterId, timeout)
+}
+
+// WaitForAttachment waits for the Route Filter Policy attachment to the Connection
+// to be stable and returns the attachment
+func WaitForAttachment(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, connectionId, routeFilterId string, timeout time.Duration) (*fabricv4.ConnectionRouteFilterData, error) {
+	log.Printf("Waiting for route filter policy (%s) attachment to connection (%s) to be stable", routeFilterId, connectionId)
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			string(fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHING),
@@ -152,24 +165,26 @@ func waitForStability(connectionId, routeFilterId string, meta interface{}, d *s
 			string(fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_PENDING_BGP_CONFIGURATION),
 		},
 		Refresh: func() (interface{}, string, error) {
-			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 			connectionRouteFilter, _, err := client.RouteFiltersApi.GetConnectionRouteFilterByUuid(ctx, routeFilterId, connectionId).Execute()
 			if err != nil {
 				return "", "", equinix_errors.FormatFabricError(err)
 			}
 			return connectionRouteFilter, string(connectionRouteFilter.GetAttachmentStatus()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.WaitForStateContext(ctx, stateConf)
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return inter.(*fabricv4.ConnectionRouteFilterData), nil
 }
 
-func WaitForDeletion(connectionId, routeFilterId string, meta interface{}, d *schema.ResourceData, ctx context.Context, timeout time.Duration) error {
+// WaitForDetachment waits for the Route Filter Policy to be detached from the Connection
+func WaitForDetachment(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, connectionId, routeFilterId string, timeout time.Duration) error {
 	log.Printf("Waiting for route filter policy (%s) to be detached from connection (%s)", routeFilterId, connectionId)
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -181,23 +196,21 @@ func WaitForDeletion(connectionId, routeFilterId string, meta interface{}, d *sc
 			string(fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHED),
 		},
 		Refresh: func() (interface{}, string, error) {
-			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 			connectionRouteFilter, body, err := client.RouteFiltersApi.GetConnectionRouteFilterByUuid(ctx, routeFilterId, connectionId).Execute()
 			if err != nil {
-				if body.StatusCode >= 400 && body.StatusCode <= 499 {
+				if body != nil && body.StatusCode >= 400 && body.StatusCode <= 499 {
 					// Already deleted resource
-					return connectionRouteFilter, string(fabricv4.ROUTEFILTERSTATE_DEPROVISIONED), nil
+					return &fabricv4.ConnectionRouteFilterData{}, string(fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHED), nil
 				}
 				return "", "", equinix_errors.FormatFabricError(err)
 			}
 			return connectionRouteFilter, string(connectionRouteFilter.GetAttachmentStatus()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -24,9 +24,10 @@ func TestAccFabricConnectionRouteFilter_PFCR(t *testing.T) {
 		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
 	}
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
-		Providers:    acceptance.TestAccProviders,
-		CheckDestroy: CheckConnectionRouteFilterDelete,
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckConnectionRouteFilterDelete,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFabricConnectionRouteFilterConfig(portUUID),
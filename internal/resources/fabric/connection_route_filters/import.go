@@ -0,0 +1,77 @@
+package connection_route_filters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseImportID splits an import identifier of the form
+// <connection_id>[,<connection_id>...]/<route_filter_id>:<direction>[,<route_filter_id>:<direction>...]
+func parseImportID(id string) ([]string, map[string]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, nil, fmt.Errorf("expected an identifier of the form <connection_ids>/<route_filter_id>:<direction>, got %q", id)
+	}
+
+	connectionIDs := strings.Split(parts[0], ",")
+	for _, connectionID := range connectionIDs {
+		if connectionID == "" {
+			return nil, nil, fmt.Errorf("empty connection id in %q", id)
+		}
+	}
+
+	routeFilters := make(map[string]string)
+	for _, routeFilter := range strings.Split(parts[1], ",") {
+		routeFilterID, direction, ok := strings.Cut(routeFilter, ":")
+		direction = strings.ToUpper(direction)
+		if !ok || routeFilterID == "" || (direction != directionInbound && direction != directionOutbound) {
+			return nil, nil, fmt.Errorf("expected <route_filter_id>:<direction> with a direction of %s or %s, got %q", directionInbound, directionOutbound, routeFilter)
+		}
+		if _, ok := routeFilters[routeFilterID]; ok {
+			return nil, nil, fmt.Errorf("route filter %s is listed more than once in %q", routeFilterID, id)
+		}
+		routeFilters[routeFilterID] = direction
+	}
+
+	return connectionIDs, routeFilters, nil
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	connectionIDs, routeFilters, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import Identifier", err.Error())
+		return
+	}
+
+	routeFilterModels := make([]RouteFilterModel, 0, len(routeFilters))
+	for routeFilterID, direction := range routeFilters {
+		routeFilterModels = append(routeFilterModels, RouteFilterModel{
+			RouteFilterID: types.StringValue(routeFilterID),
+			Direction:     types.StringValue(direction),
+		})
+	}
+
+	connectionIDSet, diags := types.SetValueFrom(ctx, types.StringType, connectionIDs)
+	resp.Diagnostics.Append(diags...)
+	routeFilterSet, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: fwtypes.AttributeTypesMust[RouteFilterModel](ctx)}, routeFilterModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), uuid.New().String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_ids"), connectionIDSet)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_filters"), routeFilterSet)...)
+}
@@ -0,0 +1,55 @@
+package connection_route_filters
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := map[string]struct {
+		id               string
+		wantConnections  []string
+		wantRouteFilters map[string]string
+		wantErr          bool
+	}{
+		"single connection and route filter": {
+			id:               "conn-1/rf-1:INBOUND",
+			wantConnections:  []string{"conn-1"},
+			wantRouteFilters: map[string]string{"rf-1": directionInbound},
+		},
+		"many connections and route filters": {
+			id:               "conn-1,conn-2/rf-1:inbound,rf-2:OUTBOUND",
+			wantConnections:  []string{"conn-1", "conn-2"},
+			wantRouteFilters: map[string]string{"rf-1": directionInbound, "rf-2": directionOutbound},
+		},
+		"missing route filters":    {id: "conn-1", wantErr: true},
+		"missing direction":        {id: "conn-1/rf-1", wantErr: true},
+		"invalid direction":        {id: "conn-1/rf-1:SIDEWAYS", wantErr: true},
+		"empty connection":         {id: "conn-1,/rf-1:INBOUND", wantErr: true},
+		"duplicate route filter":   {id: "conn-1/rf-1:INBOUND,rf-1:OUTBOUND", wantErr: true},
+		"too many parts":           {id: "conn-1/rf-1:INBOUND/extra", wantErr: true},
+		"empty import identifier":  {id: "", wantErr: true},
+		"missing route filter id":  {id: "conn-1/:INBOUND", wantErr: true},
+		"missing connection parts": {id: "/rf-1:INBOUND", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			connectionIDs, routeFilters, err := parseImportID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v %v", connectionIDs, routeFilters)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(connectionIDs, tc.wantConnections) {
+				t.Errorf("expected connections %v, got %v", tc.wantConnections, connectionIDs)
+			}
+			if !reflect.DeepEqual(routeFilters, tc.wantRouteFilters) {
+				t.Errorf("expected route filters %v, got %v", tc.wantRouteFilters, routeFilters)
+			}
+		})
+	}
+}
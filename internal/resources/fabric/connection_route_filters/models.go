@@ -0,0 +1,252 @@
+package connection_route_filters
+
+import (
+	"context"
+	"sort"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	directionInbound   = "INBOUND"
+	directionOutbound  = "OUTBOUND"
+	defaultParallelism = 10
+)
+
+type ResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	ConnectionIDs types.Set      `tfsdk:"connection_ids"`
+	RouteFilters  types.Set      `tfsdk:"route_filters"`
+	Parallelism   types.Int64    `tfsdk:"parallelism"`
+	Attachments   types.List     `tfsdk:"attachments"`
+}
+
+type RouteFilterModel struct {
+	RouteFilterID types.String `tfsdk:"route_filter_id"`
+	Direction     types.String `tfsdk:"direction"`
+}
+
+type AttachmentModel struct {
+	ConnectionID     types.String `tfsdk:"connection_id"`
+	RouteFilterID    types.String `tfsdk:"route_filter_id"`
+	Direction        types.String `tfsdk:"direction"`
+	Type             types.String `tfsdk:"type"`
+	Href             types.String `tfsdk:"href"`
+	AttachmentStatus types.String `tfsdk:"attachment_status"`
+}
+
+// attachmentKey identifies the attachment of a Route Filter Policy to a Connection
+type attachmentKey struct {
+	connectionID  string
+	routeFilterID string
+}
+
+func (m *ResourceModel) connectionIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	var connectionIDs []string
+	if m.ConnectionIDs.IsNull() || m.ConnectionIDs.IsUnknown() {
+		return connectionIDs, nil
+	}
+	diags := m.ConnectionIDs.ElementsAs(ctx, &connectionIDs, false)
+	return connectionIDs, diags
+}
+
+func (m *ResourceModel) routeFilters(ctx context.Context) ([]RouteFilterModel, diag.Diagnostics) {
+	var routeFilters []RouteFilterModel
+	if m.RouteFilters.IsNull() || m.RouteFilters.IsUnknown() {
+		return routeFilters, nil
+	}
+	diags := m.RouteFilters.ElementsAs(ctx, &routeFilters, false)
+	return routeFilters, diags
+}
+
+func (m *ResourceModel) parallelism() int {
+	if m.Parallelism.IsNull() || m.Parallelism.IsUnknown() {
+		return defaultParallelism
+	}
+	return int(m.Parallelism.ValueInt64())
+}
+
+// desiredAttachments returns the direction of every Route Filter Policy on
+// every Connection
+func (m *ResourceModel) desiredAttachments(ctx context.Context) (map[attachmentKey]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	connectionIDs, d := m.connectionIDs(ctx)
+	diags.Append(d...)
+	routeFilters, d := m.routeFilters(ctx)
+	diags.Append(d...)
+
+	desired := make(map[attachmentKey]string, len(connectionIDs)*len(routeFilters))
+	for _, connectionID := range connectionIDs {
+		for _, routeFilter := range routeFilters {
+			key := attachmentKey{connectionID: connectionID, routeFilterID: routeFilter.RouteFilterID.ValueString()}
+			desired[key] = routeFilter.Direction.ValueString()
+		}
+	}
+	return desired, diags
+}
+
+// parse sets the Connections and Route Filter Policies from the attachments
+// found on the Connections, ignoring the ones that are not active. A desired
+// Connection is only kept when every Route Filter Policy is attached to it
+// with the desired direction, so that missing attachments show up as
+// differences. Attachments that are no longer desired but still active keep
+// their Connection and Route Filter Policy in the state until they are
+// detached
+func (m *ResourceModel) parse(ctx context.Context, desired map[attachmentKey]string, current map[attachmentKey]*fabricv4.ConnectionRouteFilterData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Parallelism.IsNull() || m.Parallelism.IsUnknown() {
+		m.Parallelism = types.Int64Value(defaultParallelism)
+	}
+
+	current = activeAttachments(current)
+	incomplete := make(map[string]bool)
+	connections := make(map[string]bool)
+	routeFilters := make(map[string]string)
+	for key, direction := range desired {
+		connections[key.connectionID] = true
+		routeFilters[key.routeFilterID] = direction
+		if connectionRouteFilter := current[key]; !isAttached(connectionRouteFilter) ||
+			string(connectionRouteFilter.GetDirection()) != direction {
+			incomplete[key.connectionID] = true
+		}
+	}
+	for key, connectionRouteFilter := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		connections[key.connectionID] = true
+		delete(incomplete, key.connectionID)
+		if _, ok := routeFilters[key.routeFilterID]; !ok {
+			routeFilters[key.routeFilterID] = string(connectionRouteFilter.GetDirection())
+		}
+	}
+
+	connectionIDs := make([]string, 0, len(connections))
+	for connectionID := range connections {
+		if !incomplete[connectionID] {
+			connectionIDs = append(connectionIDs, connectionID)
+		}
+	}
+	sort.Strings(connectionIDs)
+
+	routeFilterModels := make([]RouteFilterModel, 0, len(routeFilters))
+	for routeFilterID, direction := range routeFilters {
+		routeFilterModels = append(routeFilterModels, RouteFilterModel{
+			RouteFilterID: types.StringValue(routeFilterID),
+			Direction:     types.StringValue(direction),
+		})
+	}
+
+	var d diag.Diagnostics
+	m.ConnectionIDs, d = types.SetValueFrom(ctx, types.StringType, connectionIDs)
+	diags.Append(d...)
+	m.RouteFilters, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: fwtypes.AttributeTypesMust[RouteFilterModel](ctx)}, routeFilterModels)
+	diags.Append(d...)
+	m.Attachments, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: fwtypes.AttributeTypesMust[AttachmentModel](ctx)}, attachmentModels(current))
+	diags.Append(d...)
+
+	return diags
+}
+
+func attachmentModels(current map[attachmentKey]*fabricv4.ConnectionRouteFilterData) []AttachmentModel {
+	keys := sortedKeys(current)
+	attachments := make([]AttachmentModel, 0, len(keys))
+	for _, key := range keys {
+		connectionRouteFilter := current[key]
+		attachments = append(attachments, AttachmentModel{
+			ConnectionID:     types.StringValue(key.connectionID),
+			RouteFilterID:    types.StringValue(key.routeFilterID),
+			Direction:        types.StringValue(string(connectionRouteFilter.GetDirection())),
+			Type:             types.StringValue(string(connectionRouteFilter.GetType())),
+			Href:             types.StringValue(connectionRouteFilter.GetHref()),
+			AttachmentStatus: types.StringValue(string(connectionRouteFilter.GetAttachmentStatus())),
+		})
+	}
+	return attachments
+}
+
+// activeAttachments drops the attachments that are being, or have been,
+// detached from their Connection, as well as the ones that failed
+func activeAttachments(current map[attachmentKey]*fabricv4.ConnectionRouteFilterData) map[attachmentKey]*fabricv4.ConnectionRouteFilterData {
+	active := make(map[attachmentKey]*fabricv4.ConnectionRouteFilterData, len(current))
+	for key, connectionRouteFilter := range current {
+		if isAttached(connectionRouteFilter) {
+			active[key] = connectionRouteFilter
+		}
+	}
+	return active
+}
+
+// isAttached is true for attachments that are, or are becoming, usable
+func isAttached(connectionRouteFilter *fabricv4.ConnectionRouteFilterData) bool {
+	if connectionRouteFilter == nil {
+		return false
+	}
+	switch connectionRouteFilter.GetAttachmentStatus() {
+	case fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHING,
+		fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHED,
+		fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_PENDING_BGP_CONFIGURATION:
+		return true
+	}
+	return false
+}
+
+// isPresent is true for attachments that still have to be detached
+func isPresent(connectionRouteFilter *fabricv4.ConnectionRouteFilterData) bool {
+	if connectionRouteFilter == nil {
+		return false
+	}
+	switch connectionRouteFilter.GetAttachmentStatus() {
+	case fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHING,
+		fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHED:
+		return false
+	}
+	return true
+}
+
+// attachmentChanges are the attachments to create or update, with their
+// direction, and the attachments to remove
+type attachmentChanges struct {
+	attach map[attachmentKey]string
+	detach []attachmentKey
+}
+
+// computeAttachmentChanges diffs the current attachments against the desired ones.
+// Attachments with the wrong direction are attached again, which updates them
+func computeAttachmentChanges(current map[attachmentKey]*fabricv4.ConnectionRouteFilterData, desired map[attachmentKey]string) attachmentChanges {
+	changes := attachmentChanges{attach: make(map[attachmentKey]string)}
+	for key, direction := range desired {
+		connectionRouteFilter := current[key]
+		if !isAttached(connectionRouteFilter) || string(connectionRouteFilter.GetDirection()) != direction {
+			changes.attach[key] = direction
+		}
+	}
+	for _, key := range sortedKeys(current) {
+		if _, ok := desired[key]; !ok && isPresent(current[key]) {
+			changes.detach = append(changes.detach, key)
+		}
+	}
+	return changes
+}
+
+func sortedKeys[V any](attachments map[attachmentKey]V) []attachmentKey {
+	keys := make([]attachmentKey, 0, len(attachments))
+	for key := range attachments {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].connectionID != keys[j].connectionID {
+			return keys[i].connectionID < keys[j].connectionID
+		}
+		return keys[i].routeFilterID < keys[j].routeFilterID
+	})
+	return keys
+}
@@ -0,0 +1,113 @@
+package connection_route_filters
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func testAttachment(direction string, status fabricv4.ConnectionRouteAggregationDataAttachmentStatus) *fabricv4.ConnectionRouteFilterData {
+	connectionRouteFilter := &fabricv4.ConnectionRouteFilterData{}
+	connectionRouteFilter.SetDirection(fabricv4.ConnectionRouteFilterDataDirection(direction))
+	connectionRouteFilter.SetAttachmentStatus(status)
+	return connectionRouteFilter
+}
+
+func TestComputeAttachmentChanges(t *testing.T) {
+	current := map[attachmentKey]*fabricv4.ConnectionRouteFilterData{
+		{"conn-1", "rf-in"}:  testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHED),
+		{"conn-1", "rf-out"}: testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHED),
+		{"conn-2", "rf-in"}:  testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_FAILED),
+		{"conn-3", "rf-in"}:  testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_PENDING_BGP_CONFIGURATION),
+		{"conn-4", "rf-in"}:  testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHING),
+	}
+	desired := map[attachmentKey]string{
+		{"conn-1", "rf-in"}:  directionInbound,
+		{"conn-1", "rf-out"}: directionOutbound,
+		{"conn-2", "rf-in"}:  directionInbound,
+		{"conn-5", "rf-in"}:  directionInbound,
+	}
+
+	changes := computeAttachmentChanges(current, desired)
+
+	wantAttach := map[attachmentKey]string{
+		{"conn-1", "rf-out"}: directionOutbound,
+		{"conn-2", "rf-in"}:  directionInbound,
+		{"conn-5", "rf-in"}:  directionInbound,
+	}
+	if !reflect.DeepEqual(changes.attach, wantAttach) {
+		t.Errorf("expected attachments %v, got %v", wantAttach, changes.attach)
+	}
+	wantDetach := []attachmentKey{{"conn-3", "rf-in"}}
+	if !reflect.DeepEqual(changes.detach, wantDetach) {
+		t.Errorf("expected detachments %v, got %v", wantDetach, changes.detach)
+	}
+}
+
+func TestParse(t *testing.T) {
+	ctx := context.Background()
+	desired := map[attachmentKey]string{
+		{"conn-1", "rf-in"}: directionInbound,
+		{"conn-2", "rf-in"}: directionInbound,
+		{"conn-3", "rf-in"}: directionInbound,
+	}
+	current := map[attachmentKey]*fabricv4.ConnectionRouteFilterData{
+		{"conn-1", "rf-in"}:   testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHED),
+		{"conn-2", "rf-in"}:   testAttachment(directionOutbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHED),
+		{"conn-4", "rf-old"}:  testAttachment(directionOutbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_ATTACHED),
+		{"conn-5", "rf-old"}:  testAttachment(directionOutbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHING),
+		{"conn-6", "rf-gone"}: testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_DETACHED),
+		{"conn-7", "rf-gone"}: testAttachment(directionInbound, fabricv4.CONNECTIONROUTEAGGREGATIONDATAATTACHMENTSTATUS_FAILED),
+	}
+
+	var model ResourceModel
+	if diags := model.parse(ctx, desired, current); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	connectionIDs, _ := model.connectionIDs(ctx)
+	if want := []string{"conn-1", "conn-4"}; !reflect.DeepEqual(connectionIDs, want) {
+		t.Errorf("expected connections %v, got %v", want, connectionIDs)
+	}
+	routeFilters, _ := model.routeFilters(ctx)
+	if len(routeFilters) != 2 {
+		t.Errorf("expected the desired and the still attached route filters, got %v", routeFilters)
+	}
+	if len(model.Attachments.Elements()) != 3 {
+		t.Errorf("expected 3 attachments, got %d", len(model.Attachments.Elements()))
+	}
+	if model.parallelism() != defaultParallelism {
+		t.Errorf("expected parallelism %d, got %d", defaultParallelism, model.parallelism())
+	}
+}
+
+func TestForEachAttachment(t *testing.T) {
+	keys := []attachmentKey{{"conn-1", "rf"}, {"conn-2", "rf"}, {"conn-3", "rf"}, {"conn-4", "rf"}}
+
+	var running, maxRunning int32
+	errs := forEachAttachment(keys, 2, func(key attachmentKey) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		if key.connectionID == "conn-3" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent calls, got %d", maxRunning)
+	}
+	if len(errs) != 1 || errs[attachmentKey{"conn-3", "rf"}] == nil {
+		t.Errorf("expected a single error for conn-3, got %v", errs)
+	}
+}
@@ -0,0 +1,376 @@
+package connection_route_filters
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connection_route_filter"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_connection_route_filters",
+			},
+		),
+	}
+}
+
+type Resource struct {
+	framework.BaseResource
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routeFilters, diags := config.routeFilters(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routeFilterIDs := make(map[string]bool, len(routeFilters))
+	for _, routeFilter := range routeFilters {
+		if routeFilter.RouteFilterID.IsUnknown() {
+			continue
+		}
+		routeFilterID := routeFilter.RouteFilterID.ValueString()
+		if routeFilterIDs[routeFilterID] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("route_filters"),
+				"Duplicate Route Filter Policy",
+				fmt.Sprintf("route filter %s is listed more than once; a route filter can only be attached in one direction", routeFilterID),
+			)
+		}
+		routeFilterIDs[routeFilterID] = true
+	}
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	desired, diags := plan.desiredAttachments(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Route Filter Policies that are already attached with the desired
+	// direction are adopted rather than attached again
+	current, diags := reconcile(ctx, r.Meta, client, nil, desired, plan.parallelism(), createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if current == nil {
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.New().String())
+	resp.Diagnostics.Append(plan.parse(ctx, desired, current)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	desired, diags := state.desiredAttachments(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getAttachments(ctx, client, sortedKeys(desired), state.parallelism())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed retrieving Route Filter Policy attachments", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, desired, current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var state, plan ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	previous, diags := state.desiredAttachments(ctx)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := plan.desiredAttachments(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := reconcile(ctx, r.Meta, client, previous, desired, plan.parallelism(), updateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if current == nil {
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(plan.parse(ctx, desired, current)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	previous, diags := state.desiredAttachments(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = reconcile(ctx, r.Meta, client, previous, nil, state.parallelism(), deleteTimeout)
+	resp.Diagnostics.Append(diags...)
+}
+
+// reconcile attaches and detaches Route Filter Policies so that the attachments
+// known from the previous state match the desired ones. It returns the
+// attachments found afterwards, or nil when they couldn't be retrieved
+func reconcile(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, previous, desired map[attachmentKey]string, parallelism int, timeout time.Duration) (map[attachmentKey]*fabricv4.ConnectionRouteFilterData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tracked := make(map[attachmentKey]string, len(previous)+len(desired))
+	for key, direction := range previous {
+		tracked[key] = direction
+	}
+	for key, direction := range desired {
+		tracked[key] = direction
+	}
+	keys := sortedKeys(tracked)
+
+	current, err := getAttachments(ctx, client, keys, parallelism)
+	if err != nil {
+		diags.AddError("Failed retrieving Route Filter Policy attachments", err.Error())
+		return nil, diags
+	}
+
+	changes := computeAttachmentChanges(current, desired)
+
+	detachErrs := forEachAttachment(changes.detach, parallelism, func(key attachmentKey) error {
+		return detach(ctx, meta, client, key, timeout)
+	})
+	for _, key := range changes.detach {
+		if err, ok := detachErrs[key]; ok {
+			diags.AddError(
+				fmt.Sprintf("Failed detaching Route Filter Policy %s from Connection %s", key.routeFilterID, key.connectionID),
+				err.Error(),
+			)
+		}
+	}
+
+	attachKeys := sortedKeys(changes.attach)
+	attachErrs := forEachAttachment(attachKeys, parallelism, func(key attachmentKey) error {
+		return attach(ctx, meta, client, key, changes.attach[key], timeout)
+	})
+	for _, key := range attachKeys {
+		if err, ok := attachErrs[key]; ok {
+			diags.AddError(
+				fmt.Sprintf("Failed attaching Route Filter Policy %s to Connection %s", key.routeFilterID, key.connectionID),
+				err.Error(),
+			)
+		}
+	}
+
+	if len(changes.detach) == 0 && len(changes.attach) == 0 {
+		return current, diags
+	}
+
+	current, err = getAttachments(ctx, client, keys, parallelism)
+	if err != nil {
+		diags.AddError("Failed retrieving Route Filter Policy attachments", err.Error())
+		return nil, diags
+	}
+	return current, diags
+}
+
+func attach(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, key attachmentKey, direction string, timeout time.Duration) error {
+	_, _, err := client.RouteFiltersApi.
+		AttachConnectionRouteFilter(ctx, key.routeFilterID, key.connectionID).
+		ConnectionRouteFiltersBase(
+			fabricv4.ConnectionRouteFiltersBase{
+				Direction: fabricv4.ConnectionRouteFiltersBaseDirection(direction),
+			},
+		).Execute()
+	if err != nil {
+		return equinix_errors.FormatFabricError(err)
+	}
+
+	_, err = connection_route_filter.WaitForAttachment(ctx, meta, client, key.connectionID, key.routeFilterID, timeout)
+	return err
+}
+
+func detach(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, key attachmentKey, timeout time.Duration) error {
+	_, _, err := client.RouteFiltersApi.DetachConnectionRouteFilter(ctx, key.routeFilterID, key.connectionID).Execute()
+	if err != nil {
+		if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
+			if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
+				// EQ-3142509 = Connection already deleted
+				if equinix_errors.HasErrorCode(fabricErrs, "EQ-3142509") {
+					return nil
+				}
+			}
+		}
+		return equinix_errors.FormatFabricError(err)
+	}
+
+	return connection_route_filter.WaitForDetachment(ctx, meta, client, key.connectionID, key.routeFilterID, timeout)
+}
+
+// getAttachments retrieves the given attachments. Attachments that don't exist
+// are left out of the result
+func getAttachments(ctx context.Context, client *fabricv4.APIClient, keys []attachmentKey, parallelism int) (map[attachmentKey]*fabricv4.ConnectionRouteFilterData, error) {
+	var mu sync.Mutex
+	current := make(map[attachmentKey]*fabricv4.ConnectionRouteFilterData, len(keys))
+
+	errs := forEachAttachment(keys, parallelism, func(key attachmentKey) error {
+		connectionRouteFilter, httpResp, err := client.RouteFiltersApi.GetConnectionRouteFilterByUuid(ctx, key.routeFilterID, key.connectionID).Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode >= 400 && httpResp.StatusCode <= 499 {
+				return nil
+			}
+			return equinix_errors.FormatFabricError(err)
+		}
+		mu.Lock()
+		current[key] = connectionRouteFilter
+		mu.Unlock()
+		return nil
+	})
+	for _, key := range keys {
+		if err, ok := errs[key]; ok {
+			return nil, fmt.Errorf("route filter %s on connection %s: %w", key.routeFilterID, key.connectionID, err)
+		}
+	}
+	return current, nil
+}
+
+// forEachAttachment calls fn for every attachment, running at most parallelism
+// calls at the same time, and returns the errors by attachment
+func forEachAttachment(keys []attachmentKey, parallelism int, fn func(attachmentKey) error) map[attachmentKey]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[attachmentKey]error)
+	sem := make(chan struct{}, max(parallelism, 1))
+
+	for _, key := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(key); err != nil {
+				mu.Lock()
+				errs[key] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,107 @@
+package connection_route_filters
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows attachment of one or more Route Filter Policies to many Fabric Connections.
+Attachments are reconciled in parallel and the status of every attachment is reported per Connection.
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-route-filters.htm
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#route-filters`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"connection_ids": schema.SetAttribute{
+				Description: "Equinix Assigned UUIDs of the Equinix Connections to attach the Route Filter Policies to",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"route_filters": schema.SetNestedAttribute{
+				Description: "Route Filter Policies to attach to every Connection",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"route_filter_id": schema.StringAttribute{
+							Description: "Equinix Assigned UUID of the Route Filter Policy",
+							Required:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "Direction of the filtering of the attached Route Filter Policy. One of [ INBOUND, OUTBOUND ]",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(directionInbound, directionOutbound),
+							},
+						},
+					},
+				},
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: "Maximum number of attachments reconciled at the same time. Default: 10",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultParallelism),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"attachments": schema.ListNestedAttribute{
+				Description: "Active Route Filter Policy attachments of the Connections, sorted by Connection. Detaching, detached and failed attachments are left out",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_id": schema.StringAttribute{
+							Description: "Equinix Assigned UUID of the Equinix Connection",
+							Computed:    true,
+						},
+						"route_filter_id": schema.StringAttribute{
+							Description: "Equinix Assigned UUID of the attached Route Filter Policy",
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "Direction of the filtering of the attached Route Filter Policy",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Route Filter Type. One of [ \"BGP_IPv4_PREFIX_FILTER\", \"BGP_IPv6_PREFIX_FILTER\" ]",
+							Computed:    true,
+						},
+						"href": schema.StringAttribute{
+							Description: "URI to the attached Route Filter Policy on the Connection",
+							Computed:    true,
+						},
+						"attachment_status": schema.StringAttribute{
+							Description: "Status of the Route Filter Policy attachment lifecycle",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
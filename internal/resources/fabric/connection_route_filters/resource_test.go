@@ -0,0 +1,256 @@
+package connection_route_filters_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connection_route_filter"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFabricConnectionRouteFilters_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var portUUID string
+	if len(ports) > 0 {
+		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckConnectionRouteFiltersDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricConnectionRouteFiltersConfig(portUUID, `
+					{
+						route_filter_id = equinix_fabric_route_filter.inbound.id
+						direction       = "INBOUND"
+					},
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("equinix_fabric_connection_route_filters.test", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "connection_ids.#", "2"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "route_filters.#", "1"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "parallelism", "10"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "attachments.#", "2"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "attachments.0.direction", "INBOUND"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "attachments.0.type", "BGP_IPv4_PREFIX_FILTER"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_connection_route_filters.test", "attachments.0.attachment_status"),
+				),
+			},
+			{
+				Config: testAccFabricConnectionRouteFiltersConfig(portUUID, `
+					{
+						route_filter_id = equinix_fabric_route_filter.inbound.id
+						direction       = "INBOUND"
+					},
+					{
+						route_filter_id = equinix_fabric_route_filter.outbound.id
+						direction       = "OUTBOUND"
+					},
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "connection_ids.#", "2"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "route_filters.#", "2"),
+					resource.TestCheckResourceAttr("equinix_fabric_connection_route_filters.test", "attachments.#", "4"),
+				),
+			},
+			{
+				ResourceName:            "equinix_fabric_connection_route_filters.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccFabricConnectionRouteFiltersImportID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id", "timeouts"},
+			},
+		},
+	})
+}
+
+func testAccFabricConnectionRouteFiltersImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["equinix_fabric_connection_route_filters.test"]
+	if !ok {
+		return "", fmt.Errorf("equinix_fabric_connection_route_filters.test not found in state")
+	}
+	attributes := rs.Primary.Attributes
+	return fmt.Sprintf("%s,%s/%s:%s,%s:%s",
+		s.RootModule().Resources["equinix_fabric_connection.primary"].Primary.ID,
+		s.RootModule().Resources["equinix_fabric_connection.secondary"].Primary.ID,
+		attributes["route_filters.0.route_filter_id"], attributes["route_filters.0.direction"],
+		attributes["route_filters.1.route_filter_id"], attributes["route_filters.1.direction"],
+	), nil
+}
+
+func testAccFabricConnectionRouteFiltersConnectionConfig(name, portUUID string, vlanTag int) string {
+	return fmt.Sprintf(`
+		resource "equinix_fabric_connection" "%[1]s" {
+			type = "IP_VC"
+			name = "RF_CR_Connection_%[1]s_PFCR"
+			notifications {
+				type = "ALL"
+				emails = ["test@equinix.com","test1@equinix.com"]
+			}
+			order {
+				purchase_order_number = "123485"
+				term_length = 1
+			}
+			bandwidth = 50
+			redundancy {
+				priority= "PRIMARY"
+			}
+			a_side {
+				access_point {
+					type = "CLOUD_ROUTER"
+					router {
+						uuid = equinix_fabric_cloud_router.test.id
+					}
+				}
+			}
+			project {
+				project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+			}
+			z_side {
+				access_point {
+					type = "COLO"
+					port{
+						uuid = "%[2]s"
+					}
+					link_protocol {
+						type= "DOT1Q"
+						vlan_tag= %[3]d
+					}
+					location {
+						metro_code = "DC"
+					}
+				}
+			}
+		}
+	`, name, portUUID, vlanTag)
+}
+
+func testAccFabricConnectionRouteFiltersConfig(portUUID, routeFilters string) string {
+	return fmt.Sprintf(`
+		resource "equinix_fabric_cloud_router" "test" {
+			type = "XF_ROUTER"
+			name = "RF_CRs_PFCR"
+			location {
+				metro_code  = "DC"
+			}
+			package {
+				code = "STANDARD"
+			}
+			order {
+				purchase_order_number = "1-234567"
+				term_length = 1
+			}
+			notifications {
+				type = "ALL"
+				emails = [
+					"test@equinix.com",
+					"test1@equinix.com"
+				]
+			}
+			project {
+				project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+			}
+			account {
+				account_number = 201257
+			}
+		}
+
+		%s
+
+		%s
+
+		resource "equinix_fabric_route_filter" "inbound" {
+			name = "rfs_inbound_test_PFCR"
+			project {
+				project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+			}
+			type = "BGP_IPv4_PREFIX_FILTER"
+			description = "Inbound Route Filter Policy for X Purpose"
+		}
+
+		resource "equinix_fabric_route_filter_rules" "inbound" {
+			route_filter_id = equinix_fabric_route_filter.inbound.id
+			rules = [
+				{
+					prefix       = "192.168.0.0/24"
+					prefix_match = "exact"
+				},
+			]
+		}
+
+		resource "equinix_fabric_route_filter" "outbound" {
+			name = "rfs_outbound_test_PFCR"
+			project {
+				project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+			}
+			type = "BGP_IPv4_PREFIX_FILTER"
+			description = "Outbound Route Filter Policy for X Purpose"
+		}
+
+		resource "equinix_fabric_route_filter_rules" "outbound" {
+			route_filter_id = equinix_fabric_route_filter.outbound.id
+			rules = [
+				{
+					prefix = "10.10.0.0/16"
+				},
+			]
+		}
+
+		resource "equinix_fabric_connection_route_filters" "test" {
+			depends_on = [
+				equinix_fabric_route_filter_rules.inbound,
+				equinix_fabric_route_filter_rules.outbound,
+			]
+			connection_ids = [
+				equinix_fabric_connection.primary.id,
+				equinix_fabric_connection.secondary.id,
+			]
+			route_filters = [
+				%s
+			]
+		}
+	`,
+		testAccFabricConnectionRouteFiltersConnectionConfig("primary", portUUID, 2110),
+		testAccFabricConnectionRouteFiltersConnectionConfig("secondary", portUUID, 2111),
+		routeFilters,
+	)
+}
+
+func CheckConnectionRouteFiltersDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewFabricClientForTesting(ctx)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_connection_route_filters" {
+			continue
+		}
+
+		for _, attachment := range testAccAttachments(rs.Primary.Attributes) {
+			err := connection_route_filter.WaitForDetachment(ctx, acceptance.TestAccProvider.Meta().(*config.Config), client, attachment[0], attachment[1], 10*time.Minute)
+			if err != nil {
+				return fmt.Errorf("API call failed while waiting for resource deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccAttachments(attributes map[string]string) [][2]string {
+	var attachments [][2]string
+	for i := 0; ; i++ {
+		connectionID, ok := attributes[fmt.Sprintf("attachments.%d.connection_id", i)]
+		if !ok {
+			return attachments
+		}
+		attachments = append(attachments, [2]string{connectionID, attributes[fmt.Sprintf("attachments.%d.route_filter_id", i)]})
+	}
+}
@@ -66,7 +66,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, routeAggregationID, connectionID, createTimeout)
-	connectionRouteAggregationChecked, err := createWaiter.WaitForStateContext(ctx)
+	connectionRouteAggregationChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed attaching Route Aggregation %s", connectionRouteAggregation.GetUuid()), err.Error())
 		return
@@ -148,7 +148,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deletewaiter := getDeleteWaiter(ctx, client, routeAggregationID, connectionID, deleteTimeout)
-	_, err = deletewaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deletewaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed detaching Connection Route Aggregation %s", id), err.Error())
@@ -171,9 +171,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, rout
 			}
 			return connectionRouteAggregation, string(connectionRouteAggregation.GetAttachmentStatus()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 }
 
@@ -200,8 +199,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, routeAggre
 			}
 			return routeAggregationRule, string(routeAggregationRule.GetAttachmentStatus()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
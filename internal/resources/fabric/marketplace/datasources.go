@@ -5,7 +5,9 @@ import (
 	"log"
 	"strings"
 
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/equinix/terraform-provider-equinix/internal/config"
+	"github.com/equinix/terraform-provider-equinix/internal/converters"
 	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,3 +40,28 @@ func fabricMarketplaceSubscriptionRead(ctx context.Context, d *schema.ResourceDa
 	d.SetId(subscription.GetUuid())
 	return setFabricMap(d, subscription)
 }
+
+func DataSourceFabricMarketplaceSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricMarketplaceSubscriptionsRead,
+		Schema:      fabricMarketplaceSubscriptionsDataSourceSchema(),
+		Description: "Fabric V4 API compatible data resource that allow user to fetch Marketplace Subscription details for the given UUIDs and filter them by marketplace, status and quantity available. The Fabric API has no endpoint to list or search subscriptions, so only the subscriptions with the given UUIDs are read",
+	}
+}
+
+func dataSourceFabricMarketplaceSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	uuids := converters.IfArrToStringArr(d.Get("uuids").([]interface{}))
+	subscriptions := make([]fabricv4.SubscriptionResponse, 0, len(uuids))
+	for _, uuid := range uuids {
+		subscription, _, err := client.MarketplaceSubscriptionsApi.GetSubscriptionById(ctx, uuid).Execute()
+		if err != nil {
+			return diag.FromErr(equinix_errors.FormatFabricError(err))
+		}
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	filtered := filterSubscriptions(subscriptions, d.Get("marketplace").(string), d.Get("status").(string), d.Get("min_quantity_available").(int))
+	d.SetId(strings.Join(uuids, ","))
+	return setFabricSubscriptionsData(d, filtered)
+}
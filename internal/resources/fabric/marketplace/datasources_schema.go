@@ -1,7 +1,11 @@
 package marketplace
 
 import (
+	"fmt"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func fabricMarketplaceSubscriptionDataSourceSchema() map[string]*schema.Schema {
@@ -36,6 +40,19 @@ func fabricMarketplaceSubscriptionDataSourceSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "Information about subscription auto renewal",
 		},
+		"metro_codes": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of metro codes the subscription is available in",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"quantity_available": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Quantity available across all subscription entitlements",
+		},
 		"trial": {
 			Type:        schema.TypeSet,
 			Computed:    true,
@@ -51,6 +68,49 @@ func fabricMarketplaceSubscriptionDataSourceSchema() map[string]*schema.Schema {
 	}
 }
 
+func fabricMarketplaceSubscriptionsDataSourceSchema() map[string]*schema.Schema {
+	subscriptionSchema := fabricMarketplaceSubscriptionDataSourceSchema()
+	subscriptionSchema["uuid"].Required = false
+	subscriptionSchema["uuid"].Computed = true
+	return map[string]*schema.Schema{
+		"uuids": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Equinix-assigned identifiers of the marketplace subscriptions to fetch",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"marketplace": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(enumStrings(fabricv4.AllowedSubscriptionResponseMarketplaceEnumValues), false),
+			Description:  fmt.Sprintf("Only return subscriptions from this marketplace. One of %v", fabricv4.AllowedSubscriptionResponseMarketplaceEnumValues),
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(enumStrings(fabricv4.AllowedSubscriptionStateEnumValues), false),
+			Description:  fmt.Sprintf("Only return subscriptions with this status. One of %v", fabricv4.AllowedSubscriptionStateEnumValues),
+		},
+		"min_quantity_available": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Only return subscriptions with at least this quantity available across their entitlements",
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of matching Marketplace Subscriptions, ordered by descending quantity available",
+			Elem: &schema.Resource{
+				Schema: subscriptionSchema,
+			},
+		},
+	}
+}
+
 func marketplaceSubscriptionTrialSch() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -26,6 +26,11 @@ func TestAccFabricDataSourceMarketPlace_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscription.test", "marketplace", "AWS"),
 					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscription.test", "offer_type", "PUBLIC"),
 					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscription.test", "is_auto_renew", "false"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_market_place_subscription.test", "quantity_available"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscriptions.test", "data.#", "1"),
+					resource.TestCheckResourceAttrPair("data.equinix_fabric_market_place_subscriptions.test", "data.0.uuid", "data.equinix_fabric_market_place_subscription.test", "uuid"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_market_place_subscriptions.test", "data.0.marketplace", "AWS"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_market_place_subscriptions.test", "data.0.entitlements.0.quantity_available"),
 				),
 			},
 		},
@@ -35,7 +40,12 @@ func TestAccFabricDataSourceMarketPlace_PFCR(t *testing.T) {
 func configGetMarketplaceSubscriptionResource(subscriptionID string) string {
 	return fmt.Sprintf(`
 	data "equinix_fabric_market_place_subscription" "test"{
-		uuid = "%s"
+		uuid = "%[1]s"
+	}
+	data "equinix_fabric_market_place_subscriptions" "test"{
+		uuids       = ["%[1]s"]
+		marketplace = "AWS"
+		status      = "ACTIVE"
 	}
 `, subscriptionID)
 }
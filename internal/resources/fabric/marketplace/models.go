@@ -1,6 +1,8 @@
 package marketplace
 
 import (
+	"sort"
+
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,6 +27,8 @@ func subscriptionMap(subs *fabricv4.SubscriptionResponse) map[string]interface{}
 	subscription["marketplace"] = subs.GetMarketplace()
 	subscription["offer_type"] = subs.GetOfferType()
 	subscription["is_auto_renew"] = subs.GetIsAutoRenew()
+	subscription["metro_codes"] = subs.GetMetroCodes()
+	subscription["quantity_available"] = int(quantityAvailable(subs))
 	if subs.Trial != nil {
 		trial := subs.GetTrial()
 		subscription["trial"] = subscriptionTrialGoToTerraform(&trial)
@@ -36,6 +40,60 @@ func subscriptionMap(subs *fabricv4.SubscriptionResponse) map[string]interface{}
 	return subscription
 }
 
+func setFabricSubscriptionsData(d *schema.ResourceData, subscriptions []fabricv4.SubscriptionResponse) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	mappedSubscriptions := make([]map[string]interface{}, len(subscriptions))
+	for index, subscription := range subscriptions {
+		mappedSubscriptions[index] = subscriptionMap(&subscription)
+	}
+	err := equinix_schema.SetMap(d, map[string]interface{}{
+		"data": mappedSubscriptions,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// quantityAvailable sums the quantity available across the subscription entitlements
+func quantityAvailable(subs *fabricv4.SubscriptionResponse) int32 {
+	var available int32
+	for _, entitlement := range subs.GetEntitlements() {
+		available += entitlement.GetQuantityAvailable()
+	}
+	return available
+}
+
+// filterSubscriptions keeps the subscriptions matching the given marketplace,
+// status and minimum quantity available, with the most capacity first
+func filterSubscriptions(subscriptions []fabricv4.SubscriptionResponse, marketplace, status string, minQuantityAvailable int) []fabricv4.SubscriptionResponse {
+	filtered := make([]fabricv4.SubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if marketplace != "" && string(subscription.GetMarketplace()) != marketplace {
+			continue
+		}
+		if status != "" && string(subscription.GetState()) != status {
+			continue
+		}
+		if int(quantityAvailable(&subscription)) < minQuantityAvailable {
+			continue
+		}
+		filtered = append(filtered, subscription)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return quantityAvailable(&filtered[i]) > quantityAvailable(&filtered[j])
+	})
+	return filtered
+}
+
+func enumStrings[T ~string](values []T) []string {
+	strs := make([]string, len(values))
+	for index, value := range values {
+		strs[index] = string(value)
+	}
+	return strs
+}
+
 func subscriptionTrialGoToTerraform(trial *fabricv4.SubscriptionTrial) *schema.Set {
 	if trial == nil {
 		return nil
@@ -57,10 +115,11 @@ func subscriptionEntitlementsGoToTerraform(entitlementsList []fabricv4.Subscript
 	for index, entitlements := range entitlementsList {
 		asset := entitlements.GetAsset()
 		mappedEntitlements[index] = map[string]interface{}{
-			"uuid":              entitlements.GetUuid(),
-			"quantity_entitled": entitlements.GetQuantityEntitled(),
-			"quantity_consumed": entitlements.GetQuantityConsumed(),
-			"asset":             subscriptionAssetGoToTerraform(&asset),
+			"uuid":               entitlements.GetUuid(),
+			"quantity_entitled":  entitlements.GetQuantityEntitled(),
+			"quantity_consumed":  entitlements.GetQuantityConsumed(),
+			"quantity_available": entitlements.GetQuantityAvailable(),
+			"asset":              subscriptionAssetGoToTerraform(&asset),
 		}
 	}
 	return mappedEntitlements
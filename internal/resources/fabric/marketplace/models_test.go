@@ -0,0 +1,64 @@
+package marketplace
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func testSubscription(uuid string, marketplace fabricv4.SubscriptionResponseMarketplace, state fabricv4.SubscriptionState, available ...int32) fabricv4.SubscriptionResponse {
+	subscription := fabricv4.SubscriptionResponse{}
+	subscription.SetUuid(uuid)
+	subscription.SetMarketplace(marketplace)
+	subscription.SetState(state)
+	entitlements := make([]fabricv4.SubscriptionEntitlementResponse, len(available))
+	for index, quantity := range available {
+		entitlements[index].SetQuantityAvailable(quantity)
+	}
+	subscription.SetEntitlements(entitlements)
+	return subscription
+}
+
+func TestFilterSubscriptions(t *testing.T) {
+	subscriptions := []fabricv4.SubscriptionResponse{
+		testSubscription("aws-small", fabricv4.SUBSCRIPTIONRESPONSEMARKETPLACE_AWS, fabricv4.SUBSCRIPTIONSTATE_ACTIVE, 1),
+		testSubscription("aws-large", fabricv4.SUBSCRIPTIONRESPONSEMARKETPLACE_AWS, fabricv4.SUBSCRIPTIONSTATE_ACTIVE, 2, 3),
+		testSubscription("aws-expired", fabricv4.SUBSCRIPTIONRESPONSEMARKETPLACE_AWS, fabricv4.SUBSCRIPTIONSTATE_EXPIRED, 10),
+		testSubscription("gcp", fabricv4.SUBSCRIPTIONRESPONSEMARKETPLACE_GCP, fabricv4.SUBSCRIPTIONSTATE_ACTIVE, 4),
+		testSubscription("aws-empty", fabricv4.SUBSCRIPTIONRESPONSEMARKETPLACE_AWS, fabricv4.SUBSCRIPTIONSTATE_ACTIVE),
+	}
+
+	tests := map[string]struct {
+		marketplace string
+		status      string
+		minQuantity int
+		want        []string
+	}{
+		"no filters": {
+			want: []string{"aws-expired", "aws-large", "gcp", "aws-small", "aws-empty"},
+		},
+		"marketplace and status": {
+			marketplace: "AWS",
+			status:      "ACTIVE",
+			want:        []string{"aws-large", "aws-small", "aws-empty"},
+		},
+		"with capacity": {
+			status:      "ACTIVE",
+			minQuantity: 1,
+			want:        []string{"aws-large", "gcp", "aws-small"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filtered := filterSubscriptions(subscriptions, tc.marketplace, tc.status, tc.minQuantity)
+			got := make([]string, len(filtered))
+			for index, subscription := range filtered {
+				got[index] = subscription.GetUuid()
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+// Package metalinterconnection for the composite Metal to Fabric interconnection resource
+package metalinterconnection
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/metal/connection"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/equinix-sdk-go/services/metalv1"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceModel struct {
+	ID           types.String                                     `tfsdk:"id"`
+	Timeouts     timeouts.Value                                   `tfsdk:"timeouts"`
+	ProjectID    types.String                                     `tfsdk:"project_id"`
+	Name         types.String                                     `tfsdk:"name"`
+	Metro        types.String                                     `tfsdk:"metro"`
+	Speed        types.String                                     `tfsdk:"speed"`
+	Redundancy   types.String                                     `tfsdk:"redundancy"`
+	Description  types.String                                     `tfsdk:"description"`
+	ContactEmail types.String                                     `tfsdk:"contact_email"`
+	Vlans        types.List                                       `tfsdk:"vlans"` // List of ints
+	Vrfs         types.List                                       `tfsdk:"vrfs"`  // List of strings
+	Fabric       fwtypes.ObjectValueOf[FabricModel]               `tfsdk:"fabric"`
+	Status       types.String                                     `tfsdk:"status"`
+	Connections  fwtypes.ListNestedObjectValueOf[ConnectionModel] `tfsdk:"connections"`
+}
+
+type FabricModel struct {
+	Type               types.String                      `tfsdk:"type"`
+	ID                 types.String                      `tfsdk:"id"`
+	SecondaryID        types.String                      `tfsdk:"secondary_id"`
+	VlanTags           fwtypes.ListValueOf[types.Int64]  `tfsdk:"vlan_tags"`
+	NotificationEmails fwtypes.ListValueOf[types.String] `tfsdk:"notification_emails"`
+}
+
+type ConnectionModel struct {
+	Role                  types.String `tfsdk:"role"`
+	ServiceTokenID        types.String `tfsdk:"service_token_id"`
+	FabricConnectionID    types.String `tfsdk:"fabric_connection_id"`
+	FabricConnectionState types.String `tfsdk:"fabric_connection_state"`
+}
+
+// fabric holds the plain values of the fabric block used to build the
+// Fabric Connection requests
+type fabric struct {
+	accessPointType    fabricv4.AccessPointType
+	id                 string
+	secondaryID        string
+	vlanTags           []int64
+	notificationEmails []string
+}
+
+func (m *ResourceModel) fabric(ctx context.Context) (fabric, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fabricModel, d := m.Fabric.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fabric{}, diags
+	}
+
+	f := fabric{
+		accessPointType: fabricv4.AccessPointType(fabricModel.Type.ValueString()),
+		id:              fabricModel.ID.ValueString(),
+		secondaryID:     fabricModel.SecondaryID.ValueString(),
+	}
+	if f.secondaryID == "" {
+		f.secondaryID = f.id
+	}
+	diags.Append(fabricModel.VlanTags.ElementsAs(ctx, &f.vlanTags, true)...)
+	diags.Append(fabricModel.NotificationEmails.ElementsAs(ctx, &f.notificationEmails, true)...)
+	return f, diags
+}
+
+// tokenCount is the number of service tokens, and so Fabric Connections, the
+// Metal connection redundancy results in
+func tokenCount(redundancy string) int {
+	if redundancy == string(metalv1.INTERCONNECTIONREDUNDANCY_REDUNDANT) {
+		return 2
+	}
+	return 1
+}
+
+// validate checks that the number of virtual circuits and COLO VLAN tags
+// matches the redundancy of the interconnection
+func (m *ResourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.Redundancy.IsUnknown() {
+		return diags
+	}
+	redundancy := m.Redundancy.ValueString()
+	if m.Redundancy.IsNull() {
+		redundancy = string(metalv1.INTERCONNECTIONREDUNDANCY_PRIMARY)
+	}
+
+	for _, circuits := range []struct {
+		attribute string
+		value     types.List
+	}{
+		{"vlans", m.Vlans},
+		{"vrfs", m.Vrfs},
+	} {
+		if circuits.value.IsNull() || circuits.value.IsUnknown() {
+			continue
+		}
+		if count := tokenCount(redundancy); len(circuits.value.Elements()) != count {
+			diags.AddAttributeError(path.Root(circuits.attribute), fmt.Sprintf("Wrong number of %s", circuits.attribute),
+				fmt.Sprintf("a %s interconnection requires %d %s, got %d", redundancy, count, circuits.attribute, len(circuits.value.Elements())))
+		}
+	}
+
+	if m.Fabric.IsNull() || m.Fabric.IsUnknown() {
+		return diags
+	}
+	fabricModel, d := m.Fabric.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || fabricModel.Type.IsUnknown() || fabricModel.VlanTags.IsUnknown() {
+		return diags
+	}
+	f := fabric{accessPointType: fabricv4.AccessPointType(fabricModel.Type.ValueString())}
+	diags.Append(fabricModel.VlanTags.ElementsAs(ctx, &f.vlanTags, true)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(f.validate(redundancy)...)
+	return diags
+}
+
+func (f fabric) validate(redundancy string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributePath := path.Root("fabric")
+
+	if f.accessPointType == fabricv4.ACCESSPOINTTYPE_COLO {
+		if count := tokenCount(redundancy); len(f.vlanTags) != count {
+			diags.AddAttributeError(attributePath.AtName("vlan_tags"), "Wrong number of vlan_tags",
+				fmt.Sprintf("a %s interconnection to a COLO port requires %d vlan_tags, got %d", redundancy, count, len(f.vlanTags)))
+		}
+	} else if len(f.vlanTags) != 0 {
+		diags.AddAttributeError(attributePath.AtName("vlan_tags"), "Invalid vlan_tags",
+			fmt.Sprintf("vlan_tags can only be set for COLO interconnections, not %s", f.accessPointType))
+	}
+	return diags
+}
+
+// serviceTokenType returns the side of the Fabric Connection the Metal
+// service tokens are redeemed on; Cloud Routers can only be the A side of a
+// connection so they redeem Z side tokens
+func serviceTokenType(accessPointType fabricv4.AccessPointType) metalv1.VlanFabricVcCreateInputServiceTokenType {
+	if accessPointType == fabricv4.ACCESSPOINTTYPE_CLOUD_ROUTER {
+		return metalv1.VLANFABRICVCCREATEINPUTSERVICETOKENTYPE_Z_SIDE
+	}
+	return metalv1.VLANFABRICVCCREATEINPUTSERVICETOKENTYPE_A_SIDE
+}
+
+// speedMbps converts a Metal connection speed such as 50Mbps or 10Gbps to
+// the Fabric Connection bandwidth in Mbps
+func speedMbps(speed string) (int32, error) {
+	match := connection.SpeedFormat.FindStringSubmatch(speed)
+	if match == nil {
+		return 0, fmt.Errorf("invalid speed string %v, must match %v", speed, connection.SpeedFormat.String())
+	}
+	value, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if match[3] == "G" {
+		value *= 1000
+	}
+	return int32(value), nil
+}
+
+// connectionModel returns the metal/connection plan creating the shared
+// Metal connection whose service tokens the Fabric Connections redeem
+func (m *ResourceModel) connectionModel(accessPointType fabricv4.AccessPointType) connection.ResourceModel {
+	return connection.ResourceModel{
+		Name:             m.Name,
+		Metro:            m.Metro,
+		Redundancy:       m.Redundancy,
+		ContactEmail:     m.ContactEmail,
+		Type:             types.StringValue(string(metalv1.INTERCONNECTIONTYPE_SHARED)),
+		ProjectID:        m.ProjectID,
+		Speed:            m.Speed,
+		Description:      m.Description,
+		Vlans:            m.Vlans,
+		Vrfs:             m.Vrfs,
+		ServiceTokenType: types.StringValue(string(serviceTokenType(accessPointType))),
+	}
+}
+
+// sortedServiceTokens returns the service tokens of the Metal connection with
+// the primary token first
+func sortedServiceTokens(conn *metalv1.Interconnection) []metalv1.FabricServiceToken {
+	tokens := slices.Clone(conn.GetServiceTokens())
+	slices.SortStableFunc(tokens, func(a, b metalv1.FabricServiceToken) int {
+		isSecondary := func(token metalv1.FabricServiceToken) int {
+			if token.GetRole() == metalv1.FABRICSERVICETOKENROLE_SECONDARY {
+				return 1
+			}
+			return 0
+		}
+		return isSecondary(a) - isSecondary(b)
+	})
+	return tokens
+}
+
+// buildFabricConnectionRequest builds the request redeeming the service token
+// at index of the sorted Metal service tokens; secondary connections join the
+// redundancy group of the primary connection
+func (m *ResourceModel) buildFabricConnectionRequest(f fabric, index int, tokenID, group string) (fabricv4.ConnectionPostRequest, error) {
+	bandwidth, err := speedMbps(m.Speed.ValueString())
+	if err != nil {
+		return fabricv4.ConnectionPostRequest{}, err
+	}
+
+	name := m.Name.ValueString()
+	accessPointID := f.id
+	priority := fabricv4.CONNECTIONPRIORITY_PRIMARY
+	if index > 0 {
+		name += "-sec"
+		accessPointID = f.secondaryID
+		priority = fabricv4.CONNECTIONPRIORITY_SECONDARY
+	}
+
+	request := fabricv4.ConnectionPostRequest{
+		Name:      name,
+		Bandwidth: bandwidth,
+		Notifications: []fabricv4.SimplifiedNotification{
+			{
+				Type:   fabricv4.SIMPLIFIEDNOTIFICATIONTYPE_ALL,
+				Emails: f.notificationEmails,
+			},
+		},
+		Redundancy: &fabricv4.ConnectionRedundancy{
+			Priority: &priority,
+		},
+	}
+	if group != "" {
+		request.Redundancy.Group = &group
+	}
+
+	tokenSide := fabricv4.ConnectionSide{
+		ServiceToken: &fabricv4.ServiceToken{Uuid: &tokenID},
+	}
+	accessPoint := &fabricv4.AccessPoint{Type: &f.accessPointType}
+
+	switch f.accessPointType {
+	case fabricv4.ACCESSPOINTTYPE_CLOUD_ROUTER:
+		request.Type = fabricv4.CONNECTIONTYPE_IP_VC
+		accessPoint.Router = &fabricv4.CloudRouter{Uuid: &accessPointID}
+		request.ASide = fabricv4.ConnectionSide{AccessPoint: accessPoint}
+		request.ZSide = tokenSide
+		return request, nil
+	case fabricv4.ACCESSPOINTTYPE_COLO:
+		request.Type = fabricv4.CONNECTIONTYPE_EVPL_VC
+		vlanTag := int32(f.vlanTags[index])
+		accessPoint.Port = &fabricv4.SimplifiedPort{Uuid: &accessPointID}
+		accessPoint.LinkProtocol = &fabricv4.SimplifiedLinkProtocol{
+			Type:    fabricv4.LINKPROTOCOLTYPE_DOT1_Q.Ptr(),
+			VlanTag: &vlanTag,
+		}
+	case fabricv4.ACCESSPOINTTYPE_NETWORK:
+		request.Type = fabricv4.CONNECTIONTYPE_EVPLAN_VC
+		accessPoint.Network = &fabricv4.SimplifiedNetwork{Uuid: accessPointID}
+	default:
+		return fabricv4.ConnectionPostRequest{}, fmt.Errorf("unsupported fabric type %s", f.accessPointType)
+	}
+	request.ASide = tokenSide
+	request.ZSide = fabricv4.ConnectionSide{AccessPoint: accessPoint}
+	return request, nil
+}
+
+func (m *ResourceModel) parse(ctx context.Context, conn *metalv1.Interconnection, connections []ConnectionModel) {
+	m.ID = types.StringValue(conn.GetId())
+	m.Status = types.StringValue(conn.GetStatus())
+	m.Connections = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, connections)
+}
@@ -0,0 +1,238 @@
+package metalinterconnection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/equinix-sdk-go/services/metalv1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSpeedMbps(t *testing.T) {
+	tests := map[string]struct {
+		speed   string
+		want    int32
+		wantErr bool
+	}{
+		"megabits": {speed: "50Mbps", want: 50},
+		"gigabits": {speed: "10Gbps", want: 10000},
+		"invalid":  {speed: "10 Gbps", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := speedMbps(tc.speed)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSortedServiceTokens(t *testing.T) {
+	secondary := metalv1.FABRICSERVICETOKENROLE_SECONDARY
+	primary := metalv1.FABRICSERVICETOKENROLE_PRIMARY
+	conn := &metalv1.Interconnection{
+		ServiceTokens: []metalv1.FabricServiceToken{
+			{Id: metalv1.PtrString("token-2"), Role: &secondary},
+			{Id: metalv1.PtrString("token-1"), Role: &primary},
+		},
+	}
+
+	tokens := sortedServiceTokens(conn)
+	if len(tokens) != 2 || tokens[0].GetId() != "token-1" || tokens[1].GetId() != "token-2" {
+		t.Errorf("expected primary token first, got %v", tokens)
+	}
+}
+
+func TestBuildFabricConnectionRequest(t *testing.T) {
+	model := ResourceModel{
+		Name:  types.StringValue("metal-fabric"),
+		Speed: types.StringValue("1Gbps"),
+	}
+
+	tests := map[string]struct {
+		fabric       fabric
+		index        int
+		group        string
+		wantType     fabricv4.ConnectionType
+		wantName     string
+		wantPriority fabricv4.ConnectionPriority
+		wantTokenOn  string
+		check        func(t *testing.T, request fabricv4.ConnectionPostRequest)
+	}{
+		"cloud router redeems z side token": {
+			fabric:       fabric{accessPointType: fabricv4.ACCESSPOINTTYPE_CLOUD_ROUTER, id: "router-1", secondaryID: "router-1"},
+			wantType:     fabricv4.CONNECTIONTYPE_IP_VC,
+			wantName:     "metal-fabric",
+			wantPriority: fabricv4.CONNECTIONPRIORITY_PRIMARY,
+			wantTokenOn:  "z",
+			check: func(t *testing.T, request fabricv4.ConnectionPostRequest) {
+				router := request.ASide.AccessPoint.GetRouter()
+				if router.GetUuid() != "router-1" {
+					t.Errorf("expected cloud router router-1, got %s", router.GetUuid())
+				}
+			},
+		},
+		"secondary colo port uses its vlan tag and the primary group": {
+			fabric:       fabric{accessPointType: fabricv4.ACCESSPOINTTYPE_COLO, id: "port-1", secondaryID: "port-2", vlanTags: []int64{100, 200}},
+			index:        1,
+			group:        "group-1",
+			wantType:     fabricv4.CONNECTIONTYPE_EVPL_VC,
+			wantName:     "metal-fabric-sec",
+			wantPriority: fabricv4.CONNECTIONPRIORITY_SECONDARY,
+			wantTokenOn:  "a",
+			check: func(t *testing.T, request fabricv4.ConnectionPostRequest) {
+				port := request.ZSide.AccessPoint.GetPort()
+				linkProtocol := request.ZSide.AccessPoint.GetLinkProtocol()
+				if port.GetUuid() != "port-2" || linkProtocol.GetVlanTag() != 200 {
+					t.Errorf("expected port-2 with vlan tag 200, got %s with %d", port.GetUuid(), linkProtocol.GetVlanTag())
+				}
+				if request.Redundancy.GetGroup() != "group-1" {
+					t.Errorf("expected redundancy group group-1, got %s", request.Redundancy.GetGroup())
+				}
+			},
+		},
+		"network redeems a side token": {
+			fabric:       fabric{accessPointType: fabricv4.ACCESSPOINTTYPE_NETWORK, id: "network-1", secondaryID: "network-1"},
+			wantType:     fabricv4.CONNECTIONTYPE_EVPLAN_VC,
+			wantName:     "metal-fabric",
+			wantPriority: fabricv4.CONNECTIONPRIORITY_PRIMARY,
+			wantTokenOn:  "a",
+			check: func(t *testing.T, request fabricv4.ConnectionPostRequest) {
+				network := request.ZSide.AccessPoint.GetNetwork()
+				if network.GetUuid() != "network-1" {
+					t.Errorf("expected network network-1, got %s", network.GetUuid())
+				}
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			request, err := model.buildFabricConnectionRequest(tc.fabric, tc.index, "token-1", tc.group)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if request.Type != tc.wantType || request.Name != tc.wantName || request.Bandwidth != 1000 {
+				t.Errorf("expected %s %s with 1000 Mbps, got %s %s with %d Mbps",
+					tc.wantType, tc.wantName, request.Type, request.Name, request.Bandwidth)
+			}
+			if request.Redundancy.GetPriority() != tc.wantPriority {
+				t.Errorf("expected priority %s, got %s", tc.wantPriority, request.Redundancy.GetPriority())
+			}
+			tokenSide := request.ASide
+			if tc.wantTokenOn == "z" {
+				tokenSide = request.ZSide
+			}
+			if tokenSide.ServiceToken.GetUuid() != "token-1" {
+				t.Errorf("expected service token token-1 on the %s side, got %v", tc.wantTokenOn, tokenSide.ServiceToken)
+			}
+			tc.check(t, request)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	ctx := context.Background()
+	vlans := func(ids ...int64) types.List {
+		values, _ := types.ListValueFrom(ctx, types.Int64Type, ids)
+		return values
+	}
+	vlanTags := func(tags ...int64) fwtypes.ListValueOf[types.Int64] {
+		values := make([]attr.Value, 0, len(tags))
+		for _, tag := range tags {
+			values = append(values, types.Int64Value(tag))
+		}
+		return fwtypes.NewListValueOfMust[types.Int64](ctx, values)
+	}
+	fabricOf := func(accessPointType string, vlanTags fwtypes.ListValueOf[types.Int64]) FabricModel {
+		return FabricModel{
+			Type:               types.StringValue(accessPointType),
+			ID:                 types.StringValue("access-point-1"),
+			SecondaryID:        types.StringNull(),
+			VlanTags:           vlanTags,
+			NotificationEmails: fwtypes.NewListValueOfNull[types.String](ctx),
+		}
+	}
+
+	tests := map[string]struct {
+		redundancy types.String
+		vlans      types.List
+		fabric     FabricModel
+		wantErrors int
+	}{
+		"primary cloud router": {
+			redundancy: types.StringNull(),
+			vlans:      vlans(1000),
+			fabric:     fabricOf("CLOUD_ROUTER", fwtypes.NewListValueOfNull[types.Int64](ctx)),
+		},
+		"redundant colo": {
+			redundancy: types.StringValue("redundant"),
+			vlans:      vlans(1000, 1001),
+			fabric:     fabricOf("COLO", vlanTags(100, 200)),
+		},
+		"redundant with one vlan": {
+			redundancy: types.StringValue("redundant"),
+			vlans:      vlans(1000),
+			fabric:     fabricOf("NETWORK", fwtypes.NewListValueOfNull[types.Int64](ctx)),
+			wantErrors: 1,
+		},
+		"colo without vlan tags": {
+			redundancy: types.StringValue("primary"),
+			vlans:      vlans(1000),
+			fabric:     fabricOf("COLO", fwtypes.NewListValueOfNull[types.Int64](ctx)),
+			wantErrors: 1,
+		},
+		"vlan tags on a network": {
+			redundancy: types.StringValue("primary"),
+			vlans:      vlans(1000),
+			fabric:     fabricOf("NETWORK", vlanTags(100)),
+			wantErrors: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			model := ResourceModel{
+				Redundancy: tc.redundancy,
+				Vlans:      tc.vlans,
+				Vrfs:       types.ListNull(types.StringType),
+			}
+			model.Fabric = fwtypes.NewObjectValueOf(ctx, &tc.fabric)
+			if diags := model.validate(ctx); diags.ErrorsCount() != tc.wantErrors {
+				t.Errorf("expected %d errors, got %v", tc.wantErrors, diags)
+			}
+		})
+	}
+}
+
+func TestGetActiveWaiter_failedStatus(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "connection", "status": "failed"}`))
+	}))
+	defer mockAPI.Close()
+
+	configuration := metalv1.NewConfiguration()
+	configuration.Servers = metalv1.ServerConfigurations{{URL: mockAPI.URL}}
+	client := metalv1.NewAPIClient(configuration)
+
+	waiter := getActiveWaiter(context.Background(), client, "connection", time.Minute)
+	waiter.Delay = 0
+	_, err := waiter.WaitForStateContext(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Fatalf("expected the wait to end with the failed status, got %v", err)
+	}
+}
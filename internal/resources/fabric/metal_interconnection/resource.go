@@ -0,0 +1,397 @@
+package metalinterconnection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	fabricconnection "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/connection"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/metal/connection"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/equinix-sdk-go/services/metalv1"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// NB: organization.address and organization.billing_address need to be
+// included otherwise the Interconnection response is invalid against the
+// API spec
+var connectionIncludes = []string{"service_tokens", "organization", "organization.address", "organization.billing_address"}
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_metal_interconnection",
+			},
+		),
+	}
+}
+
+type Resource struct {
+	framework.BaseResource
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+// ValidateConfig checks the virtual circuits and COLO VLAN tags against the
+// redundancy before any API call is made
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate(ctx)...)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	start := time.Now()
+
+	fabricSide, diags := plan.fabric(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createRequest, diags := connection.BuildCreateRequest(ctx, plan.connectionModel(fabricSide.accessPointType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metalClient := r.Meta.NewMetalClientForFramework(ctx, req.ProviderMeta)
+	fabricClient := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	conn, _, err := metalClient.InterconnectionsApi.CreateProjectInterconnection(ctx, plan.ProjectID.ValueString()).
+		CreateOrganizationInterconnectionRequest(createRequest).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Metal Connection", err.Error())
+		return
+	}
+
+	// Save the Metal connection right away so that a failure while redeeming
+	// its service tokens taints the resource instead of orphaning the connection
+	connections := make([]ConnectionModel, 0, tokenCount(plan.Redundancy.ValueString()))
+	plan.parse(ctx, conn, connections)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := conn.GetId()
+	tokensWaiter := getServiceTokensWaiter(ctx, metalClient, id, tokenCount(plan.Redundancy.ValueString()), createTimeout-time.Since(start))
+	tokensConn, err := r.Meta.WaitForStateContext(ctx, tokensWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed waiting for the service tokens of Metal Connection %s", id), err.Error())
+		return
+	}
+	conn = tokensConn.(*metalv1.Interconnection)
+
+	group := ""
+	for index, token := range sortedServiceTokens(conn) {
+		createConnectionRequest, err := plan.buildFabricConnectionRequest(fabricSide, index, token.GetId(), group)
+		if err != nil {
+			resp.Diagnostics.AddError("Error building Fabric Connection request", err.Error())
+			return
+		}
+		fabricConn, _, err := fabricClient.ConnectionsApi.CreateConnection(ctx).
+			ConnectionPostRequest(createConnectionRequest).
+			Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error redeeming %s service token %s on a Fabric Connection", token.GetRole(), token.GetId()),
+				equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		redundancy := fabricConn.GetRedundancy()
+		group = redundancy.GetGroup()
+
+		connections = append(connections, ConnectionModel{
+			Role:                  types.StringValue(string(token.GetRole())),
+			ServiceTokenID:        types.StringValue(token.GetId()),
+			FabricConnectionID:    types.StringValue(fabricConn.GetUuid()),
+			FabricConnectionState: types.StringValue(string(fabricConn.GetState())),
+		})
+		plan.parse(ctx, conn, connections)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for index := range connections {
+		fabricConnectionID := connections[index].FabricConnectionID.ValueString()
+		createWaiter := fabricconnection.GetCreateWaiter(ctx, fabricClient, fabricConnectionID, createTimeout-time.Since(start))
+		fabricConn, err := r.Meta.WaitForStateContext(ctx, createWaiter)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed waiting for Fabric Connection %s to be provisioned", fabricConnectionID), err.Error())
+			return
+		}
+		connections[index].FabricConnectionState = types.StringValue(string(fabricConn.(*fabricv4.Connection).GetState()))
+	}
+
+	activeWaiter := getActiveWaiter(ctx, metalClient, id, createTimeout-time.Since(start))
+	activeConn, err := r.Meta.WaitForStateContext(ctx, activeWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed waiting for Metal Connection %s to become active", id), err.Error())
+		return
+	}
+
+	plan.parse(ctx, activeConn.(*metalv1.Interconnection), connections)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metalClient := r.Meta.NewMetalClientForFramework(ctx, req.ProviderMeta)
+	fabricClient := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	conn, httpResp, err := metalClient.InterconnectionsApi.GetInterconnection(ctx, id).
+		Include(connectionIncludes).
+		Execute()
+	if err != nil {
+		if httpResp != nil && slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, httpResp.StatusCode) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Metal Connection %s", id), err.Error())
+		return
+	}
+
+	connectionPtrs, diags := state.Connections.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connections := make([]ConnectionModel, 0, len(connectionPtrs))
+	for _, connectionPtr := range connectionPtrs {
+		fabricConnectionID := connectionPtr.FabricConnectionID.ValueString()
+		fabricConn, httpResp, err := fabricClient.ConnectionsApi.GetConnectionByUuid(ctx, fabricConnectionID).Execute()
+		if err != nil {
+			if httpResp != nil && slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, httpResp.StatusCode) {
+				// Deleted outside of Terraform; ModifyPlan replaces the
+				// interconnection
+				connectionPtr.FabricConnectionState = types.StringValue(string(fabricv4.CONNECTIONSTATE_DEPROVISIONED))
+				connections = append(connections, *connectionPtr)
+				continue
+			}
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed retrieving Fabric Connection %s", fabricConnectionID),
+				equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		connectionPtr.FabricConnectionState = types.StringValue(string(fabricConn.GetState()))
+		connections = append(connections, *connectionPtr)
+	}
+
+	state.parse(ctx, conn, connections)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan replaces the interconnection when one of its Fabric Connections
+// has been deprovisioned or deleted outside of Terraform
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connections, diags := state.Connections.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if slices.ContainsFunc(connections, isDeprovisioned) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connections"), fwtypes.NewListNestedObjectValueOfUnknown[ConnectionModel](ctx))...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("connections"))
+	}
+}
+
+func isDeprovisioned(conn *ConnectionModel) bool {
+	return conn.FabricConnectionState.ValueString() == string(fabricv4.CONNECTIONSTATE_DEPROVISIONED)
+}
+
+// Update only persists changes to timeouts; every other argument replaces the
+// interconnection
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	start := time.Now()
+
+	metalClient := r.Meta.NewMetalClientForFramework(ctx, req.ProviderMeta)
+	fabricClient := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	connections, diags := state.Connections.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Fabric Connections have to be gone before the Metal connection
+	// holding their service tokens can be deleted
+	connections = slices.DeleteFunc(connections, isDeprovisioned)
+	for _, conn := range connections {
+		fabricConnectionID := conn.FabricConnectionID.ValueString()
+		_, _, err := fabricClient.ConnectionsApi.DeleteConnectionByUuid(ctx, fabricConnectionID).Execute()
+		if err != nil && !fabricconnection.IsAlreadyDeleted(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed deleting Fabric Connection %s", fabricConnectionID),
+				equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+	}
+	for _, conn := range connections {
+		fabricConnectionID := conn.FabricConnectionID.ValueString()
+		deleteWaiter := fabricconnection.GetDeleteWaiter(ctx, fabricClient, fabricConnectionID, deleteTimeout-time.Since(start))
+		if _, err := r.Meta.WaitForStateContext(ctx, deleteWaiter); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed waiting for Fabric Connection %s to be deprovisioned", fabricConnectionID), err.Error())
+			return
+		}
+	}
+
+	id := state.ID.ValueString()
+	_, deleteResp, err := metalClient.InterconnectionsApi.DeleteInterconnection(ctx, id).Execute()
+	if err != nil {
+		if deleteResp == nil || !slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, deleteResp.StatusCode) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to delete Metal Connection %s", id), err.Error())
+			return
+		}
+	}
+
+	deleteWaiter := connection.GetDeleteWaiter(ctx, metalClient, id, deleteTimeout-time.Since(start))
+	if _, err := r.Meta.WaitForStateContext(ctx, deleteWaiter); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete Metal Connection %s", id), err.Error())
+	}
+}
+
+// getServiceTokensWaiter waits for the Metal connection to expose one
+// service token per virtual circuit
+func getServiceTokensWaiter(ctx context.Context, client *metalv1.APIClient, id string, count int, timeout time.Duration) *retry.StateChangeConf {
+	const (
+		waiting = "waiting"
+		ready   = "ready"
+	)
+	return &retry.StateChangeConf{
+		Pending: []string{waiting},
+		Target:  []string{ready},
+		Refresh: func() (interface{}, string, error) {
+			conn, _, err := client.InterconnectionsApi.GetInterconnection(ctx, id).
+				Include(connectionIncludes).
+				Execute()
+			if err != nil {
+				return nil, "", err
+			}
+			if len(conn.GetServiceTokens()) < count {
+				return conn, waiting, nil
+			}
+			return conn, ready, nil
+		},
+		Timeout: timeout,
+	}
+}
+
+// getActiveWaiter waits for the Metal connection to become active once its
+// service tokens have been redeemed. Any status other than the in-progress
+// ones, e.g. failed, ends the wait with that status
+func getActiveWaiter(ctx context.Context, client *metalv1.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{"requested", "pending", "provisioning"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			conn, _, err := client.InterconnectionsApi.GetInterconnection(ctx, id).
+				Include(connectionIncludes).
+				Execute()
+			if err != nil {
+				return nil, "", err
+			}
+			return conn, conn.GetStatus(), nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+}
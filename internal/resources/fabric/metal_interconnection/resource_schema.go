@@ -0,0 +1,204 @@
+package metalinterconnection
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/metal/connection"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/equinix/equinix-sdk-go/services/metalv1"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource that interconnects Equinix Metal with Equinix Fabric in one step. It creates a shared Metal connection with its VLAN or VRF virtual circuits, redeems the generated service tokens on Fabric Connections to a port, Cloud Router or network, and tears both down in the right order
+
+Additional Documentation:
+* Getting Started: https://deploy.equinix.com/developers/docs/metal/interconnections/introduction/
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#connections`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute("The ID of the Metal connection"),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"project_id": schema.StringAttribute{
+				Description: "ID of the Metal project the connection belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Metal connection; the Fabric Connections are named after it, with a -sec suffix for the secondary connection",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metro": schema.StringAttribute{
+				Description: "Metro where the Metal connection is created",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"speed": schema.StringAttribute{
+				Description: "Connection speed in the format '<number>Mbps' or '<number>Gbps', for example '100Mbps' or '1Gbps'. It is also the bandwidth of the Fabric Connections",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(connection.SpeedFormat, "must be in the format '<number>Mbps' or '<number>Gbps'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"redundancy": schema.StringAttribute{
+				Description: "Connection redundancy - primary or redundant. Redundant interconnections create a secondary Fabric Connection in the same redundancy group",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(metalv1.INTERCONNECTIONREDUNDANCY_PRIMARY)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(metalv1.INTERCONNECTIONREDUNDANCY_PRIMARY),
+						string(metalv1.INTERCONNECTIONREDUNDANCY_REDUNDANT),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Metal connection",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact_email": schema.StringAttribute{
+				Description: "The preferred email used for communication about the Metal connection",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlans": schema.ListAttribute{
+				Description: "Metal VLANs to attach. Pass one VLAN for a primary interconnection and two VLANs for a redundant one. Conflicts with vrfs",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+					listvalidator.ExactlyOneOf(path.MatchRoot("vrfs")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"vrfs": schema.ListAttribute{
+				Description: "Metal VRFs to attach. Pass one VRF for a primary interconnection and two VRFs for a redundant one. Conflicts with vlans",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"fabric": schema.SingleNestedAttribute{
+				Description: "Equinix Fabric side of the interconnection",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[FabricModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of the Fabric access point redeeming the service tokens - COLO, CLOUD_ROUTER or NETWORK",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(fabricv4.ACCESSPOINTTYPE_COLO),
+								string(fabricv4.ACCESSPOINTTYPE_CLOUD_ROUTER),
+								string(fabricv4.ACCESSPOINTTYPE_NETWORK),
+							),
+						},
+					},
+					"id": schema.StringAttribute{
+						Description: "Equinix assigned UUID of the port, Cloud Router or network of the primary Fabric Connection",
+						Required:    true,
+					},
+					"secondary_id": schema.StringAttribute{
+						Description: "Equinix assigned UUID of the port, Cloud Router or network of the secondary Fabric Connection. Defaults to id",
+						Optional:    true,
+					},
+					"vlan_tags": schema.ListAttribute{
+						Description: "DOT1Q VLAN tags on the COLO ports, one per Fabric Connection. Only used with type COLO",
+						Optional:    true,
+						CustomType:  fwtypes.ListOfInt64Type,
+						ElementType: types.Int64Type,
+					},
+					"notification_emails": schema.ListAttribute{
+						Description: "Emails notified about the Fabric Connections",
+						Required:    true,
+						CustomType:  fwtypes.ListOfStringType,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the Metal connection",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "Service tokens of the Metal connection and the Fabric Connections redeeming them, primary first",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ConnectionModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Role of the service token - primary or secondary",
+							Computed:    true,
+						},
+						"service_token_id": schema.StringAttribute{
+							Description: "Equinix assigned UUID of the Metal service token",
+							Computed:    true,
+						},
+						"fabric_connection_id": schema.StringAttribute{
+							Description: "Equinix assigned UUID of the Fabric Connection redeeming the service token",
+							Computed:    true,
+						},
+						"fabric_connection_state": schema.StringAttribute{
+							Description: "State of the Fabric Connection",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
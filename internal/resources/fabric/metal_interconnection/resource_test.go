@@ -0,0 +1,107 @@
+package metalinterconnection_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func CheckMetalInterconnectionDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewMetalClientForTesting()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_metal_interconnection" {
+			continue
+		}
+		if _, _, err := client.InterconnectionsApi.GetInterconnection(ctx, rs.Primary.ID).Execute(); err == nil {
+			return fmt.Errorf("Metal Connection %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccFabricMetalInterconnectionCloudRouter_PFCR(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc-mi")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckMetalInterconnectionDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricMetalInterconnectionCloudRouterConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("equinix_fabric_metal_interconnection.test", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_metal_interconnection.test", "redundancy", "primary"),
+					resource.TestCheckResourceAttr("equinix_fabric_metal_interconnection.test", "status", "active"),
+					resource.TestCheckResourceAttr("equinix_fabric_metal_interconnection.test", "connections.#", "1"),
+					resource.TestCheckResourceAttr("equinix_fabric_metal_interconnection.test", "connections.0.role", "primary"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_metal_interconnection.test", "connections.0.service_token_id"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_metal_interconnection.test", "connections.0.fabric_connection_id"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_metal_interconnection.test", "connections.0.fabric_connection_state"),
+				),
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccFabricMetalInterconnectionCloudRouterConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "equinix_metal_project" "test" {
+		name = "%[1]s"
+	}
+
+	resource "equinix_metal_vlan" "test" {
+		description = "%[1]s"
+		metro       = "sv"
+		project_id  = equinix_metal_project.test.id
+	}
+
+	resource "equinix_fabric_cloud_router" "test" {
+		type = "XF_ROUTER"
+		name = "%[1]s"
+		location {
+			metro_code = "SV"
+		}
+		package {
+			code = "STANDARD"
+		}
+		order {
+			purchase_order_number = "1-234567"
+		}
+		notifications {
+			type   = "ALL"
+			emails = ["test@equinix.com"]
+		}
+		project {
+			project_id = "33ec651f-cc99-48e0-94d3-47466899cdc7"
+		}
+		account {
+			account_number = 201257
+		}
+	}
+
+	resource "equinix_fabric_metal_interconnection" "test" {
+		project_id    = equinix_metal_project.test.id
+		name          = "%[1]s"
+		metro         = "sv"
+		speed         = "50Mbps"
+		contact_email = "tfacc@example.com"
+		vlans         = [equinix_metal_vlan.test.vxlan]
+		fabric = {
+			type                = "CLOUD_ROUTER"
+			id                  = equinix_fabric_cloud_router.test.id
+			notification_emails = ["test@equinix.com"]
+		}
+	}`, name)
+}
@@ -0,0 +1,71 @@
+package metro
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func NewDataSourceMetroLatency() datasource.DataSource {
+	return &DataSourceMetroLatency{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_metro_latency",
+			},
+		),
+	}
+}
+
+type DataSourceMetroLatency struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourceMetroLatency) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceMetroLatencySchema(ctx)
+}
+
+func (r *DataSourceMetroLatency) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data DataSourceMetroLatencyModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var metroCodes, toMetroCodes []string
+	response.Diagnostics.Append(data.MetroCodes.ElementsAs(ctx, &metroCodes, false)...)
+	response.Diagnostics.Append(data.ToMetroCodes.ElementsAs(ctx, &toMetroCodes, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	maxLatency := float32(-1)
+	if !data.MaxLatency.IsNull() {
+		maxLatency = data.MaxLatency.ValueFloat32()
+	}
+
+	var latencies []LatencyModel
+	for _, metroCode := range metroCodes {
+		metro, _, err := client.MetrosApi.GetMetroByCode(ctx, metroCode).Execute()
+		if err != nil {
+			response.State.RemoveResource(ctx)
+			response.Diagnostics.AddError("Get By Metro Code API Error", equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		latencies = append(latencies, metroLatencies(metro, toMetroCodes, maxLatency, data.Closest.ValueInt32())...)
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, metroCodes, latencies)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
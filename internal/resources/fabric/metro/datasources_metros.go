@@ -10,6 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// metrosPageLimit is the page size used when every metro is retrieved for
+// local filtering and sorting
+const metrosPageLimit int32 = 100
+
 func NewDataSourceMetros() datasource.DataSource {
 	return &DataSourceMetros{
 		BaseDataSource: framework.NewBaseDataSource(
@@ -53,20 +57,87 @@ func (r *DataSourceMetros) Read(ctx context.Context, request datasource.ReadRequ
 		limit = 20
 	}
 
-	metroRequest := client.MetrosApi.GetMetros(ctx).
-		Limit(limit).
-		Offset(offset)
-	if presence != "" {
-		metroRequest.Presence(fabricv4.Presence(presence))
+	if allMetrosData.Regions.IsNull() && allMetrosData.ServiceProfileID.IsNull() && allMetrosData.Sort.IsNull() {
+		metroRequest := client.MetrosApi.GetMetros(ctx).
+			Limit(limit).
+			Offset(offset)
+		if presence != "" {
+			metroRequest = metroRequest.Presence(fabricv4.Presence(presence))
+		}
+		metros, _, err := metroRequest.Execute()
+
+		if err != nil {
+			response.State.RemoveResource(ctx)
+			response.Diagnostics.AddError("api error retrieving metros data", equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+
+		response.Diagnostics.Append(allMetrosData.parse(ctx, metros)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(response.State.Set(ctx, &allMetrosData)...)
+		return
+	}
+
+	// Filtering and sorting happen on the full metro list, so every page is
+	// retrieved and the requested pagination is applied afterwards
+	var allMetros []fabricv4.Metro
+	for pageOffset := int32(0); ; {
+		metroRequest := client.MetrosApi.GetMetros(ctx).
+			Limit(metrosPageLimit).
+			Offset(pageOffset)
+		if presence != "" {
+			metroRequest = metroRequest.Presence(fabricv4.Presence(presence))
+		}
+		metros, _, err := metroRequest.Execute()
+		if err != nil {
+			response.State.RemoveResource(ctx)
+			response.Diagnostics.AddError("api error retrieving metros data", equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		allMetros = append(allMetros, metros.GetData()...)
+		pageOffset += int32(len(metros.GetData()))
+		if len(metros.GetData()) == 0 || pageOffset >= metros.Pagination.GetTotal() {
+			break
+		}
 	}
-	metros, _, err := metroRequest.Execute()
 
-	if err != nil {
-		response.State.RemoveResource(ctx)
-		response.Diagnostics.AddError("api error retrieving metros data", equinix_errors.FormatFabricError(err).Error())
+	var regions []string
+	response.Diagnostics.Append(allMetrosData.Regions.ElementsAs(ctx, &regions, false)...)
+	var sorts []SortModel
+	response.Diagnostics.Append(allMetrosData.Sort.ElementsAs(ctx, &sorts, false)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
+	var serviceMetroCodes map[string]bool
+	if serviceProfileID := allMetrosData.ServiceProfileID.ValueString(); serviceProfileID != "" {
+		serviceMetroCodes = make(map[string]bool)
+		for pageOffset := int32(0); ; {
+			serviceMetros, _, err := client.ServiceProfilesApi.GetServiceProfileMetrosByUuid(ctx, serviceProfileID).
+				Limit(metrosPageLimit).
+				Offset(pageOffset).
+				Execute()
+			if err != nil {
+				response.State.RemoveResource(ctx)
+				response.Diagnostics.AddError("api error retrieving service profile metros data", equinix_errors.FormatFabricError(err).Error())
+				return
+			}
+			for _, serviceMetro := range serviceMetros.GetData() {
+				serviceMetroCodes[serviceMetro.GetCode()] = true
+			}
+			pageOffset += int32(len(serviceMetros.GetData()))
+			if len(serviceMetros.GetData()) == 0 || pageOffset >= serviceMetros.Pagination.GetTotal() {
+				break
+			}
+		}
+	}
+
+	filtered := filterMetros(allMetros, regions, serviceMetroCodes)
+	sortMetros(filtered, sorts)
+	metros := pageMetros(filtered, offset, limit)
+
 	response.Diagnostics.Append(allMetrosData.parse(ctx, metros)...)
 	if response.Diagnostics.HasError() {
 		return
@@ -5,7 +5,10 @@ import (
 
 	"github.com/equinix/terraform-provider-equinix/internal/framework"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +22,39 @@ func dataSourceAllMetroSchema(ctx context.Context) schema.Schema {
 				Description: "User On Boarded Metros based on Fabric resource availability",
 				Optional:    true,
 			},
+			"regions": schema.ListAttribute{
+				Description: "Only return metros located in one of these regions, e.g. AMER, APAC, EMEA",
+				Optional:    true,
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+			},
+			"service_profile_id": schema.StringAttribute{
+				Description: "Only return metros in which the service profile with this UUID is available",
+				Optional:    true,
+			},
+			"sort": schema.ListNestedAttribute{
+				Description: "Sorting criteria for the returned metro list, applied in order",
+				Optional:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[SortModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							Description: "The sorting direction. Can be one of: [DESC, ASC], Defaults to ASC",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("DESC", "ASC"),
+							},
+						},
+						"property": schema.StringAttribute{
+							Description: "The property name to use in sorting. Can be one of: [/code, /name, /region], Defaults to /code",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("/code", "/name", "/region"),
+							},
+						},
+					},
+				},
+			},
 			"pagination": schema.SingleNestedAttribute{
 				Description: "Pagination details for the returned metro list",
 				Required:    true,
@@ -67,6 +103,68 @@ func dataSourceAllMetroSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+func dataSourceMetroLatencySchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"metro_codes": schema.ListAttribute{
+				Description: "Codes of the metros to measure latency from",
+				Required:    true,
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"to_metro_codes": schema.ListAttribute{
+				Description: "Only return latency to these metros. Defaults to every connected metro",
+				Optional:    true,
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+			},
+			"max_latency": schema.Float32Attribute{
+				Description: "Only return connected metros with an average latency, in milliseconds, up to this value",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+				},
+			},
+			"closest": schema.Int32Attribute{
+				Description: "Only return this number of lowest-latency connected metros for each metro in metro_codes",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Latency between each metro in metro_codes and its connected metros, ordered by metro_codes and then by lowest latency",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[LatencyModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"metro_code": schema.StringAttribute{
+							Description: "Code of the metro latency is measured from",
+							Computed:    true,
+						},
+						"connected_metro_code": schema.StringAttribute{
+							Description: "Code of the connected metro latency is measured to",
+							Computed:    true,
+						},
+						"avg_latency": schema.Float32Attribute{
+							Description: "Average latency in milliseconds between the two metros",
+							Computed:    true,
+						},
+						"remote_vc_bandwidth_max": schema.Int64Attribute{
+							Description: "Maximum connection speed between the two metros",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func dataSourceSingleMetroSchema(ctx context.Context) schema.Schema {
 	baseMetroSchema := getMetroSchema(ctx)
 	baseMetroSchema["id"] = framework.IDAttributeDefaultDescription()
@@ -63,6 +63,50 @@ func TestAccFabricMetroDataSource_PFCR(t *testing.T) {
 	})
 }
 
+func TestAccFabricMetroLatencyDataSource_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricMetroLatencyDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_metros.amer", "data.#", "5"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_metros.amer", "data.0.region", "AMER"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_metros.amer", "pagination.total"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_metro_latency.closest", "id", "DA"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_metro_latency.closest", "data.#", "3"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_metro_latency.closest", "data.0.metro_code", "DA"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_metro_latency.closest", "data.0.connected_metro_code"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_metro_latency.closest", "data.0.avg_latency"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_metro_latency.closest", "data.0.remote_vc_bandwidth_max"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricMetroLatencyDataSourceConfig() string {
+	return `
+	data "equinix_fabric_metros" "amer" {
+		regions = ["AMER"]
+		sort = [{
+			property  = "/name"
+			direction = "ASC"
+		}]
+		pagination = {
+			limit = 5
+		}
+	}
+
+	data "equinix_fabric_metro_latency" "closest" {
+		metro_codes = ["DA"]
+		closest     = 3
+	}
+	`
+}
+
 func testAccFabricMetroDataSourcesConfig(code string, limit, offset int) string {
 	return fmt.Sprintf(`
 
@@ -1,7 +1,10 @@
 package metro
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/equinix/equinix-sdk-go/services/fabricv4"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
@@ -50,10 +53,34 @@ type DataSourceByCodeModel struct {
 }
 
 type DataSourceAllMetrosModel struct {
-	ID         types.String                           `tfsdk:"id"`
-	Presence   types.String                           `tfsdk:"presence"`
-	Data       fwtypes.ListNestedObjectValueOf[Model] `tfsdk:"data"`
-	Pagination fwtypes.ObjectValueOf[PaginationModel] `tfsdk:"pagination"`
+	ID               types.String                               `tfsdk:"id"`
+	Presence         types.String                               `tfsdk:"presence"`
+	Regions          fwtypes.ListValueOf[types.String]          `tfsdk:"regions"`
+	ServiceProfileID types.String                               `tfsdk:"service_profile_id"`
+	Sort             fwtypes.ListNestedObjectValueOf[SortModel] `tfsdk:"sort"`
+	Data             fwtypes.ListNestedObjectValueOf[Model]     `tfsdk:"data"`
+	Pagination       fwtypes.ObjectValueOf[PaginationModel]     `tfsdk:"pagination"`
+}
+
+type SortModel struct {
+	Direction types.String `tfsdk:"direction"`
+	Property  types.String `tfsdk:"property"`
+}
+
+type DataSourceMetroLatencyModel struct {
+	ID           types.String                                  `tfsdk:"id"`
+	MetroCodes   fwtypes.ListValueOf[types.String]             `tfsdk:"metro_codes"`
+	ToMetroCodes fwtypes.ListValueOf[types.String]             `tfsdk:"to_metro_codes"`
+	MaxLatency   types.Float32                                 `tfsdk:"max_latency"`
+	Closest      types.Int32                                   `tfsdk:"closest"`
+	Data         fwtypes.ListNestedObjectValueOf[LatencyModel] `tfsdk:"data"`
+}
+
+type LatencyModel struct {
+	MetroCode            types.String  `tfsdk:"metro_code"`
+	ConnectedMetroCode   types.String  `tfsdk:"connected_metro_code"`
+	AvgLatency           types.Float32 `tfsdk:"avg_latency"`
+	RemoteVCBandwidthMax types.Int64   `tfsdk:"remote_vc_bandwidth_max"`
 }
 
 func (a *DataSourceAllMetrosModel) parse(ctx context.Context, metroResponse *fabricv4.MetroResponse) diag.Diagnostics {
@@ -186,3 +213,113 @@ func parseConnectedMetros(ctx context.Context, connectedMetros []fabricv4.Connec
 	}
 	return fwtypes.NewListNestedObjectValueOfValueSlice(ctx, connMetros), nil
 }
+
+// filterMetros keeps the metros located in one of the given regions and, when
+// serviceMetroCodes is not nil, the metros the service profile is offered in
+func filterMetros(metros []fabricv4.Metro, regions []string, serviceMetroCodes map[string]bool) []fabricv4.Metro {
+	filtered := make([]fabricv4.Metro, 0, len(metros))
+	for _, metro := range metros {
+		if len(regions) > 0 && !slices.ContainsFunc(regions, func(region string) bool {
+			return strings.EqualFold(region, metro.GetRegion())
+		}) {
+			continue
+		}
+		if serviceMetroCodes != nil && !serviceMetroCodes[metro.GetCode()] {
+			continue
+		}
+		filtered = append(filtered, metro)
+	}
+	return filtered
+}
+
+// sortMetros orders the metros by each sort criteria in turn, ascending by
+// metro code unless told otherwise
+func sortMetros(metros []fabricv4.Metro, sorts []SortModel) {
+	slices.SortStableFunc(metros, func(a, b fabricv4.Metro) int {
+		for _, sort := range sorts {
+			var result int
+			switch sort.Property.ValueString() {
+			case "/name":
+				result = strings.Compare(a.GetName(), b.GetName())
+			case "/region":
+				result = strings.Compare(a.GetRegion(), b.GetRegion())
+			default:
+				result = strings.Compare(a.GetCode(), b.GetCode())
+			}
+			if sort.Direction.ValueString() == "DESC" {
+				result = -result
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+}
+
+// pageMetros applies the offset and limit to a locally filtered metro list
+func pageMetros(metros []fabricv4.Metro, offset, limit int32) *fabricv4.MetroResponse {
+	total := int32(len(metros))
+	start := min(offset, total)
+	end := min(start+limit, total)
+
+	pagination := fabricv4.Pagination{}
+	pagination.SetOffset(offset)
+	pagination.SetLimit(limit)
+	pagination.SetTotal(total)
+
+	response := fabricv4.MetroResponse{}
+	response.SetPagination(pagination)
+	response.SetData(metros[start:end])
+	return &response
+}
+
+// metroLatencies returns the latency from the metro to each of its connected
+// metros, lowest latency first. toMetroCodes restricts the destinations,
+// maxLatency drops slower destinations unless negative, and closest keeps only the
+// given number of destinations when positive
+func metroLatencies(metro *fabricv4.Metro, toMetroCodes []string, maxLatency float32, closest int32) []LatencyModel {
+	var connectedMetros []fabricv4.ConnectedMetro
+	for _, connectedMetro := range metro.GetConnectedMetros() {
+		if connectedMetro.AvgLatency == nil || connectedMetro.GetCode() == metro.GetCode() {
+			continue
+		}
+		if len(toMetroCodes) > 0 && !slices.Contains(toMetroCodes, connectedMetro.GetCode()) {
+			continue
+		}
+		if maxLatency >= 0 && connectedMetro.GetAvgLatency() > maxLatency {
+			continue
+		}
+		connectedMetros = append(connectedMetros, connectedMetro)
+	}
+
+	slices.SortStableFunc(connectedMetros, func(a, b fabricv4.ConnectedMetro) int {
+		if result := cmp.Compare(a.GetAvgLatency(), b.GetAvgLatency()); result != 0 {
+			return result
+		}
+		return strings.Compare(a.GetCode(), b.GetCode())
+	})
+	if closest > 0 && int(closest) < len(connectedMetros) {
+		connectedMetros = connectedMetros[:closest]
+	}
+
+	latencies := make([]LatencyModel, len(connectedMetros))
+	for i, connectedMetro := range connectedMetros {
+		latencies[i] = LatencyModel{
+			MetroCode:            types.StringValue(metro.GetCode()),
+			ConnectedMetroCode:   types.StringValue(connectedMetro.GetCode()),
+			AvgLatency:           types.Float32Value(connectedMetro.GetAvgLatency()),
+			RemoteVCBandwidthMax: types.Int64Value(connectedMetro.GetRemoteVCBandwidthMax()),
+		}
+	}
+	return latencies
+}
+
+func (m *DataSourceMetroLatencyModel) parse(ctx context.Context, metroCodes []string, latencies []LatencyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strings.Join(metroCodes, ","))
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[LatencyModel](ctx, latencies)
+
+	return diags
+}
@@ -0,0 +1,164 @@
+package metro
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testMetro(code, name, region string, latencies map[string]float32) fabricv4.Metro {
+	metro := fabricv4.Metro{}
+	metro.SetCode(code)
+	metro.SetName(name)
+	metro.SetRegion(region)
+	for connectedCode, latency := range latencies {
+		connectedMetro := fabricv4.ConnectedMetro{}
+		connectedMetro.SetCode(connectedCode)
+		connectedMetro.SetAvgLatency(latency)
+		connectedMetro.SetRemoteVCBandwidthMax(10000)
+		metro.ConnectedMetros = append(metro.ConnectedMetros, connectedMetro)
+	}
+	return metro
+}
+
+func metroCodes(metros []fabricv4.Metro) []string {
+	codes := make([]string, len(metros))
+	for i, metro := range metros {
+		codes[i] = metro.GetCode()
+	}
+	return codes
+}
+
+func TestFilterAndSortMetros(t *testing.T) {
+	metros := []fabricv4.Metro{
+		testMetro("SV", "Silicon Valley", "AMER", nil),
+		testMetro("AM", "Amsterdam", "EMEA", nil),
+		testMetro("DA", "Dallas", "AMER", nil),
+		testMetro("SG", "Singapore", "APAC", nil),
+		testMetro("LD", "London", "EMEA", nil),
+	}
+
+	tests := map[string]struct {
+		regions           []string
+		serviceMetroCodes map[string]bool
+		sorts             []SortModel
+		want              []string
+	}{
+		"no filters keeps order": {
+			want: []string{"SV", "AM", "DA", "SG", "LD"},
+		},
+		"region filter is case insensitive": {
+			regions: []string{"amer", "APAC"},
+			want:    []string{"SV", "DA", "SG"},
+		},
+		"service profile metros": {
+			serviceMetroCodes: map[string]bool{"DA": true, "LD": true},
+			want:              []string{"DA", "LD"},
+		},
+		"default sort is ascending code": {
+			sorts: []SortModel{{}},
+			want:  []string{"AM", "DA", "LD", "SG", "SV"},
+		},
+		"sort by region then name descending": {
+			sorts: []SortModel{
+				{Property: types.StringValue("/region")},
+				{Property: types.StringValue("/name"), Direction: types.StringValue("DESC")},
+			},
+			want: []string{"SV", "DA", "SG", "LD", "AM"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filtered := filterMetros(metros, tc.regions, tc.serviceMetroCodes)
+			sortMetros(filtered, tc.sorts)
+			if got := metroCodes(filtered); !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPageMetros(t *testing.T) {
+	metros := []fabricv4.Metro{
+		testMetro("AM", "Amsterdam", "EMEA", nil),
+		testMetro("DA", "Dallas", "AMER", nil),
+		testMetro("LD", "London", "EMEA", nil),
+	}
+
+	tests := map[string]struct {
+		offset, limit int32
+		want          []string
+	}{
+		"first page":          {offset: 0, limit: 2, want: []string{"AM", "DA"}},
+		"last page":           {offset: 2, limit: 2, want: []string{"LD"}},
+		"offset past the end": {offset: 5, limit: 2, want: []string{}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := pageMetros(metros, tc.offset, tc.limit)
+			if got := metroCodes(response.GetData()); !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if total := response.Pagination.GetTotal(); total != 3 {
+				t.Errorf("expected total 3, got %d", total)
+			}
+		})
+	}
+}
+
+func TestMetroLatencies(t *testing.T) {
+	metro := testMetro("DA", "Dallas", "AMER", map[string]float32{
+		"DA": 0.5,
+		"CH": 22.1,
+		"HO": 6.2,
+		"AT": 19.3,
+		"SV": 39.8,
+	})
+
+	tests := map[string]struct {
+		toMetroCodes []string
+		maxLatency   float32
+		closest      int32
+		want         []string
+	}{
+		"every connected metro by latency": {
+			maxLatency: -1,
+			want:       []string{"HO", "AT", "CH", "SV"},
+		},
+		"closest": {
+			maxLatency: -1,
+			closest:    2,
+			want:       []string{"HO", "AT"},
+		},
+		"max latency": {
+			maxLatency: 20,
+			want:       []string{"HO", "AT"},
+		},
+		"destinations": {
+			toMetroCodes: []string{"SV", "CH", "NY"},
+			maxLatency:   -1,
+			closest:      1,
+			want:         []string{"CH"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			latencies := metroLatencies(&metro, tc.toMetroCodes, tc.maxLatency, tc.closest)
+			got := make([]string, len(latencies))
+			for i, latency := range latencies {
+				if latency.MetroCode.ValueString() != "DA" {
+					t.Errorf("expected metro_code DA, got %s", latency.MetroCode.ValueString())
+				}
+				got[i] = latency.ConnectedMetroCode.ValueString()
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
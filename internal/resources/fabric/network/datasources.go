@@ -2,7 +2,12 @@ package network
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/equinix/terraform-provider-equinix/internal/config"
@@ -34,6 +39,211 @@ func dataSourceFabricNetworkRead(ctx context.Context, d *schema.ResourceData, me
 	return resourceFabricNetworkRead(ctx, d, meta)
 }
 
+func DataSourceConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricNetworkConnectionsRead,
+		Schema:      readFabricNetworkConnectionsSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the Connections attached to a Fabric Network for a given UUID
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/Fabric/IMPLEMENTATION/fabric-networks-implement.htm
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#fabric-networks`,
+	}
+}
+
+func dataSourceFabricNetworkConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	networkId := d.Get("network_id").(string)
+	connections, err := getNetworkConnections(ctx, client, networkId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(networkId)
+	return setNetworkConnectionsData(d, connections)
+}
+
+// getNetworkConnections returns every page of the connections attached to a
+// Fabric Network, with the pagination describing all of them
+func getNetworkConnections(ctx context.Context, client *fabricv4.APIClient, networkId string) (*fabricv4.NetworkConnections, error) {
+	connections, _, err := client.NetworksApi.GetConnectionsByNetworkUuid(ctx, networkId).Execute()
+	if err != nil {
+		return nil, equinix_errors.FormatFabricError(err)
+	}
+	pagination := connections.GetPagination()
+	for {
+		page := &fabricv4.NetworkConnections{}
+		found, err := getNextPage(ctx, client, "NetworksApiService.GetConnectionsByNetworkUuid",
+			fmt.Sprintf("/fabric/v4/networks/%s/connections", url.PathEscape(networkId)), pagination, len(connections.Data), page)
+		if err != nil {
+			return nil, err
+		}
+		if !found || len(page.Data) == 0 {
+			break
+		}
+		connections.Data = append(connections.Data, page.Data...)
+		pagination = page.GetPagination()
+	}
+	connections.SetPagination(collectedPagination(pagination, len(connections.Data)))
+	return connections, nil
+}
+
+func setNetworkConnectionsData(d *schema.ResourceData, connections *fabricv4.NetworkConnections) diag.Diagnostics {
+	mappedConnections := make([]map[string]interface{}, len(connections.Data))
+	for index, connection := range connections.Data {
+		mappedConnections[index] = networkConnectionMap(&connection)
+	}
+	pagination := connections.GetPagination()
+	err := equinix_schema.SetMap(d, map[string]interface{}{
+		"data":       mappedConnections,
+		"pagination": paginationGoToTerraform(&pagination),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// getNextPage requests the page following the given pagination into page,
+// as the SDK cannot request anything but the first page of some lists. The
+// pagination next reference is followed when the API sets it, otherwise the
+// offset and limit query parameters are used. It returns false once all of
+// the total results have been collected
+func getNextPage(ctx context.Context, client *fabricv4.APIClient, operation, path string, pagination fabricv4.Pagination, collected int, page interface{}) (bool, error) {
+	if collected >= int(pagination.GetTotal()) {
+		return false, nil
+	}
+	configuration := client.GetConfig()
+	serverURL, err := configuration.ServerURLWithContext(ctx, operation)
+	if err != nil {
+		return false, err
+	}
+	pageURL, err := url.Parse(serverURL + path)
+	if err != nil {
+		return false, err
+	}
+	if next := pagination.GetNext(); next != "" {
+		nextURL, err := url.Parse(next)
+		if err != nil {
+			return false, fmt.Errorf("invalid next page reference %q: %w", next, err)
+		}
+		pageURL = pageURL.ResolveReference(nextURL)
+	} else {
+		query := pageURL.Query()
+		query.Set("offset", strconv.Itoa(collected))
+		if limit := pagination.GetLimit(); limit > 0 {
+			query.Set("limit", strconv.Itoa(int(limit)))
+		}
+		pageURL.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", configuration.UserAgent)
+	for header, value := range configuration.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+	httpClient := configuration.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode >= 300 {
+		return false, fmt.Errorf("failed requesting %s: %s %s", pageURL.RequestURI(), resp.Status, body)
+	}
+	if err := json.Unmarshal(body, page); err != nil {
+		return false, fmt.Errorf("failed decoding %s: %w", pageURL.RequestURI(), err)
+	}
+	return true, nil
+}
+
+// collectedPagination describes all of the collected results as a single page
+func collectedPagination(pagination fabricv4.Pagination, collected int) fabricv4.Pagination {
+	collectedPagination := fabricv4.Pagination{}
+	collectedPagination.SetOffset(0)
+	collectedPagination.SetLimit(int32(collected))
+	collectedPagination.SetTotal(pagination.GetTotal())
+	return collectedPagination
+}
+
+func DataSourceChanges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFabricNetworkChangesRead,
+		Schema:      readFabricNetworkChangesSchema(),
+		Description: `Fabric V4 API compatible data resource that allow user to fetch the Changes applied to a Fabric Network for a given UUID
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/Fabric/IMPLEMENTATION/fabric-networks-implement.htm
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#fabric-networks`,
+	}
+}
+
+func dataSourceFabricNetworkChangesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
+	networkId := d.Get("network_id").(string)
+	changes, err := getNetworkChanges(ctx, client, networkId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(networkId)
+	return setNetworkChangesData(d, changes)
+}
+
+// getNetworkChanges returns every page of the changes applied to a Fabric
+// Network, with the pagination describing all of them
+func getNetworkChanges(ctx context.Context, client *fabricv4.APIClient, networkId string) (*fabricv4.NetworkChangeResponse, error) {
+	changes, _, err := client.NetworksApi.GetNetworkChanges(ctx, networkId).Execute()
+	if err != nil {
+		return nil, equinix_errors.FormatFabricError(err)
+	}
+	pagination := changes.GetPagination()
+	for {
+		page := &fabricv4.NetworkChangeResponse{}
+		found, err := getNextPage(ctx, client, "NetworksApiService.GetNetworkChanges",
+			fmt.Sprintf("/fabric/v4/networks/%s/changes", url.PathEscape(networkId)), pagination, len(changes.Data), page)
+		if err != nil {
+			return nil, err
+		}
+		if !found || len(page.Data) == 0 {
+			break
+		}
+		changes.Data = append(changes.Data, page.Data...)
+		pagination = page.GetPagination()
+	}
+	changes.SetPagination(collectedPagination(pagination, len(changes.Data)))
+	return changes, nil
+}
+
+func setNetworkChangesData(d *schema.ResourceData, changes *fabricv4.NetworkChangeResponse) diag.Diagnostics {
+	mappedChanges := make([]map[string]interface{}, len(changes.Data))
+	for index, change := range changes.Data {
+		mappedChange, err := networkChangeMap(&change)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mappedChanges[index] = mappedChange
+	}
+	pagination := changes.GetPagination()
+	err := equinix_schema.SetMap(d, map[string]interface{}{
+		"data":       mappedChanges,
+		"pagination": paginationGoToTerraform(&pagination),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
 func DataSourceSearch() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceFabricNetworkSearch,
@@ -132,3 +132,261 @@ func readFabricNetworkSearchSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+func readFabricNetworkConnectionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_id": networkIdSchema(),
+		"pagination": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Pagination details for the returned list of Connections",
+			Elem:        paginationSchema(),
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of Connections attached to the given Fabric Network",
+			Elem: &schema.Resource{
+				Schema: networkConnectionSchema(),
+			},
+		},
+	}
+}
+
+func readFabricNetworkChangesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_id": networkIdSchema(),
+		"pagination": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Pagination details for the returned list of Changes",
+			Elem:        paginationSchema(),
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of Changes applied to the given Fabric Network",
+			Elem: &schema.Resource{
+				Schema: networkChangeSchema(),
+			},
+		},
+	}
+}
+
+func networkIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Equinix-assigned Fabric Network identifier",
+	}
+}
+
+func networkConnectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Equinix-assigned connection identifier",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Connection URI",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Customer-provided connection name",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Defines the connection type like EVPLAN_VC, EPLAN_VC, IPWAN_VC, etc.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Connection overall state",
+		},
+		"equinix_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Connection status from the Equinix side",
+		},
+		"provider_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Connection status from the provider side",
+		},
+		"bandwidth": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Connection bandwidth in Mbps",
+		},
+		"a_side": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "A-side access point of the connection",
+			Elem:        networkConnectionAccessPointSchema(),
+		},
+		"z_side": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: "Z-side access point of the connection",
+			Elem:        networkConnectionAccessPointSchema(),
+		},
+	}
+}
+
+func networkConnectionAccessPointSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access point type",
+			},
+			"port_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Equinix-assigned Port identifier, for COLO access points",
+			},
+			"router_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Equinix-assigned Cloud Router identifier, for CLOUD_ROUTER access points",
+			},
+			"virtual_device_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Equinix-assigned Virtual Device identifier, for VD access points",
+			},
+			"network_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Equinix-assigned Network identifier, for NETWORK access points",
+			},
+			"profile_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Equinix-assigned Service Profile identifier, for SP access points",
+			},
+			"metro_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access point metro code",
+			},
+			"link_protocol_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the link protocol. One of [UNTAGGED, DOT1Q, QINQ, EVPN_VXLAN, VXLAN]",
+			},
+			"vlan_tag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Vlan Tag information, vlanTag value specified for DOT1Q connections",
+			},
+			"vlan_s_tag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Vlan Provider Tag information, vlanSTag value specified for QINQ connections",
+			},
+			"vlan_c_tag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Vlan Customer Tag information, vlanCTag value specified for QINQ connections",
+			},
+		},
+	}
+}
+
+func networkChangeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Uniquely identifies a change",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Network Change URI",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Type of change. One of %v", fabricv4.AllowedNetworkChangeTypeEnumValues),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Current outcome of the change flow. One of %v", fabricv4.AllowedNetworkChangeStatusEnumValues),
+		},
+		"created_date_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Set when change flow starts",
+		},
+		"updated_date_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Set when change object is updated",
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Operations applied to the Network by the change",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"op": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Network change operation. One of [add, replace, remove]",
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Path inside document leading to updated parameter",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "JSON encoded new value for the updated parameter",
+					},
+				},
+			},
+		},
+	}
+}
+
+func paginationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"offset": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The page offset for the pagination request. Index of the first element. Default is 0.",
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of elements to be requested per page. Number must be between 1 and 100. Default is 20",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of elements returned.",
+			},
+			"next": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL relative to the last item in the response.",
+			},
+			"previous": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL relative to the first item in the response.",
+			},
+		},
+	}
+}
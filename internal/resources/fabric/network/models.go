@@ -1,9 +1,11 @@
 package network
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
 	equinix_fabric_schema "github.com/equinix/terraform-provider-equinix/internal/fabric/schema"
 	equinix_schema "github.com/equinix/terraform-provider-equinix/internal/schema"
 
@@ -90,3 +92,117 @@ func getFabricNetworkUpdateRequest(network *fabricv4.Network, d *schema.Resource
 	}
 	return changeOps, nil
 }
+
+func networkConnectionMap(connection *fabricv4.Connection) map[string]interface{} {
+	operation := connection.GetOperation()
+	aSide := connection.GetASide()
+	zSide := connection.GetZSide()
+	return map[string]interface{}{
+		"uuid":            connection.GetUuid(),
+		"href":            connection.GetHref(),
+		"name":            connection.GetName(),
+		"type":            string(connection.GetType()),
+		"state":           string(connection.GetState()),
+		"equinix_status":  string(operation.GetEquinixStatus()),
+		"provider_status": string(operation.GetProviderStatus()),
+		"bandwidth":       int(connection.GetBandwidth()),
+		"a_side":          networkConnectionAccessPointGoToTerraform(aSide.AccessPoint),
+		"z_side":          networkConnectionAccessPointGoToTerraform(zSide.AccessPoint),
+	}
+}
+
+func networkConnectionAccessPointGoToTerraform(accessPoint *fabricv4.AccessPoint) *schema.Set {
+	if accessPoint == nil {
+		return nil
+	}
+	port := accessPoint.GetPort()
+	router := accessPoint.GetRouter()
+	virtualDevice := accessPoint.GetVirtualDevice()
+	network := accessPoint.GetNetwork()
+	profile := accessPoint.GetProfile()
+	location := accessPoint.GetLocation()
+	linkProtocol := accessPoint.GetLinkProtocol()
+	mappedAccessPoint := map[string]interface{}{
+		"type":                string(accessPoint.GetType()),
+		"port_uuid":           port.GetUuid(),
+		"router_uuid":         router.GetUuid(),
+		"virtual_device_uuid": virtualDevice.GetUuid(),
+		"network_uuid":        network.GetUuid(),
+		"profile_uuid":        profile.GetUuid(),
+		"metro_code":          location.GetMetroCode(),
+		"link_protocol_type":  string(linkProtocol.GetType()),
+		"vlan_tag":            int(linkProtocol.GetVlanTag()),
+		"vlan_s_tag":          int(linkProtocol.GetVlanSTag()),
+		"vlan_c_tag":          int(linkProtocol.GetVlanCTag()),
+	}
+	return schema.NewSet(
+		schema.HashResource(networkConnectionAccessPointSchema()),
+		[]interface{}{mappedAccessPoint},
+	)
+}
+
+func networkChangeMap(change *fabricv4.NetworkChange) (map[string]interface{}, error) {
+	operations := make([]map[string]interface{}, len(change.Data))
+	for index, operation := range change.Data {
+		value, err := networkChangeOperationValue(operation.Value)
+		if err != nil {
+			return nil, err
+		}
+		operations[index] = map[string]interface{}{
+			"op":    string(operation.GetOp()),
+			"path":  operation.GetPath(),
+			"value": value,
+		}
+	}
+	mappedChange := map[string]interface{}{
+		"uuid":              change.GetUuid(),
+		"href":              change.GetHref(),
+		"type":              string(change.GetType()),
+		"status":            string(change.GetStatus()),
+		"created_date_time": "",
+		"updated_date_time": "",
+		"data":              operations,
+	}
+	if createdDateTime, ok := change.GetCreatedDateTimeOk(); ok {
+		mappedChange["created_date_time"] = createdDateTime.Format(fabric.TimeFormat)
+	}
+	if updatedDateTime, ok := change.GetUpdatedDateTimeOk(); ok {
+		mappedChange["updated_date_time"] = updatedDateTime.Format(fabric.TimeFormat)
+	}
+	return mappedChange, nil
+}
+
+// networkChangeOperationValue flattens the free-form value of a change
+// operation into a string; plain strings are kept as is, anything else is
+// JSON encoded.
+func networkChangeOperationValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("error encoding network change operation value: %w", err)
+		}
+		return string(encoded), nil
+	}
+}
+
+func paginationGoToTerraform(pagination *fabricv4.Pagination) *schema.Set {
+	if pagination == nil {
+		return nil
+	}
+	mappedPagination := make(map[string]interface{})
+	mappedPagination["offset"] = int(pagination.GetOffset())
+	mappedPagination["limit"] = int(pagination.GetLimit())
+	mappedPagination["total"] = int(pagination.GetTotal())
+	mappedPagination["next"] = pagination.GetNext()
+	mappedPagination["previous"] = pagination.GetPrevious()
+
+	return schema.NewSet(
+		schema.HashResource(paginationSchema()),
+		[]interface{}{mappedPagination},
+	)
+}
@@ -0,0 +1,79 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func TestNetworkChangeOperationValue(t *testing.T) {
+	tests := map[string]struct {
+		value interface{}
+		want  string
+	}{
+		"no value":     {value: nil, want: ""},
+		"string value": {value: "new-name", want: "new-name"},
+		"object value": {value: map[string]interface{}{"type": "ALL"}, want: `{"type":"ALL"}`},
+		"list value":   {value: []interface{}{"a@equinix.com"}, want: `["a@equinix.com"]`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := networkChangeOperationValue(tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetNetworkChanges(t *testing.T) {
+	var requests []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			_, _ = w.Write([]byte(`{"pagination": {"offset": 0, "limit": 2, "total": 5, "next": "/fabric/v4/networks/network/changes?offset=2&limit=2"}, "data": [{"uuid": "change-1"}, {"uuid": "change-2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"pagination": {"offset": 2, "limit": 2, "total": 5}, "data": [{"uuid": "change-3"}, {"uuid": "change-4"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"pagination": {"offset": 4, "limit": 2, "total": 5}, "data": [{"uuid": "change-5"}]}`))
+		}
+	}))
+	defer mockAPI.Close()
+
+	configuration := fabricv4.NewConfiguration()
+	configuration.Servers = fabricv4.ServerConfigurations{{URL: mockAPI.URL}}
+	client := fabricv4.NewAPIClient(configuration)
+
+	changes, err := getNetworkChanges(context.Background(), client, "network")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantRequests := []string{
+		"/fabric/v4/networks/network/changes",
+		"/fabric/v4/networks/network/changes?offset=2&limit=2",
+		"/fabric/v4/networks/network/changes?limit=2&offset=4",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("expected requests %v, got %v", wantRequests, requests)
+	}
+	var uuids []string
+	for _, change := range changes.Data {
+		uuids = append(uuids, change.GetUuid())
+	}
+	if want := []string{"change-1", "change-2", "change-3", "change-4", "change-5"}; !reflect.DeepEqual(uuids, want) {
+		t.Errorf("expected changes %v, got %v", want, uuids)
+	}
+	if pagination := changes.GetPagination(); pagination.GetLimit() != 5 || pagination.GetTotal() != 5 || pagination.GetNext() != "" {
+		t.Errorf("expected the pagination to cover all changes, got %+v", pagination)
+	}
+}
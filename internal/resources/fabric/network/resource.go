@@ -131,12 +131,11 @@ func waitForFabricNetworkUpdateCompletion(uuid string, meta interface{}, d *sche
 			}
 			return dbConn, string(*dbConn.Operation.EquinixStatus), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbConn *fabricv4.Network
 
 	if err == nil {
@@ -162,12 +161,11 @@ func waitUntilFabricNetworkIsProvisioned(uuid string, meta interface{}, d *schem
 			}
 			return dbConn, string(*dbConn.Operation.EquinixStatus), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var dbConn *fabricv4.Network
 
 	if err == nil {
@@ -218,11 +216,10 @@ func WaitUntilFabricNetworkDeprovisioned(uuid string, meta interface{}, d *schem
 			}
 			return dbConn, string(*dbConn.Operation.EquinixStatus), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	return err
 }
@@ -0,0 +1,88 @@
+package portpair
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func NewDataSourcePortPairs() datasource.DataSource {
+	return &DataSourcePortPairs{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_port_pairs",
+			},
+		),
+	}
+}
+
+type DataSourcePortPairs struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourcePortPairs) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePortPairsSchema(ctx)
+}
+
+func (r *DataSourcePortPairs) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data DataSourcePortPairsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := data.vlanTags(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ports, _, err := client.PortsApi.GetPorts(ctx).Execute()
+	if err != nil {
+		response.State.RemoveResource(ctx)
+		response.Diagnostics.AddError("api error retrieving ports data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	pairs := selectPortPairs(ports.GetData(), data.criteria())
+	if len(tags) > 0 {
+		// Ports are shared between pairs, so look up their VLANs only once
+		available := map[string]bool{}
+		var free []portPair
+		for _, pair := range pairs {
+			pairAvailable := true
+			for _, uuid := range []string{pair.primary.GetUuid(), pair.secondary.GetUuid()} {
+				if _, ok := available[uuid]; !ok {
+					vlans, _, err := client.PortsApi.GetVlans(ctx, uuid).Execute()
+					if err != nil {
+						response.State.RemoveResource(ctx)
+						response.Diagnostics.AddError("api error retrieving port vlans data", equinix_errors.FormatFabricError(err).Error())
+						return
+					}
+					available[uuid] = vlanTagsAvailable(vlans.GetData(), tags)
+				}
+				pairAvailable = pairAvailable && available[uuid]
+			}
+			if pairAvailable {
+				free = append(free, pair)
+			}
+		}
+		pairs = free
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, pairs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
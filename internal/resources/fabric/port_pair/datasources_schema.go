@@ -0,0 +1,100 @@
+package portpair
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func dataSourcePortPairsSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data source that allows user to select primary and secondary Equinix Fabric Port pairs for redundant designs
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/Fabric/IMPLEMENTATION/fabric-ports-implement.htm
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#ports`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"metro_code": schema.StringAttribute{
+				Description: "Metro code the ports have to be located in",
+				Required:    true,
+			},
+			"encapsulation_type": schema.StringAttribute{
+				Description: "Encapsulation of the ports. One of DOT1Q, QINQ",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q),
+						string(fabricv4.PORTENCAPSULATIONTYPE_QINQ),
+					),
+				},
+			},
+			"bandwidth": schema.Int64Attribute{
+				Description: "Bandwidth in Mbps that has to be available on both ports of a pair",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"vlan_tags": schema.ListAttribute{
+				Description: "VLAN tags that have to be free on both ports of a pair; outer (S) tags for QINQ ports",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(2, 4094)),
+				},
+			},
+			"pairs": schema.ListNestedAttribute{
+				Description: "Matching port pairs, ordered by the bandwidth available on the busier port of each pair",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[PortPairModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.StringAttribute{
+							Description: "Redundancy group shared by the ports",
+							Computed:    true,
+						},
+						"primary":   portSchema(ctx, "Primary port of the pair"),
+						"secondary": portSchema(ctx, "Secondary port of the pair"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func portSchema(ctx context.Context, description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[PortModel](ctx),
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Description: "Equinix-assigned port identifier",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Port name",
+				Computed:    true,
+			},
+			"ibx": schema.StringAttribute{
+				Description: "IBX the port is located in",
+				Computed:    true,
+			},
+			"available_bandwidth": schema.Int64Attribute{
+				Description: "Port available bandwidth in Mbps",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package portpair_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricPortPairsDataSource_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricPortPairsDataSourceConfig("SV", "DOT1Q"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_pairs.test", "id", "SV-DOT1Q"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_pairs.test", "pairs.0.group"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_pairs.test", "pairs.0.primary.uuid"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_pairs.test", "pairs.0.secondary.uuid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricPortPairsDataSourceConfig(metroCode, encapsulationType string) string {
+	return fmt.Sprintf(`
+	data "equinix_fabric_port_pairs" "test" {
+		metro_code         = "%s"
+		encapsulation_type = "%s"
+		bandwidth          = 50
+		vlan_tags          = [3999]
+	}
+	`, metroCode, encapsulationType)
+}
@@ -0,0 +1,200 @@
+package portpair
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourcePortPairsModel struct {
+	ID                types.String                                   `tfsdk:"id"`
+	MetroCode         types.String                                   `tfsdk:"metro_code"`
+	EncapsulationType types.String                                   `tfsdk:"encapsulation_type"`
+	Bandwidth         types.Int64                                    `tfsdk:"bandwidth"`
+	VlanTags          types.List                                     `tfsdk:"vlan_tags"`
+	Pairs             fwtypes.ListNestedObjectValueOf[PortPairModel] `tfsdk:"pairs"`
+}
+
+type PortPairModel struct {
+	Group     types.String                     `tfsdk:"group"`
+	Primary   fwtypes.ObjectValueOf[PortModel] `tfsdk:"primary"`
+	Secondary fwtypes.ObjectValueOf[PortModel] `tfsdk:"secondary"`
+}
+
+type PortModel struct {
+	UUID               types.String `tfsdk:"uuid"`
+	Name               types.String `tfsdk:"name"`
+	IBX                types.String `tfsdk:"ibx"`
+	AvailableBandwidth types.Int64  `tfsdk:"available_bandwidth"`
+}
+
+// portPair is a primary and secondary port of the same redundancy group
+type portPair struct {
+	group     string
+	primary   fabricv4.Port
+	secondary fabricv4.Port
+}
+
+// portCriteria are the requirements a port has to meet to be part of a pair
+type portCriteria struct {
+	metroCode         string
+	encapsulationType fabricv4.PortEncapsulationType
+	bandwidth         int64
+}
+
+func (m *DataSourcePortPairsModel) criteria() portCriteria {
+	return portCriteria{
+		metroCode:         m.MetroCode.ValueString(),
+		encapsulationType: fabricv4.PortEncapsulationType(m.EncapsulationType.ValueString()),
+		bandwidth:         m.Bandwidth.ValueInt64(),
+	}
+}
+
+func (m *DataSourcePortPairsModel) vlanTags(ctx context.Context) ([]int64, diag.Diagnostics) {
+	var tags []int64
+	if m.VlanTags.IsNull() || m.VlanTags.IsUnknown() {
+		return tags, nil
+	}
+	diags := m.VlanTags.ElementsAs(ctx, &tags, false)
+	return tags, diags
+}
+
+func (m *DataSourcePortPairsModel) parse(ctx context.Context, pairs []portPair) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pairModels := make([]PortPairModel, len(pairs))
+	for index, pair := range pairs {
+		pairModels[index] = PortPairModel{
+			Group:     types.StringValue(pair.group),
+			Primary:   parsePort(ctx, pair.primary),
+			Secondary: parsePort(ctx, pair.secondary),
+		}
+	}
+
+	m.ID = types.StringValue(strings.Join([]string{
+		m.MetroCode.ValueString(),
+		m.EncapsulationType.ValueString(),
+	}, "-"))
+	m.Pairs = fwtypes.NewListNestedObjectValueOfValueSlice[PortPairModel](ctx, pairModels)
+	return diags
+}
+
+func parsePort(ctx context.Context, port fabricv4.Port) fwtypes.ObjectValueOf[PortModel] {
+	location := port.GetLocation()
+	return fwtypes.NewObjectValueOf[PortModel](ctx, &PortModel{
+		UUID:               types.StringValue(port.GetUuid()),
+		Name:               types.StringValue(port.GetName()),
+		IBX:                types.StringValue(location.GetIbx()),
+		AvailableBandwidth: types.Int64Value(int64(port.GetAvailableBandwidth())),
+	})
+}
+
+// portRedundancy returns the redundancy group and priority of the port,
+// falling back to the redundancy of the port device
+func portRedundancy(port fabricv4.Port) (string, string) {
+	redundancy := port.GetRedundancy()
+	group, priority := redundancy.GetGroup(), string(redundancy.GetPriority())
+	if group == "" || priority == "" {
+		device := port.GetDevice()
+		deviceRedundancy := device.GetRedundancy()
+		if group == "" {
+			group = deviceRedundancy.GetGroup()
+		}
+		if priority == "" {
+			priority = string(deviceRedundancy.GetPriority())
+		}
+	}
+	return group, strings.ToUpper(priority)
+}
+
+func (c portCriteria) matches(port fabricv4.Port) bool {
+	location := port.GetLocation()
+	encapsulation := port.GetEncapsulation()
+	return port.GetState() == fabricv4.PORTSTATE_ACTIVE &&
+		strings.EqualFold(location.GetMetroCode(), c.metroCode) &&
+		encapsulation.GetType() == c.encapsulationType &&
+		int64(port.GetAvailableBandwidth()) >= c.bandwidth
+}
+
+// selectPortPairs pairs the primary and secondary ports of each redundancy
+// group that meet the criteria. Pairs with the most spare bandwidth on their
+// busiest port come first
+func selectPortPairs(ports []fabricv4.Port, criteria portCriteria) []portPair {
+	primaries := map[string][]fabricv4.Port{}
+	secondaries := map[string][]fabricv4.Port{}
+	for _, port := range ports {
+		if !criteria.matches(port) {
+			continue
+		}
+		group, priority := portRedundancy(port)
+		if group == "" {
+			continue
+		}
+		switch priority {
+		case string(fabricv4.PORTPRIORITY_PRIMARY):
+			primaries[group] = append(primaries[group], port)
+		case string(fabricv4.PORTPRIORITY_SECONDARY):
+			secondaries[group] = append(secondaries[group], port)
+		}
+	}
+
+	var pairs []portPair
+	for group, groupPrimaries := range primaries {
+		for _, primary := range groupPrimaries {
+			for _, secondary := range secondaries[group] {
+				pairs = append(pairs, portPair{group: group, primary: primary, secondary: secondary})
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		left, right := pairs[i].spareBandwidth(), pairs[j].spareBandwidth()
+		if left != right {
+			return left > right
+		}
+		if pairs[i].group != pairs[j].group {
+			return pairs[i].group < pairs[j].group
+		}
+		return pairs[i].primary.GetUuid()+pairs[i].secondary.GetUuid() < pairs[j].primary.GetUuid()+pairs[j].secondary.GetUuid()
+	})
+	return pairs
+}
+
+func (p portPair) spareBandwidth() int32 {
+	return min(p.primary.GetAvailableBandwidth(), p.secondary.GetAvailableBandwidth())
+}
+
+// vlanTagsAvailable reports whether none of the tags is used by the VLANs
+// already provisioned on a port. For QINQ ports the tags are outer (S) tags
+func vlanTagsAvailable(vlans []fabricv4.LinkProtocolResponse, tags []int64) bool {
+	for _, vlan := range vlans {
+		if vlan.GetState() == fabricv4.LINKPROTOCOLSTATE_RELEASED {
+			continue
+		}
+		for _, tag := range tags {
+			if vlanUsesTag(vlan, tag) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func vlanUsesTag(vlan fabricv4.LinkProtocolResponse, tag int64) bool {
+	switch {
+	case vlan.HasVlanSTag():
+		return int64(vlan.GetVlanSTag()) == tag
+	case vlan.HasVlanTag():
+		return int64(vlan.GetVlanTag()) == tag
+	case vlan.HasVlanTagMin() && vlan.HasVlanTagMax():
+		return int64(vlan.GetVlanTagMin()) <= tag && tag <= int64(vlan.GetVlanTagMax())
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package portpair
+
+import (
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func testPort(uuid, metroCode, group string, priority fabricv4.PortPriority, encapsulationType fabricv4.PortEncapsulationType, availableBandwidth int32) fabricv4.Port {
+	port := fabricv4.Port{}
+	port.SetUuid(uuid)
+	port.SetState(fabricv4.PORTSTATE_ACTIVE)
+	port.SetAvailableBandwidth(availableBandwidth)
+	port.SetLocation(fabricv4.SimplifiedLocation{MetroCode: &metroCode})
+	port.SetEncapsulation(fabricv4.PortEncapsulation{Type: &encapsulationType})
+	port.SetRedundancy(fabricv4.PortRedundancy{Group: &group, Priority: &priority})
+	return port
+}
+
+func TestSelectPortPairs(t *testing.T) {
+	inactive := testPort("inactive-secondary", "SV", "3", fabricv4.PORTPRIORITY_SECONDARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 10000)
+	inactive.SetState(fabricv4.PORTSTATE_INACTIVE)
+	ports := []fabricv4.Port{
+		testPort("primary-1", "SV", "1", fabricv4.PORTPRIORITY_PRIMARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 2000),
+		testPort("secondary-1", "SV", "1", fabricv4.PORTPRIORITY_SECONDARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 5000),
+		testPort("primary-2", "sv", "2", fabricv4.PORTPRIORITY_PRIMARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 8000),
+		testPort("secondary-2", "SV", "2", fabricv4.PORTPRIORITY_SECONDARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 9000),
+		testPort("primary-3", "SV", "3", fabricv4.PORTPRIORITY_PRIMARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 10000),
+		inactive,
+		testPort("primary-4", "SV", "4", fabricv4.PORTPRIORITY_PRIMARY, fabricv4.PORTENCAPSULATIONTYPE_QINQ, 10000),
+		testPort("secondary-4", "SV", "4", fabricv4.PORTPRIORITY_SECONDARY, fabricv4.PORTENCAPSULATIONTYPE_QINQ, 10000),
+		testPort("primary-5", "DC", "5", fabricv4.PORTPRIORITY_PRIMARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 10000),
+		testPort("secondary-5", "DC", "5", fabricv4.PORTPRIORITY_SECONDARY, fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q, 10000),
+	}
+
+	pairs := selectPortPairs(ports, portCriteria{
+		metroCode:         "SV",
+		encapsulationType: fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q,
+		bandwidth:         1000,
+	})
+	if len(pairs) != 2 {
+		t.Fatalf("expected 2 pairs, got %d", len(pairs))
+	}
+	if pairs[0].group != "2" || pairs[0].primary.GetUuid() != "primary-2" || pairs[0].secondary.GetUuid() != "secondary-2" {
+		t.Errorf("expected the pair with the most spare bandwidth first, got %+v", pairs[0])
+	}
+	if pairs[1].group != "1" {
+		t.Errorf("expected group 1 second, got %s", pairs[1].group)
+	}
+
+	pairs = selectPortPairs(ports, portCriteria{
+		metroCode:         "SV",
+		encapsulationType: fabricv4.PORTENCAPSULATIONTYPE_DOT1_Q,
+		bandwidth:         3000,
+	})
+	if len(pairs) != 1 || pairs[0].group != "2" {
+		t.Errorf("expected only group 2 to have 3000 Mbps available on both ports, got %+v", pairs)
+	}
+}
+
+func TestVlanTagsAvailable(t *testing.T) {
+	tag := func(value int32) *int32 { return &value }
+	released := fabricv4.LINKPROTOCOLSTATE_RELEASED
+	vlans := []fabricv4.LinkProtocolResponse{
+		{VlanTag: tag(100)},
+		{VlanTagMin: tag(200), VlanTagMax: tag(210)},
+		{VlanSTag: tag(300), VlanCTag: tag(400)},
+		{VlanTag: tag(500), State: &released},
+	}
+
+	tests := map[string]struct {
+		tags []int64
+		want bool
+	}{
+		"free tags":           {tags: []int64{101, 211}, want: true},
+		"single tag in use":   {tags: []int64{101, 100}, want: false},
+		"tag in range in use": {tags: []int64{205}, want: false},
+		"outer tag in use":    {tags: []int64{300}, want: false},
+		"inner tag only":      {tags: []int64{400}, want: true},
+		"released tag":        {tags: []int64{500}, want: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := vlanTagsAvailable(vlans, tc.tags); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Package precisiontime for EPT resources and data sources
+package precisiontime
+
+import (
+	"context"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+)
+
+// NewDataSourcePackages retrieves precision time service packages
+func NewDataSourcePackages() datasource.DataSource {
+	return &DataSourcePackages{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_precision_time_packages",
+			},
+		),
+	}
+}
+
+// DataSourcePackages represents precision time service packages data source
+type DataSourcePackages struct {
+	framework.BaseDataSource
+}
+
+// Schema returns the packages data source schema
+func (r *DataSourcePackages) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePackagesSchema(ctx)
+}
+
+// Read retrieves precision time service packages, or a single package by code
+func (r *DataSourcePackages) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data dataSourcePackagesModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var packages []fabricv4.PrecisionTimePackageResponse
+	if code := data.Code.ValueString(); code != "" {
+		eptPackage, _, err := client.PrecisionTimeApi.GetTimeServicesPackageByCode(ctx, fabricv4.GetTimeServicesPackageByCodePackageCodeParameter(code)).Execute()
+		if err != nil {
+			response.Diagnostics.AddError("api error retrieving ept package data", equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		packages = append(packages, *eptPackage)
+	} else {
+		eptPackages, _, err := client.PrecisionTimeApi.GetTimeServicesPackages(ctx).Execute()
+		if err != nil {
+			response.Diagnostics.AddError("api error retrieving ept packages data", equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+		packages = eptPackages.GetData()
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, packages)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
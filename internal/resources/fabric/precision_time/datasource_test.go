@@ -1,5 +1,45 @@
 // Package precisiontime_test for EPT resources and data sources tests
 package precisiontime_test
 
-// Tested in resource test because of the expense to create connection and precision time
-// resources before performing data retrieval
+import (
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Service data sources are tested in resource test because of the expense to create
+// connection and precision time resources before performing data retrieval
+
+func TestAccFabricDataSourcePrecisionTimePackages_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricDataSourcePrecisionTimePackagesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_precision_time_packages.all", "data.#"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_precision_time_packages.ptp", "data.#", "1"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_precision_time_packages.ptp", "data.0.code", "PTP_STANDARD"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_precision_time_packages.ptp", "data.0.service_type", "PTP"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_precision_time_packages.ntp", "data.0.service_type", "NTP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricDataSourcePrecisionTimePackagesConfig() string {
+	return `
+	data "equinix_fabric_precision_time_packages" "all" {}
+
+	data "equinix_fabric_precision_time_packages" "ptp" {
+		code = "PTP_STANDARD"
+	}
+
+	data "equinix_fabric_precision_time_packages" "ntp" {
+		code = "NTP_STANDARD"
+	}
+	`
+}
@@ -98,6 +98,87 @@ Additional Documentation:
 	}
 }
 
+func dataSourcePackagesSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data resource that allow user to fetch Equinix Precision Time Service packages
+The package API does not report the accepted ptp_advanced_configuration values, so they are not exposed here; the equinix_fabric_precision_time_service resource validates them against the Fabric API enums instead
+Additional Documentation:
+* API: https://developer.equinix.com/catalog/fabricv4#tag/Precision-Time`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"code": schema.StringAttribute{
+				Description: "Only retrieve the package with this code",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_NTP_STANDARD),
+						string(fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_NTP_ENTERPRISE),
+						string(fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_PTP_STANDARD),
+						string(fabricv4.GETTIMESERVICESPACKAGEBYCODEPACKAGECODEPARAMETER_PTP_ENTERPRISE),
+					),
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "Returned list of Precision Time Service packages",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[packageDetailsModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: "Time Precision Package Code",
+							Computed:    true,
+						},
+						"href": schema.StringAttribute{
+							Description: "Time Precision Package HREF link",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Package type",
+							Computed:    true,
+						},
+						"service_type": schema.StringAttribute{
+							Description: "Precision Time Service type the package can be used with; NTP or PTP",
+							Computed:    true,
+						},
+						"bandwidth": schema.Int32Attribute{
+							Description: "Connection bandwidth in Mbps",
+							Computed:    true,
+						},
+						"clients_per_second_max": schema.Int32Attribute{
+							Description: "Maximum number of clients that can be synchronized per second at a packet rate of 1 per second",
+							Computed:    true,
+						},
+						"redundancy_supported": schema.BoolAttribute{
+							Description: "Whether redundant virtual connections are supported",
+							Computed:    true,
+						},
+						"multi_subnet_supported": schema.BoolAttribute{
+							Description: "Whether multiple subnets are supported",
+							Computed:    true,
+						},
+						"accuracy_sla_unit": schema.StringAttribute{
+							Description: "Accuracy SLA unit",
+							Computed:    true,
+						},
+						"accuracy_sla": schema.Int32Attribute{
+							Description: "Accuracy SLA for the package, -1 denotes the accuracy SLA is not published",
+							Computed:    true,
+						},
+						"accuracy_sla_min": schema.Int32Attribute{
+							Description: "Typical minimum accuracy for the package",
+							Computed:    true,
+						},
+						"accuracy_sla_max": schema.Int32Attribute{
+							Description: "Typical maximum accuracy for the package",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func dataSourceSingleEptServiceSchema(ctx context.Context) schema.Schema {
 	baseEptServiceSchema := getEptServiceSchema(ctx)
 	baseEptServiceSchema["id"] = framework.IDAttributeDefaultDescription()
@@ -26,6 +26,27 @@ type dataSourceAllEptServicesModel struct {
 	Sort       fwtypes.ListNestedObjectValueOf[sortModel]              `tfsdk:"sort"`
 }
 
+type dataSourcePackagesModel struct {
+	ID   types.String                                         `tfsdk:"id"`
+	Code types.String                                         `tfsdk:"code"`
+	Data fwtypes.ListNestedObjectValueOf[packageDetailsModel] `tfsdk:"data"`
+}
+
+type packageDetailsModel struct {
+	Code                 types.String `tfsdk:"code"`
+	Href                 types.String `tfsdk:"href"`
+	Type                 types.String `tfsdk:"type"`
+	ServiceType          types.String `tfsdk:"service_type"`
+	Bandwidth            types.Int32  `tfsdk:"bandwidth"`
+	ClientsPerSecondMax  types.Int32  `tfsdk:"clients_per_second_max"`
+	RedundancySupported  types.Bool   `tfsdk:"redundancy_supported"`
+	MultiSubnetSupported types.Bool   `tfsdk:"multi_subnet_supported"`
+	AccuracySlaUnit      types.String `tfsdk:"accuracy_sla_unit"`
+	AccuracySla          types.Int32  `tfsdk:"accuracy_sla"`
+	AccuracySlaMin       types.Int32  `tfsdk:"accuracy_sla_min"`
+	AccuracySlaMax       types.Int32  `tfsdk:"accuracy_sla_max"`
+}
+
 type filterModel struct {
 	Property types.String                      `tfsdk:"property"`
 	Operator types.String                      `tfsdk:"operator"`
@@ -192,6 +213,43 @@ func (m *dataSourceAllEptServicesModel) parse(ctx context.Context, eptResponse *
 	return mDiags
 }
 
+func (m *dataSourcePackagesModel) parse(ctx context.Context, packages []fabricv4.PrecisionTimePackageResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(packages) < 1 {
+		diags.AddError("no data retrieved by precision time packages data source",
+			"the api did not return any precision time packages")
+		return diags
+	}
+
+	data := make([]packageDetailsModel, len(packages))
+	for index, eptPackage := range packages {
+		code := string(eptPackage.GetCode())
+		data[index] = packageDetailsModel{
+			Code:                 types.StringValue(code),
+			Href:                 types.StringValue(eptPackage.GetHref()),
+			Type:                 types.StringValue(string(eptPackage.GetType())),
+			ServiceType:          types.StringValue(packageServiceType(code)),
+			Bandwidth:            types.Int32Value(eptPackage.GetBandwidth()),
+			ClientsPerSecondMax:  types.Int32Value(eptPackage.GetClientsPerSecondMax()),
+			RedundancySupported:  types.BoolValue(eptPackage.GetRedundancySupported()),
+			MultiSubnetSupported: types.BoolValue(eptPackage.GetMultiSubnetSupported()),
+			AccuracySlaUnit:      types.StringValue(eptPackage.GetAccuracySlaUnit()),
+			AccuracySla:          types.Int32Value(eptPackage.GetAccuracySla()),
+			AccuracySlaMin:       types.Int32Value(eptPackage.GetAccuracySlaMin()),
+			AccuracySlaMax:       types.Int32Value(eptPackage.GetAccuracySlaMax()),
+		}
+	}
+
+	if m.Code.ValueString() != "" {
+		m.ID = m.Code
+	} else {
+		m.ID = types.StringValue("precision_time_packages")
+	}
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[packageDetailsModel](ctx, data)
+	return diags
+}
+
 func (m *resourceModel) parse(ctx context.Context, routeAggregation *fabricv4.PrecisionTimeServiceResponse) diag.Diagnostics {
 	m.ID = types.StringValue(routeAggregation.GetUuid())
 	diags := m.basePrecisionTimeModel.parse(ctx, routeAggregation)
@@ -39,6 +39,22 @@ func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *r
 	resp.Schema = resourceSchema(ctx)
 }
 
+// ValidateConfig checks the package, PTP/NTP settings and IPv4 addressing
+// before any API call is made
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config resourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate(ctx)...)
+}
+
 // Create provisions a new precision time service
 func (r *Resource) Create(
 	ctx context.Context,
@@ -75,7 +91,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, ept.GetUuid(), createTimeout)
-	eptChecked, err := createWaiter.WaitForStateContext(ctx)
+	eptChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to creating Precision Time Service %s", ept.GetUuid()), err.Error())
@@ -180,7 +196,7 @@ func (r *Resource) Update(
 	}
 
 	updateWaiter := getCreateUpdateWaiter(ctx, client, serviceID, updateTimeout)
-	ept, err := updateWaiter.WaitForStateContext(ctx)
+	ept, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to update Precision Time Service %s", serviceID), err.Error())
@@ -233,7 +249,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deleteWaiter := getDeleteWaiter(ctx, client, id, deleteTimeout)
-	_, err = deleteWaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -533,9 +549,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, id s
 			}
 			return ept, string(ept.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
 
@@ -559,8 +574,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, id string,
 			}
 			return ept, string(ept.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
@@ -0,0 +1,242 @@
+// Package precisiontime for EPT resources and data sources
+package precisiontime
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// int32Range is an inclusive range of accepted values for a PTP setting
+type int32Range struct {
+	min int32
+	max int32
+}
+
+func (r int32Range) contains(value int32) bool {
+	return value >= r.min && value <= r.max
+}
+
+func (r int32Range) String() string {
+	return fmt.Sprintf("between %d and %d", r.min, r.max)
+}
+
+func rangeOf[T ~int32](values []T) int32Range {
+	return int32Range{min: int32(slices.Min(values)), max: int32(slices.Max(values))}
+}
+
+// PTP limits derived from the Fabric API enums
+var (
+	ptpLogAnnounceIntervalRange = rangeOf(fabricv4.AllowedPtpAdvanceConfigurationLogAnnounceIntervalEnumValues)
+	ptpLogSyncIntervalRange     = rangeOf(fabricv4.AllowedPtpAdvanceConfigurationLogSyncIntervalEnumValues)
+	ptpLogDelayReqIntervalRange = rangeOf(fabricv4.AllowedPtpAdvanceConfigurationLogDelayReqIntervalEnumValues)
+)
+
+var hexKeyPattern = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+
+// packageServiceType returns the service type, NTP or PTP, a package code belongs to
+func packageServiceType(code string) string {
+	serviceType, _, _ := strings.Cut(code, "_")
+	return serviceType
+}
+
+func (m *resourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serviceType := m.Type.ValueString()
+	if !m.Package.IsNull() && !m.Package.IsUnknown() {
+		var pkg packageModel
+		diags.Append(m.Package.As(ctx, &pkg, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+		code := pkg.Code.ValueString()
+		if code != "" && serviceType != "" && packageServiceType(code) != serviceType {
+			diags.AddAttributeError(
+				path.Root("package").AtName("code"),
+				"Invalid Precision Time Package",
+				fmt.Sprintf("package %s cannot be used with a %s Precision Time Service", code, serviceType),
+			)
+		}
+	}
+
+	if len(m.NtpAdvanceConfiguration.Elements()) > 0 {
+		if serviceType == string(fabricv4.PRECISIONTIMESERVICEREQUESTTYPE_PTP) {
+			diags.AddAttributeError(
+				path.Root("ntp_advanced_configuration"),
+				"Invalid Precision Time Configuration",
+				"ntp_advanced_configuration cannot be set on a PTP Precision Time Service",
+			)
+		}
+		diags.Append(m.validateNtpAdvanceConfiguration(ctx)...)
+	}
+
+	if !m.PtpAdvanceConfiguration.IsNull() && !m.PtpAdvanceConfiguration.IsUnknown() {
+		if serviceType == string(fabricv4.PRECISIONTIMESERVICEREQUESTTYPE_NTP) {
+			diags.AddAttributeError(
+				path.Root("ptp_advanced_configuration"),
+				"Invalid Precision Time Configuration",
+				"ptp_advanced_configuration cannot be set on an NTP Precision Time Service",
+			)
+		}
+		diags.Append(m.validatePtpAdvanceConfiguration(ctx)...)
+	}
+
+	if !m.Ipv4.IsNull() && !m.Ipv4.IsUnknown() {
+		var ipv4 ipv4Model
+		diags.Append(m.Ipv4.As(ctx, &ipv4, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(ipv4.validate()...)
+	}
+
+	return diags
+}
+
+func (m *resourceModel) validateNtpAdvanceConfiguration(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ntpConfigs := make([]ntpAdvanceConfigurationModel, len(m.NtpAdvanceConfiguration.Elements()))
+	diags.Append(m.NtpAdvanceConfiguration.ElementsAs(ctx, &ntpConfigs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for index, ntpConfig := range ntpConfigs {
+		attributePath := path.Root("ntp_advanced_configuration").AtListIndex(index)
+		if ntpConfig.Type.IsUnknown() || ntpConfig.Key.IsUnknown() || ntpConfig.Type.IsNull() {
+			continue
+		}
+		key := ntpConfig.Key.ValueString()
+		switch fabricv4.Md5Type(ntpConfig.Type.ValueString()) {
+		case fabricv4.MD5TYPE_ASCII:
+			if !ntpConfig.Key.IsNull() && (len(key) < 10 || len(key) > 20) {
+				diags.AddAttributeError(attributePath.AtName("key"), "Invalid NTP Authentication Key",
+					"an ASCII key must be between 10 and 20 characters long")
+			}
+		case fabricv4.MD5TYPE_HEX:
+			if !ntpConfig.Key.IsNull() && !hexKeyPattern.MatchString(key) {
+				diags.AddAttributeError(attributePath.AtName("key"), "Invalid NTP Authentication Key",
+					"a HEX key must only contain hexadecimal characters")
+			}
+		default:
+			diags.AddAttributeError(attributePath.AtName("type"), "Invalid NTP Authentication Type",
+				fmt.Sprintf("type must be one of %v, got %s", fabricv4.AllowedMd5TypeEnumValues, ntpConfig.Type.ValueString()))
+		}
+	}
+	return diags
+}
+
+func (m *resourceModel) validatePtpAdvanceConfiguration(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var ptpConfig ptpAdvanceConfigurationModel
+	diags.Append(m.PtpAdvanceConfiguration.As(ctx, &ptpConfig, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributePath := path.Root("ptp_advanced_configuration")
+	if timeScale := ptpConfig.TimeScale; !timeScale.IsNull() && !timeScale.IsUnknown() &&
+		!fabricv4.PtpAdvanceConfigurationTimeScale(timeScale.ValueString()).IsValid() {
+		diags.AddAttributeError(attributePath.AtName("time_scale"), "Invalid PTP Time Scale",
+			fmt.Sprintf("time_scale must be one of %v, got %s", fabricv4.AllowedPtpAdvanceConfigurationTimeScaleEnumValues, timeScale.ValueString()))
+	}
+	if transportMode := ptpConfig.TransportMode; !transportMode.IsNull() && !transportMode.IsUnknown() &&
+		!fabricv4.PtpAdvanceConfigurationTransportMode(transportMode.ValueString()).IsValid() {
+		diags.AddAttributeError(attributePath.AtName("transport_mode"), "Invalid PTP Transport Mode",
+			fmt.Sprintf("transport_mode must be one of %v, got %s", fabricv4.AllowedPtpAdvanceConfigurationTransportModeEnumValues, transportMode.ValueString()))
+	}
+
+	for _, check := range []struct {
+		attribute string
+		value     types.Int32
+		limits    int32Range
+	}{
+		{"log_announce_interval", ptpConfig.LogAnnounceInterval, ptpLogAnnounceIntervalRange},
+		{"log_sync_interval", ptpConfig.LogSyncInterval, ptpLogSyncIntervalRange},
+		{"log_delay_req_interval", ptpConfig.LogDelayReqInterval, ptpLogDelayReqIntervalRange},
+	} {
+		if check.value.IsNull() || check.value.IsUnknown() || check.limits.contains(check.value.ValueInt32()) {
+			continue
+		}
+		diags.AddAttributeError(attributePath.AtName(check.attribute), "Invalid PTP Configuration",
+			fmt.Sprintf("%s must be %s, got %d", check.attribute, check.limits, check.value.ValueInt32()))
+	}
+	return diags
+}
+
+// validate checks that the timing master servers and the default gateway are
+// distinct addresses inside the subnet defined by the network mask
+func (m ipv4Model) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributePath := path.Root("ipv4")
+
+	addresses := make(map[string]net.IP)
+	for _, address := range []struct {
+		attribute string
+		value     types.String
+	}{
+		{"primary", m.Primary},
+		{"secondary", m.Secondary},
+		{"network_mask", m.NetworkMask},
+		{"default_gateway", m.DefaultGateway},
+	} {
+		if address.value.IsNull() || address.value.IsUnknown() {
+			continue
+		}
+		ip := net.ParseIP(address.value.ValueString()).To4()
+		if ip == nil {
+			diags.AddAttributeError(attributePath.AtName(address.attribute), "Invalid IPv4 Address",
+				fmt.Sprintf("%s must be an IPv4 address, got %s", address.attribute, address.value.ValueString()))
+			continue
+		}
+		addresses[address.attribute] = ip
+	}
+	if diags.HasError() || len(addresses) != 4 {
+		return diags
+	}
+
+	mask := net.IPMask(addresses["network_mask"])
+	if ones, bits := mask.Size(); bits == 0 || ones == 0 || ones > 30 {
+		diags.AddAttributeError(attributePath.AtName("network_mask"), "Invalid IPv4 Network Mask",
+			fmt.Sprintf("network_mask must be a contiguous mask between /1 and /30, got %s", m.NetworkMask.ValueString()))
+		return diags
+	}
+
+	subnet := net.IPNet{IP: addresses["primary"].Mask(mask), Mask: mask}
+	broadcast := make(net.IP, len(subnet.IP))
+	for i := range subnet.IP {
+		broadcast[i] = subnet.IP[i] | ^mask[i]
+	}
+	for _, attribute := range []string{"secondary", "default_gateway"} {
+		if !subnet.Contains(addresses[attribute]) {
+			diags.AddAttributeError(attributePath.AtName(attribute), "Invalid IPv4 Address",
+				fmt.Sprintf("%s %s is outside of the %s subnet of the primary address", attribute, addresses[attribute], subnet.String()))
+		}
+	}
+	for _, attribute := range []string{"primary", "secondary", "default_gateway"} {
+		ip := addresses[attribute]
+		if ip.Equal(subnet.IP) || ip.Equal(broadcast) {
+			diags.AddAttributeError(attributePath.AtName(attribute), "Invalid IPv4 Address",
+				fmt.Sprintf("%s %s is the network or broadcast address of the %s subnet", attribute, ip, subnet.String()))
+		}
+	}
+	if addresses["primary"].Equal(addresses["secondary"]) {
+		diags.AddAttributeError(attributePath.AtName("secondary"), "Invalid IPv4 Address",
+			"primary and secondary must be different addresses")
+	}
+	if addresses["default_gateway"].Equal(addresses["primary"]) || addresses["default_gateway"].Equal(addresses["secondary"]) {
+		diags.AddAttributeError(attributePath.AtName("default_gateway"), "Invalid IPv4 Address",
+			"default_gateway must differ from the primary and secondary addresses")
+	}
+	return diags
+}
@@ -0,0 +1,190 @@
+package precisiontime
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+)
+
+func diagSummary(diags diag.Diagnostics) string {
+	var details []string
+	for _, d := range diags.Errors() {
+		details = append(details, d.Detail())
+	}
+	return strings.Join(details, "; ")
+}
+
+func TestIpv4ModelValidate(t *testing.T) {
+	tests := map[string]struct {
+		ipv4    ipv4Model
+		wantErr string
+	}{
+		"valid": {
+			ipv4: ipv4Model{
+				Primary:        types.StringValue("192.168.254.241"),
+				Secondary:      types.StringValue("192.168.254.242"),
+				NetworkMask:    types.StringValue("255.255.255.240"),
+				DefaultGateway: types.StringValue("192.168.254.254"),
+			},
+		},
+		"unknown values are skipped": {
+			ipv4: ipv4Model{
+				Primary:        types.StringUnknown(),
+				Secondary:      types.StringValue("192.168.254.242"),
+				NetworkMask:    types.StringValue("255.255.255.240"),
+				DefaultGateway: types.StringValue("10.0.0.1"),
+			},
+		},
+		"ipv6 address": {
+			ipv4: ipv4Model{
+				Primary:        types.StringValue("2001:db8::1"),
+				Secondary:      types.StringValue("192.168.254.242"),
+				NetworkMask:    types.StringValue("255.255.255.240"),
+				DefaultGateway: types.StringValue("192.168.254.254"),
+			},
+			wantErr: "primary must be an IPv4 address",
+		},
+		"non contiguous mask": {
+			ipv4: ipv4Model{
+				Primary:        types.StringValue("192.168.254.241"),
+				Secondary:      types.StringValue("192.168.254.242"),
+				NetworkMask:    types.StringValue("255.0.255.0"),
+				DefaultGateway: types.StringValue("192.168.254.254"),
+			},
+			wantErr: "network_mask must be a contiguous mask",
+		},
+		"gateway outside subnet": {
+			ipv4: ipv4Model{
+				Primary:        types.StringValue("192.168.254.241"),
+				Secondary:      types.StringValue("192.168.254.242"),
+				NetworkMask:    types.StringValue("255.255.255.240"),
+				DefaultGateway: types.StringValue("192.168.253.1"),
+			},
+			wantErr: "default_gateway 192.168.253.1 is outside of the 192.168.254.240/28 subnet",
+		},
+		"broadcast address": {
+			ipv4: ipv4Model{
+				Primary:        types.StringValue("192.168.254.241"),
+				Secondary:      types.StringValue("192.168.254.255"),
+				NetworkMask:    types.StringValue("255.255.255.240"),
+				DefaultGateway: types.StringValue("192.168.254.254"),
+			},
+			wantErr: "secondary 192.168.254.255 is the network or broadcast address",
+		},
+		"gateway equals primary": {
+			ipv4: ipv4Model{
+				Primary:        types.StringValue("192.168.254.241"),
+				Secondary:      types.StringValue("192.168.254.242"),
+				NetworkMask:    types.StringValue("255.255.255.240"),
+				DefaultGateway: types.StringValue("192.168.254.241"),
+			},
+			wantErr: "default_gateway must differ",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := diagSummary(tc.ipv4.validate())
+			if tc.wantErr == "" && got != "" {
+				t.Errorf("expected no errors, got %q", got)
+			}
+			if tc.wantErr != "" && !strings.Contains(got, tc.wantErr) {
+				t.Errorf("expected error containing %q, got %q", tc.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestResourceModelValidate(t *testing.T) {
+	ctx := context.Background()
+	ptpConfig := func(config ptpAdvanceConfigurationModel) fwtypes.ObjectValueOf[ptpAdvanceConfigurationModel] {
+		return fwtypes.NewObjectValueOf[ptpAdvanceConfigurationModel](ctx, &config)
+	}
+	validPtp := ptpAdvanceConfigurationModel{
+		TimeScale:           types.StringValue("ARB"),
+		Domain:              types.Int32Value(127),
+		Priority1:           types.Int32Value(0),
+		Priority2:           types.Int32Value(0),
+		LogAnnounceInterval: types.Int32Value(0),
+		LogSyncInterval:     types.Int32Value(-5),
+		LogDelayReqInterval: types.Int32Value(1),
+		TransportMode:       types.StringValue("MULTICAST"),
+		GrantTime:           types.Int32Value(300),
+	}
+
+	tests := map[string]struct {
+		model   basePrecisionTimeModel
+		wantErr string
+	}{
+		"valid ptp configuration": {
+			model: basePrecisionTimeModel{
+				Type:                    types.StringValue("PTP"),
+				Package:                 fwtypes.NewObjectValueOf[packageModel](ctx, &packageModel{Code: types.StringValue("PTP_STANDARD")}),
+				PtpAdvanceConfiguration: ptpConfig(validPtp),
+			},
+		},
+		"package does not match type": {
+			model: basePrecisionTimeModel{
+				Type:    types.StringValue("NTP"),
+				Package: fwtypes.NewObjectValueOf[packageModel](ctx, &packageModel{Code: types.StringValue("PTP_STANDARD")}),
+			},
+			wantErr: "package PTP_STANDARD cannot be used with a NTP Precision Time Service",
+		},
+		"ptp configuration on ntp": {
+			model: basePrecisionTimeModel{
+				Type:                    types.StringValue("NTP"),
+				PtpAdvanceConfiguration: ptpConfig(validPtp),
+			},
+			wantErr: "ptp_advanced_configuration cannot be set on an NTP",
+		},
+		"sync interval out of range": {
+			model: basePrecisionTimeModel{
+				Type: types.StringValue("PTP"),
+				PtpAdvanceConfiguration: ptpConfig(func() ptpAdvanceConfigurationModel {
+					config := validPtp
+					config.LogSyncInterval = types.Int32Value(2)
+					return config
+				}()),
+			},
+			wantErr: "log_sync_interval must be between -5 and 1, got 2",
+		},
+		"invalid time scale": {
+			model: basePrecisionTimeModel{
+				Type: types.StringValue("PTP"),
+				PtpAdvanceConfiguration: ptpConfig(func() ptpAdvanceConfigurationModel {
+					config := validPtp
+					config.TimeScale = types.StringValue("UTC")
+					return config
+				}()),
+			},
+			wantErr: "time_scale must be one of",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.model.Package.IsNull() {
+				tc.model.Package = fwtypes.NewObjectValueOfNull[packageModel](ctx)
+			}
+			if tc.model.PtpAdvanceConfiguration.IsNull() {
+				tc.model.PtpAdvanceConfiguration = fwtypes.NewObjectValueOfNull[ptpAdvanceConfigurationModel](ctx)
+			}
+			tc.model.Ipv4 = fwtypes.NewObjectValueOfNull[ipv4Model](ctx)
+			tc.model.NtpAdvanceConfiguration = fwtypes.NewListNestedObjectValueOfNull[ntpAdvanceConfigurationModel](ctx)
+
+			model := resourceModel{basePrecisionTimeModel: tc.model}
+			got := diagSummary(model.validate(ctx))
+			if tc.wantErr == "" && got != "" {
+				t.Errorf("expected no errors, got %q", got)
+			}
+			if tc.wantErr != "" && !strings.Contains(got, tc.wantErr) {
+				t.Errorf("expected error containing %q, got %q", tc.wantErr, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package price
+
+import (
+	"context"
+	"fmt"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func NewDataSourcePrices() datasource.DataSource {
+	return &DataSourcePrices{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_prices",
+			},
+		),
+	}
+}
+
+type DataSourcePrices struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourcePrices) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePricesSchema(ctx)
+}
+
+func (r *DataSourcePrices) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data DataSourcePricesModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := buildSearchFilter(ctx, data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prices, _, err := client.PricesApi.SearchPrices(ctx).FilterBody(fabricv4.FilterBody{Filter: &filter}).Execute()
+	if err != nil {
+		response.State.RemoveResource(ctx)
+		response.Diagnostics.AddError("api error retrieving prices data", formatPriceError(err).Error())
+		return
+	}
+
+	response.Diagnostics.Append(data.parse(ctx, prices)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// formatPriceError adds the price errors, including the properties rejected by
+// the search, to the error; the SDK only reports the response status for them
+func formatPriceError(err error) error {
+	genericError, ok := err.(*fabricv4.GenericOpenAPIError)
+	if !ok {
+		return equinix_errors.FormatFabricError(err)
+	}
+	priceErrs, ok := genericError.Model().([]fabricv4.PriceError)
+	if !ok || len(priceErrs) == 0 {
+		return equinix_errors.FormatFabricError(err)
+	}
+
+	errors := equinix_errors.Errors{err.Error()}
+	for _, priceErr := range priceErrs {
+		errors = append(errors, fmt.Sprintf("Code: %s, Message: %s, Details: %s",
+			priceErr.GetErrorCode(), priceErr.GetErrorMessage(), priceErr.GetDetails()))
+		if additionalInfo := fabricv4.FormatFabricv4AdditionalInfo(priceErr.GetAdditionalInfo()); additionalInfo != "" {
+			errors = append(errors, fmt.Sprintf("AdditionalInfo: [%s]", additionalInfo))
+		}
+	}
+	return errors
+}
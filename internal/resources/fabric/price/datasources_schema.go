@@ -0,0 +1,248 @@
+package price
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func dataSourcePricesSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data source that allows user to fetch Equinix Fabric prices for connections, cloud routers and ports before ordering them
+
+Additional Documentation:
+* API: https://developer.equinix.com/catalog/fabricv4#tag/Prices`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"type": schema.StringAttribute{
+				Description: "Product type to price. One of VIRTUAL_CONNECTION_PRODUCT, CLOUD_ROUTER_PRODUCT, VIRTUAL_PORT_PRODUCT",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(fabricv4.PRODUCTTYPE_VIRTUAL_CONNECTION_PRODUCT),
+						string(fabricv4.PRODUCTTYPE_CLOUD_ROUTER_PRODUCT),
+						string(fabricv4.PRODUCTTYPE_VIRTUAL_PORT_PRODUCT),
+					),
+				},
+			},
+			"virtual_connection": schema.SingleNestedAttribute{
+				Description: "Connection to price; uses the same shape as the equinix_fabric_connection resource",
+				Optional:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[ConnectionFilterModel](ctx),
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("cloud_router"), path.MatchRoot("port")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"uuid": schema.StringAttribute{
+						Description: "Equinix-assigned connection identifier; prices an existing connection",
+						Optional:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Connection type like EVPL_VC, EPL_VC, IP_VC, IPWAN_VC, ACCESS_EPL_VC, EVPLAN_VC, EPLAN_VC, EIA_VC, EC_VC",
+						Optional:    true,
+					},
+					"bandwidth": schema.Int32Attribute{
+						Description: "Connection bandwidth in Mbps",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"a_side": connectionSideSchema(ctx, "Requester or Customer side of the connection"),
+					"z_side": connectionSideSchema(ctx, "Destination or Provider side of the connection"),
+				},
+			},
+			"cloud_router": schema.SingleNestedAttribute{
+				Description: "Cloud Router to price; uses the same shape as the equinix_fabric_cloud_router resource",
+				Optional:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[RouterFilterModel](ctx),
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("virtual_connection"), path.MatchRoot("port")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"uuid": schema.StringAttribute{
+						Description: "Equinix-assigned Cloud Router identifier; prices an existing Cloud Router",
+						Optional:    true,
+					},
+					"package": schema.SingleNestedAttribute{
+						Description: "Cloud Router package",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[PackageFilterModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"code": schema.StringAttribute{
+								Description: "Cloud Router package code like LAB, STANDARD, ADVANCED, PREMIUM",
+								Required:    true,
+							},
+						},
+					},
+					"location": locationSchema(ctx, "Cloud Router location"),
+				},
+			},
+			"port": schema.SingleNestedAttribute{
+				Description: "Port to price",
+				Optional:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[PortFilterModel](ctx),
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("virtual_connection"), path.MatchRoot("cloud_router")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"uuid": schema.StringAttribute{
+						Description: "Equinix-assigned port identifier; prices an existing port",
+						Optional:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Port type like XF_PORT",
+						Optional:    true,
+					},
+					"bandwidth": schema.Int32Attribute{
+						Description: "Aggregated port bandwidth in Mbps",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"physical_ports_quantity": schema.Int32Attribute{
+						Description: "Number of physical ports requested",
+						Optional:    true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"lag": schema.SingleNestedAttribute{
+						Description: "Link aggregation group settings",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[LagFilterModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Description: "Whether the port is part of a link aggregation group",
+								Required:    true,
+							},
+						},
+					},
+					"location": schema.SingleNestedAttribute{
+						Description: "Port location",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[PortLocationFilterModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"ibx": schema.StringAttribute{
+								Description: "IBX code of the port",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+			"data": schema.ListNestedAttribute{
+				Description: "List of prices matching the product configuration",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[PriceModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Product type",
+							Computed:    true,
+						},
+						"code": schema.StringAttribute{
+							Description: "Equinix-assigned product code",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Full product name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Product description",
+							Computed:    true,
+						},
+						"currency": schema.StringAttribute{
+							Description: "Product offering price currency",
+							Computed:    true,
+						},
+						"term_length": schema.Int32Attribute{
+							Description: "Term length in months the price applies to",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "Price category - COUNTRY, CUSTOMER",
+							Computed:    true,
+						},
+						"charges": schema.ListNestedAttribute{
+							Description: "Offering price charges",
+							Computed:    true,
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[ChargeModel](ctx),
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Charge type - MONTHLY_RECURRING, NON_RECURRING",
+										Computed:    true,
+									},
+									"price": schema.Float64Attribute{
+										Description: "Offering price",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func connectionSideSchema(ctx context.Context, description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[ConnectionSideFilterModel](ctx),
+		Attributes: map[string]schema.Attribute{
+			"access_point": schema.SingleNestedAttribute{
+				Description: "Point of access details",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[AccessPointFilterModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Access point type - COLO, VD, SP, CLOUD_ROUTER, NETWORK",
+						Optional:    true,
+					},
+					"location": locationSchema(ctx, "Access point location"),
+					"profile": schema.SingleNestedAttribute{
+						Description: "Service Profile",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[ProfileFilterModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"uuid": schema.StringAttribute{
+								Description: "Equinix assigned service profile identifier",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func locationSchema(ctx context.Context, description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[LocationFilterModel](ctx),
+		Attributes: map[string]schema.Attribute{
+			"metro_code": schema.StringAttribute{
+				Description: "Metro code",
+				Required:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package price_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricPricesDataSource_PFCR(t *testing.T) {
+	metroCode := "SV"
+	packageCode := "STANDARD"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricPricesDataSourceConfig(metroCode, packageCode),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_prices.cloud_router", "id"),
+					resource.TestCheckResourceAttr("data.equinix_fabric_prices.cloud_router", "data.0.type", "CLOUD_ROUTER_PRODUCT"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_prices.cloud_router", "data.0.code"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_prices.cloud_router", "data.0.currency"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_prices.cloud_router", "data.0.term_length"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_prices.cloud_router", "data.0.charges.0.type"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_prices.cloud_router", "data.0.charges.0.price"),
+				),
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccFabricPricesDataSourceConfig(metroCode, packageCode string) string {
+	return fmt.Sprintf(`
+	data "equinix_fabric_prices" "cloud_router" {
+		type = "CLOUD_ROUTER_PRODUCT"
+		cloud_router = {
+			package = {
+				code = "%[2]s"
+			}
+			location = {
+				metro_code = "%[1]s"
+			}
+		}
+	}
+	`, metroCode, packageCode)
+}
@@ -0,0 +1,284 @@
+package price
+
+import (
+	"context"
+	"strconv"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourcePricesModel struct {
+	ID                types.String                                 `tfsdk:"id"`
+	Type              types.String                                 `tfsdk:"type"`
+	VirtualConnection fwtypes.ObjectValueOf[ConnectionFilterModel] `tfsdk:"virtual_connection"`
+	CloudRouter       fwtypes.ObjectValueOf[RouterFilterModel]     `tfsdk:"cloud_router"`
+	Port              fwtypes.ObjectValueOf[PortFilterModel]       `tfsdk:"port"`
+	Data              fwtypes.ListNestedObjectValueOf[PriceModel]  `tfsdk:"data"`
+}
+
+type ConnectionFilterModel struct {
+	UUID      types.String                                     `tfsdk:"uuid"`
+	Type      types.String                                     `tfsdk:"type"`
+	Bandwidth types.Int32                                      `tfsdk:"bandwidth"`
+	ASide     fwtypes.ObjectValueOf[ConnectionSideFilterModel] `tfsdk:"a_side"`
+	ZSide     fwtypes.ObjectValueOf[ConnectionSideFilterModel] `tfsdk:"z_side"`
+}
+
+type ConnectionSideFilterModel struct {
+	AccessPoint fwtypes.ObjectValueOf[AccessPointFilterModel] `tfsdk:"access_point"`
+}
+
+type AccessPointFilterModel struct {
+	Type     types.String                               `tfsdk:"type"`
+	Location fwtypes.ObjectValueOf[LocationFilterModel] `tfsdk:"location"`
+	Profile  fwtypes.ObjectValueOf[ProfileFilterModel]  `tfsdk:"profile"`
+}
+
+type LocationFilterModel struct {
+	MetroCode types.String `tfsdk:"metro_code"`
+}
+
+type ProfileFilterModel struct {
+	UUID types.String `tfsdk:"uuid"`
+}
+
+type RouterFilterModel struct {
+	UUID     types.String                               `tfsdk:"uuid"`
+	Package  fwtypes.ObjectValueOf[PackageFilterModel]  `tfsdk:"package"`
+	Location fwtypes.ObjectValueOf[LocationFilterModel] `tfsdk:"location"`
+}
+
+type PackageFilterModel struct {
+	Code types.String `tfsdk:"code"`
+}
+
+type PortFilterModel struct {
+	UUID                  types.String                                   `tfsdk:"uuid"`
+	Type                  types.String                                   `tfsdk:"type"`
+	Bandwidth             types.Int32                                    `tfsdk:"bandwidth"`
+	PhysicalPortsQuantity types.Int32                                    `tfsdk:"physical_ports_quantity"`
+	Lag                   fwtypes.ObjectValueOf[LagFilterModel]          `tfsdk:"lag"`
+	Location              fwtypes.ObjectValueOf[PortLocationFilterModel] `tfsdk:"location"`
+}
+
+type LagFilterModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type PortLocationFilterModel struct {
+	IBX types.String `tfsdk:"ibx"`
+}
+
+type PriceModel struct {
+	Type        types.String                                 `tfsdk:"type"`
+	Code        types.String                                 `tfsdk:"code"`
+	Name        types.String                                 `tfsdk:"name"`
+	Description types.String                                 `tfsdk:"description"`
+	Currency    types.String                                 `tfsdk:"currency"`
+	TermLength  types.Int32                                  `tfsdk:"term_length"`
+	Category    types.String                                 `tfsdk:"category"`
+	Charges     fwtypes.ListNestedObjectValueOf[ChargeModel] `tfsdk:"charges"`
+}
+
+type ChargeModel struct {
+	Type  types.String  `tfsdk:"type"`
+	Price types.Float64 `tfsdk:"price"`
+}
+
+// searchExpressions collects the equality expressions of a price search. Only
+// attributes that are set in the configuration become part of the filter.
+type searchExpressions []fabricv4.SearchExpression
+
+func (e *searchExpressions) addString(property string, value types.String) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return
+	}
+	e.add(property, value.ValueString())
+}
+
+func (e *searchExpressions) addInt32(property string, value types.Int32) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	e.add(property, strconv.Itoa(int(value.ValueInt32())))
+}
+
+func (e *searchExpressions) addBool(property string, value types.Bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	e.add(property, strconv.FormatBool(value.ValueBool()))
+}
+
+func (e *searchExpressions) add(property, value string) {
+	expression := fabricv4.SearchExpression{}
+	expression.SetProperty(property)
+	expression.SetOperator(fabricv4.EXPRESSIONOPERATOR_EQUAL)
+	expression.SetValues([]string{value})
+	*e = append(*e, expression)
+}
+
+func buildSearchFilter(ctx context.Context, data DataSourcePricesModel) (fabricv4.SearchExpression, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	expressions := searchExpressions{}
+	expressions.addString("/type", data.Type)
+
+	if !data.VirtualConnection.IsNull() && !data.VirtualConnection.IsUnknown() {
+		connection, d := data.VirtualConnection.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fabricv4.SearchExpression{}, diags
+		}
+		expressions.addString("/connection/uuid", connection.UUID)
+		expressions.addString("/connection/type", connection.Type)
+		expressions.addInt32("/connection/bandwidth", connection.Bandwidth)
+		diags.Append(expressions.addConnectionSide(ctx, "/connection/aSide", connection.ASide)...)
+		diags.Append(expressions.addConnectionSide(ctx, "/connection/zSide", connection.ZSide)...)
+	}
+
+	if !data.CloudRouter.IsNull() && !data.CloudRouter.IsUnknown() {
+		router, d := data.CloudRouter.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fabricv4.SearchExpression{}, diags
+		}
+		expressions.addString("/router/uuid", router.UUID)
+		if !router.Package.IsNull() && !router.Package.IsUnknown() {
+			routerPackage, d := router.Package.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return fabricv4.SearchExpression{}, diags
+			}
+			expressions.addString("/router/package/code", routerPackage.Code)
+		}
+		diags.Append(expressions.addLocation(ctx, "/router/location", router.Location)...)
+	}
+
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		port, d := data.Port.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fabricv4.SearchExpression{}, diags
+		}
+		expressions.addString("/port/uuid", port.UUID)
+		expressions.addString("/port/type", port.Type)
+		expressions.addInt32("/port/bandwidth", port.Bandwidth)
+		expressions.addInt32("/port/physicalPortsQuantity", port.PhysicalPortsQuantity)
+		if !port.Lag.IsNull() && !port.Lag.IsUnknown() {
+			lag, d := port.Lag.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return fabricv4.SearchExpression{}, diags
+			}
+			expressions.addBool("/port/lag/enabled", lag.Enabled)
+		}
+		if !port.Location.IsNull() && !port.Location.IsUnknown() {
+			location, d := port.Location.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return fabricv4.SearchExpression{}, diags
+			}
+			expressions.addString("/port/location/ibx", location.IBX)
+		}
+	}
+
+	filter := fabricv4.SearchExpression{}
+	filter.SetAnd(expressions)
+	return filter, diags
+}
+
+func (e *searchExpressions) addConnectionSide(ctx context.Context, prefix string, side fwtypes.ObjectValueOf[ConnectionSideFilterModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if side.IsNull() || side.IsUnknown() {
+		return diags
+	}
+	sideModel, d := side.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || sideModel.AccessPoint.IsNull() || sideModel.AccessPoint.IsUnknown() {
+		return diags
+	}
+	accessPoint, d := sideModel.AccessPoint.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	e.addString(prefix+"/accessPoint/type", accessPoint.Type)
+	diags.Append(e.addLocation(ctx, prefix+"/accessPoint/location", accessPoint.Location)...)
+	if !accessPoint.Profile.IsNull() && !accessPoint.Profile.IsUnknown() {
+		profile, d := accessPoint.Profile.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		e.addString(prefix+"/accessPoint/profile/uuid", profile.UUID)
+	}
+	return diags
+}
+
+func (e *searchExpressions) addLocation(ctx context.Context, prefix string, location fwtypes.ObjectValueOf[LocationFilterModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if location.IsNull() || location.IsUnknown() {
+		return diags
+	}
+	locationModel, d := location.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	e.addString(prefix+"/metroCode", locationModel.MetroCode)
+	return diags
+}
+
+func (m *DataSourcePricesModel) parse(ctx context.Context, pricesResponse *fabricv4.PriceSearchResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(pricesResponse.GetData()) < 1 {
+		diags.AddError("no data retrieved by prices data source",
+			"no price is offered for the given product configuration; check the access point, bandwidth, metro and package values")
+		return diags
+	}
+
+	prices := pricesResponse.GetData()
+	data := make([]PriceModel, len(prices))
+	for index, price := range prices {
+		var priceModel PriceModel
+		diags = priceModel.parse(ctx, &price)
+		if diags.HasError() {
+			return diags
+		}
+		data[index] = priceModel
+	}
+
+	m.ID = types.StringValue(data[0].Code.ValueString())
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[PriceModel](ctx, data)
+
+	return diags
+}
+
+func (m *PriceModel) parse(ctx context.Context, price *fabricv4.Price) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Type = types.StringValue(string(price.GetType()))
+	m.Code = types.StringValue(price.GetCode())
+	m.Name = types.StringValue(price.GetName())
+	m.Description = types.StringValue(price.GetDescription())
+	m.Currency = types.StringValue(price.GetCurrency())
+	m.TermLength = types.Int32Value(int32(price.GetTermLength()))
+	m.Category = types.StringValue(string(price.GetCatgory()))
+
+	charges := make([]ChargeModel, len(price.GetCharges()))
+	for index, charge := range price.GetCharges() {
+		charges[index] = ChargeModel{
+			Type:  types.StringValue(string(charge.GetType())),
+			Price: types.Float64Value(charge.GetPrice()),
+		}
+	}
+	m.Charges = fwtypes.NewListNestedObjectValueOfValueSlice[ChargeModel](ctx, charges)
+
+	return diags
+}
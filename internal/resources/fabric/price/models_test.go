@@ -0,0 +1,160 @@
+package price
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildSearchFilter(t *testing.T) {
+	ctx := context.Background()
+	location := fwtypes.NewObjectValueOf(ctx, &LocationFilterModel{MetroCode: types.StringValue("SV")})
+
+	tests := map[string]struct {
+		data DataSourcePricesModel
+		want map[string]string
+	}{
+		"virtual connection": {
+			data: DataSourcePricesModel{
+				Type: types.StringValue("VIRTUAL_CONNECTION_PRODUCT"),
+				VirtualConnection: fwtypes.NewObjectValueOf(ctx, &ConnectionFilterModel{
+					UUID:      types.StringNull(),
+					Type:      types.StringValue("EVPL_VC"),
+					Bandwidth: types.Int32Value(50),
+					ASide: fwtypes.NewObjectValueOf(ctx, &ConnectionSideFilterModel{
+						AccessPoint: fwtypes.NewObjectValueOf(ctx, &AccessPointFilterModel{
+							Type:     types.StringValue("COLO"),
+							Location: location,
+							Profile:  fwtypes.NewObjectValueOfNull[ProfileFilterModel](ctx),
+						}),
+					}),
+					ZSide: fwtypes.NewObjectValueOf(ctx, &ConnectionSideFilterModel{
+						AccessPoint: fwtypes.NewObjectValueOf(ctx, &AccessPointFilterModel{
+							Type:     types.StringValue("SP"),
+							Location: fwtypes.NewObjectValueOfNull[LocationFilterModel](ctx),
+							Profile:  fwtypes.NewObjectValueOf(ctx, &ProfileFilterModel{UUID: types.StringValue("profile")}),
+						}),
+					}),
+				}),
+			},
+			want: map[string]string{
+				"/type":                              "VIRTUAL_CONNECTION_PRODUCT",
+				"/connection/type":                   "EVPL_VC",
+				"/connection/bandwidth":              "50",
+				"/connection/aSide/accessPoint/type": "COLO",
+				"/connection/aSide/accessPoint/location/metroCode": "SV",
+				"/connection/zSide/accessPoint/type":               "SP",
+				"/connection/zSide/accessPoint/profile/uuid":       "profile",
+			},
+		},
+		"cloud router": {
+			data: DataSourcePricesModel{
+				Type: types.StringValue("CLOUD_ROUTER_PRODUCT"),
+				CloudRouter: fwtypes.NewObjectValueOf(ctx, &RouterFilterModel{
+					UUID:     types.StringNull(),
+					Package:  fwtypes.NewObjectValueOf(ctx, &PackageFilterModel{Code: types.StringValue("STANDARD")}),
+					Location: location,
+				}),
+			},
+			want: map[string]string{
+				"/type":                      "CLOUD_ROUTER_PRODUCT",
+				"/router/package/code":       "STANDARD",
+				"/router/location/metroCode": "SV",
+			},
+		},
+		"port": {
+			data: DataSourcePricesModel{
+				Type: types.StringValue("IP_BLOCK_PRODUCT"),
+				Port: fwtypes.NewObjectValueOf(ctx, &PortFilterModel{
+					UUID:                  types.StringNull(),
+					Type:                  types.StringValue("XF_PORT"),
+					Bandwidth:             types.Int32Value(10000),
+					PhysicalPortsQuantity: types.Int32Value(2),
+					Lag:                   fwtypes.NewObjectValueOf(ctx, &LagFilterModel{Enabled: types.BoolValue(true)}),
+					Location:              fwtypes.NewObjectValueOf(ctx, &PortLocationFilterModel{IBX: types.StringValue("SV1")}),
+				}),
+			},
+			want: map[string]string{
+				"/type":                       "IP_BLOCK_PRODUCT",
+				"/port/type":                  "XF_PORT",
+				"/port/bandwidth":             "10000",
+				"/port/physicalPortsQuantity": "2",
+				"/port/lag/enabled":           "true",
+				"/port/location/ibx":          "SV1",
+			},
+		},
+		"port without nested filters": {
+			data: DataSourcePricesModel{
+				Type: types.StringValue("IP_BLOCK_PRODUCT"),
+				Port: fwtypes.NewObjectValueOf(ctx, &PortFilterModel{
+					UUID:                  types.StringValue("port"),
+					Type:                  types.StringNull(),
+					Bandwidth:             types.Int32Null(),
+					PhysicalPortsQuantity: types.Int32Unknown(),
+					Lag:                   fwtypes.NewObjectValueOfNull[LagFilterModel](ctx),
+					Location:              fwtypes.NewObjectValueOfUnknown[PortLocationFilterModel](ctx),
+				}),
+			},
+			want: map[string]string{
+				"/type":      "IP_BLOCK_PRODUCT",
+				"/port/uuid": "port",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filter, diags := buildSearchFilter(ctx, tc.data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got := make(map[string]string)
+			for _, expression := range filter.GetAnd() {
+				if expression.GetOperator() != fabricv4.EXPRESSIONOPERATOR_EQUAL || len(expression.GetValues()) != 1 {
+					t.Errorf("unexpected expression %+v", expression)
+				}
+				got[expression.GetProperty()] = expression.GetValues()[0]
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatPriceError(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`[{
+			"errorCode": "EQ-3038010",
+			"errorMessage": "Validation failure",
+			"details": "Invalid filter",
+			"additionalInfo": [{"property": "/connection/bandwidth", "reason": "Bandwidth is not supported"}]
+		}]`))
+	}))
+	defer mockAPI.Close()
+
+	configuration := fabricv4.NewConfiguration()
+	configuration.Servers = fabricv4.ServerConfigurations{{URL: mockAPI.URL}}
+	client := fabricv4.NewAPIClient(configuration)
+	_, _, err := client.PricesApi.SearchPrices(context.Background()).FilterBody(fabricv4.FilterBody{}).Execute()
+	if err == nil {
+		t.Fatal("expected an error searching prices")
+	}
+
+	got := formatPriceError(err).Error()
+	for _, want := range []string{"Code: EQ-3038010", "Details: Invalid filter", "Property: /connection/bandwidth, Bandwidth is not supported"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in error, got %q", want, got)
+		}
+	}
+}
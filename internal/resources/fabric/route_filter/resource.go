@@ -134,12 +134,11 @@ func waitForStability(uuid string, meta interface{}, d *schema.ResourceData, ctx
 			}
 			return routeFilter, string(routeFilter.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -166,12 +165,11 @@ func WaitForDeletion(uuid string, meta interface{}, d *schema.ResourceData, ctx
 			}
 			return routeFilter, string(routeFilter.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -142,12 +142,11 @@ func waitForStability(routeFilterId, ruleId string, meta interface{}, d *schema.
 			}
 			return routeFilterRule, string(routeFilterRule.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -174,12 +173,11 @@ func WaitForDeletion(routeFilterId, ruleId string, meta interface{}, d *schema.R
 			}
 			return routeFilterRule, string(routeFilterRule.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -0,0 +1,243 @@
+package route_filter_rules
+
+import (
+	"context"
+	"sort"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	prefixMatchOrLonger = "orlonger"
+	prefixMatchExact    = "exact"
+	defaultBatchSize    = 50
+)
+
+type ResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	RouteFilterID types.String   `tfsdk:"route_filter_id"`
+	Rules         types.Set      `tfsdk:"rules"`
+	RuleUUIDs     types.Map      `tfsdk:"rule_uuids"`
+	BatchSize     types.Int64    `tfsdk:"batch_size"`
+}
+
+type RuleModel struct {
+	Prefix      types.String `tfsdk:"prefix"`
+	PrefixMatch types.String `tfsdk:"prefix_match"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *ResourceModel) rules(ctx context.Context) ([]RuleModel, diag.Diagnostics) {
+	var rules []RuleModel
+	if m.Rules.IsNull() || m.Rules.IsUnknown() {
+		return rules, nil
+	}
+	diags := m.Rules.ElementsAs(ctx, &rules, false)
+	return rules, diags
+}
+
+func (m *ResourceModel) batchSize() int {
+	if m.BatchSize.IsNull() || m.BatchSize.IsUnknown() {
+		return defaultBatchSize
+	}
+	return int(m.BatchSize.ValueInt64())
+}
+
+// parse sets the rules to the active Rules of the Route Filter. Names and
+// descriptions that were left out of the known rules stay unset so that the
+// values generated by the API don't show up as differences
+func (m *ResourceModel) parse(ctx context.Context, routeFilterRules []fabricv4.RouteFilterRulesData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.RouteFilterID.IsNull() || m.RouteFilterID.IsUnknown() {
+		m.RouteFilterID = m.ID
+	}
+	m.ID = m.RouteFilterID
+	if m.BatchSize.IsNull() || m.BatchSize.IsUnknown() {
+		m.BatchSize = types.Int64Value(defaultBatchSize)
+	}
+
+	known, d := m.rules(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	knownRules := make(map[string]RuleModel, len(known))
+	for _, rule := range known {
+		knownRules[rule.Prefix.ValueString()] = rule
+	}
+
+	rules := make([]RuleModel, 0, len(routeFilterRules))
+	ruleUUIDs := make(map[string]attr.Value, len(routeFilterRules))
+	for _, routeFilterRule := range routeFilterRules {
+		rule := newRuleModel(routeFilterRule)
+		if knownRule, ok := knownRules[rule.Prefix.ValueString()]; ok {
+			if knownRule.Name.IsNull() {
+				rule.Name = types.StringNull()
+			}
+			if knownRule.Description.IsNull() {
+				rule.Description = types.StringNull()
+			}
+		}
+		rules = append(rules, rule)
+		ruleUUIDs[rule.Prefix.ValueString()] = types.StringValue(routeFilterRule.GetUuid())
+	}
+
+	m.Rules, d = types.SetValueFrom(ctx, ruleObjectType(ctx), rules)
+	diags.Append(d...)
+	m.RuleUUIDs, d = types.MapValue(types.StringType, ruleUUIDs)
+	diags.Append(d...)
+
+	return diags
+}
+
+func ruleObjectType(ctx context.Context) types.ObjectType {
+	return types.ObjectType{AttrTypes: fwtypes.AttributeTypesMust[RuleModel](ctx)}
+}
+
+func newRuleModel(routeFilterRule fabricv4.RouteFilterRulesData) RuleModel {
+	rule := RuleModel{
+		Prefix:      types.StringValue(routeFilterRule.GetPrefix()),
+		PrefixMatch: types.StringValue(routeFilterRule.GetPrefixMatch()),
+		Name:        types.StringNull(),
+		Description: types.StringNull(),
+	}
+	if routeFilterRule.GetPrefixMatch() == "" {
+		rule.PrefixMatch = types.StringValue(prefixMatchOrLonger)
+	}
+	if name := routeFilterRule.GetName(); name != "" {
+		rule.Name = types.StringValue(name)
+	}
+	if description := routeFilterRule.GetDescription(); description != "" {
+		rule.Description = types.StringValue(description)
+	}
+	return rule
+}
+
+// activeRules drops the Rules that are being, or have been, removed from the
+// Route Filter
+func activeRules(routeFilterRules []fabricv4.RouteFilterRulesData) []fabricv4.RouteFilterRulesData {
+	active := make([]fabricv4.RouteFilterRulesData, 0, len(routeFilterRules))
+	for _, routeFilterRule := range routeFilterRules {
+		switch routeFilterRule.GetState() {
+		case fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONING, fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED:
+			continue
+		}
+		active = append(active, routeFilterRule)
+	}
+	return active
+}
+
+type rulePatch struct {
+	uuid       string
+	operations []fabricv4.RouteFilterRulesPatchRequestItem
+}
+
+// ruleChanges are the API calls that turn the existing Rules of a Route
+// Filter into the desired ones
+type ruleChanges struct {
+	removals  []string
+	patches   []rulePatch
+	additions []fabricv4.RouteFilterRulesBase
+}
+
+func (c ruleChanges) isEmpty() bool {
+	return len(c.removals) == 0 && len(c.patches) == 0 && len(c.additions) == 0
+}
+
+// computeRuleChanges diffs the active Rules of a Route Filter against the
+// desired set by prefix. Names and prefix matches are patched in place, while
+// Rules with a new description are removed and added back since descriptions
+// can't be patched
+func computeRuleChanges(existing []fabricv4.RouteFilterRulesData, desired []RuleModel) ruleChanges {
+	changes := ruleChanges{}
+
+	existingRules := make(map[string]fabricv4.RouteFilterRulesData, len(existing))
+	for _, routeFilterRule := range existing {
+		existingRules[routeFilterRule.GetPrefix()] = routeFilterRule
+	}
+	desiredRules := make(map[string]RuleModel, len(desired))
+	for _, rule := range desired {
+		desiredRules[rule.Prefix.ValueString()] = rule
+	}
+
+	for _, routeFilterRule := range existing {
+		if _, ok := desiredRules[routeFilterRule.GetPrefix()]; !ok {
+			changes.removals = append(changes.removals, routeFilterRule.GetUuid())
+		}
+	}
+
+	prefixes := make([]string, 0, len(desiredRules))
+	for prefix := range desiredRules {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		rule := desiredRules[prefix]
+		routeFilterRule, ok := existingRules[prefix]
+		if !ok {
+			changes.additions = append(changes.additions, rule.toRouteFilterRulesBase())
+			continue
+		}
+
+		if !rule.Description.IsNull() && rule.Description.ValueString() != routeFilterRule.GetDescription() {
+			changes.removals = append(changes.removals, routeFilterRule.GetUuid())
+			changes.additions = append(changes.additions, rule.toRouteFilterRulesBase())
+			continue
+		}
+
+		var operations []fabricv4.RouteFilterRulesPatchRequestItem
+		if !rule.Name.IsNull() && rule.Name.ValueString() != routeFilterRule.GetName() {
+			operations = append(operations, fabricv4.RouteFilterRulesPatchRequestItem{
+				Op:    "replace",
+				Path:  "/name",
+				Value: rule.Name.ValueString(),
+			})
+		}
+		if prefixMatch := rule.prefixMatch(); prefixMatch != newRuleModel(routeFilterRule).PrefixMatch.ValueString() {
+			operations = append(operations, fabricv4.RouteFilterRulesPatchRequestItem{
+				Op:    "replace",
+				Path:  "/prefixMatch",
+				Value: prefixMatch,
+			})
+		}
+		if len(operations) > 0 {
+			changes.patches = append(changes.patches, rulePatch{
+				uuid:       routeFilterRule.GetUuid(),
+				operations: operations,
+			})
+		}
+	}
+
+	return changes
+}
+
+func (m RuleModel) prefixMatch() string {
+	if m.PrefixMatch.IsNull() || m.PrefixMatch.IsUnknown() || m.PrefixMatch.ValueString() == "" {
+		return prefixMatchOrLonger
+	}
+	return m.PrefixMatch.ValueString()
+}
+
+func (m RuleModel) toRouteFilterRulesBase() fabricv4.RouteFilterRulesBase {
+	routeFilterRule := fabricv4.RouteFilterRulesBase{}
+	routeFilterRule.SetPrefix(m.Prefix.ValueString())
+	routeFilterRule.SetPrefixMatch(m.prefixMatch())
+	if !m.Name.IsNull() && m.Name.ValueString() != "" {
+		routeFilterRule.SetName(m.Name.ValueString())
+	}
+	if !m.Description.IsNull() && m.Description.ValueString() != "" {
+		routeFilterRule.SetDescription(m.Description.ValueString())
+	}
+	return routeFilterRule
+}
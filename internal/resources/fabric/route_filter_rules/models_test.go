@@ -0,0 +1,146 @@
+package route_filter_rules
+
+import (
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRouteFilterRule(uuid, prefix, prefixMatch, name, description string, state fabricv4.RouteFilterRuleState) fabricv4.RouteFilterRulesData {
+	routeFilterRule := fabricv4.RouteFilterRulesData{}
+	routeFilterRule.SetUuid(uuid)
+	routeFilterRule.SetPrefix(prefix)
+	routeFilterRule.SetPrefixMatch(prefixMatch)
+	routeFilterRule.SetName(name)
+	routeFilterRule.SetDescription(description)
+	routeFilterRule.SetState(state)
+	return routeFilterRule
+}
+
+func testRule(prefix, prefixMatch, name, description string) RuleModel {
+	rule := RuleModel{
+		Prefix:      types.StringValue(prefix),
+		PrefixMatch: types.StringValue(prefixMatch),
+		Name:        types.StringNull(),
+		Description: types.StringNull(),
+	}
+	if name != "" {
+		rule.Name = types.StringValue(name)
+	}
+	if description != "" {
+		rule.Description = types.StringValue(description)
+	}
+	return rule
+}
+
+func TestComputeRuleChanges(t *testing.T) {
+	existing := []fabricv4.RouteFilterRulesData{
+		testRouteFilterRule("keep", "10.0.0.0/24", prefixMatchOrLonger, "keep", "same", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+		testRouteFilterRule("remove", "10.0.1.0/24", prefixMatchOrLonger, "remove", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+		testRouteFilterRule("patch", "10.0.2.0/24", prefixMatchOrLonger, "old", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+		testRouteFilterRule("replace", "10.0.3.0/24", prefixMatchExact, "replace", "old", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+	}
+	desired := []RuleModel{
+		testRule("10.0.0.0/24", prefixMatchOrLonger, "", ""),
+		testRule("10.0.2.0/24", prefixMatchExact, "new", ""),
+		testRule("10.0.3.0/24", prefixMatchExact, "replace", "new"),
+		testRule("10.0.4.0/24", prefixMatchOrLonger, "add", ""),
+	}
+
+	changes := computeRuleChanges(existing, desired)
+
+	if len(changes.removals) != 2 || changes.removals[0] != "remove" || changes.removals[1] != "replace" {
+		t.Errorf("expected removals [remove replace], got %v", changes.removals)
+	}
+	if len(changes.patches) != 1 || changes.patches[0].uuid != "patch" {
+		t.Fatalf("expected a single patch of the patch rule, got %+v", changes.patches)
+	}
+	if operations := changes.patches[0].operations; len(operations) != 2 ||
+		operations[0].Path != "/name" || operations[0].Value != "new" ||
+		operations[1].Path != "/prefixMatch" || operations[1].Value != prefixMatchExact {
+		t.Errorf("unexpected patch operations %+v", operations)
+	}
+	if len(changes.additions) != 2 ||
+		changes.additions[0].GetPrefix() != "10.0.3.0/24" || changes.additions[0].GetDescription() != "new" ||
+		changes.additions[1].GetPrefix() != "10.0.4.0/24" || changes.additions[1].GetName() != "add" {
+		t.Errorf("unexpected additions %+v", changes.additions)
+	}
+
+	if changes := computeRuleChanges(existing[:1], desired[:1]); !changes.isEmpty() {
+		t.Errorf("expected no changes for matching rules, got %+v", changes)
+	}
+	if changes := computeRuleChanges(existing, nil); len(changes.removals) != len(existing) {
+		t.Errorf("expected every rule to be removed, got %+v", changes)
+	}
+}
+
+func TestActiveRules(t *testing.T) {
+	routeFilterRules := []fabricv4.RouteFilterRulesData{
+		testRouteFilterRule("provisioned", "10.0.0.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+		testRouteFilterRule("deprovisioning", "10.0.1.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONING),
+		testRouteFilterRule("deprovisioned", "10.0.2.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED),
+		testRouteFilterRule("provisioning", "10.0.3.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONING),
+	}
+
+	active := activeRules(routeFilterRules)
+	if len(active) != 2 || active[0].GetUuid() != "provisioned" || active[1].GetUuid() != "provisioning" {
+		t.Errorf("expected the provisioned and provisioning rules, got %+v", active)
+	}
+}
+
+func TestBatchStates(t *testing.T) {
+	tests := map[string]struct {
+		routeFilterRules []fabricv4.RouteFilterRulesData
+		ruleIDs          []string
+		wantProvision    fabricv4.RouteFilterRuleState
+		wantDelete       fabricv4.RouteFilterRuleState
+	}{
+		"all provisioned": {
+			routeFilterRules: []fabricv4.RouteFilterRulesData{
+				testRouteFilterRule("a", "10.0.0.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+				testRouteFilterRule("b", "10.0.1.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+			},
+			ruleIDs:       []string{"a", "b"},
+			wantProvision: fabricv4.ROUTEFILTERRULESTATE_PROVISIONED,
+			wantDelete:    fabricv4.ROUTEFILTERRULESTATE_PROVISIONED,
+		},
+		"one still provisioning": {
+			routeFilterRules: []fabricv4.RouteFilterRulesData{
+				testRouteFilterRule("a", "10.0.0.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+				testRouteFilterRule("b", "10.0.1.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONING),
+			},
+			ruleIDs:       []string{"a", "b"},
+			wantProvision: fabricv4.ROUTEFILTERRULESTATE_PROVISIONING,
+			wantDelete:    fabricv4.ROUTEFILTERRULESTATE_PROVISIONED,
+		},
+		"rules not listed yet or already gone": {
+			routeFilterRules: []fabricv4.RouteFilterRulesData{
+				testRouteFilterRule("a", "10.0.0.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED),
+			},
+			ruleIDs:       []string{"a", "b"},
+			wantProvision: fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED,
+			wantDelete:    fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED,
+		},
+		"other rules are ignored": {
+			routeFilterRules: []fabricv4.RouteFilterRulesData{
+				testRouteFilterRule("a", "10.0.0.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONING),
+				testRouteFilterRule("b", "10.0.1.0/24", prefixMatchOrLonger, "", "", fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+			},
+			ruleIDs:       []string{"b"},
+			wantProvision: fabricv4.ROUTEFILTERRULESTATE_PROVISIONED,
+			wantDelete:    fabricv4.ROUTEFILTERRULESTATE_PROVISIONED,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := provisionState(tc.routeFilterRules, tc.ruleIDs); got != string(tc.wantProvision) {
+				t.Errorf("expected provision state %s, got %s", tc.wantProvision, got)
+			}
+			if got := deleteState(tc.routeFilterRules, tc.ruleIDs); got != string(tc.wantDelete) {
+				t.Errorf("expected delete state %s, got %s", tc.wantDelete, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,421 @@
+package route_filter_rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_route_filter_rules",
+			},
+		),
+	}
+}
+
+type Resource struct {
+	framework.BaseResource
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := config.rules(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefixes := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if rule.Prefix.IsUnknown() {
+			continue
+		}
+		prefix := rule.Prefix.ValueString()
+		if prefixes[prefix] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Duplicate Route Filter Rule Prefix",
+				fmt.Sprintf("prefix %s is used by more than one rule; each prefix can only have one rule", prefix),
+			)
+		}
+		prefixes[prefix] = true
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_filter_id"), req.ID)...)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	// Rules that already exist on the Route Filter are adopted rather than
+	// created again, and the ones missing from the plan are removed
+	routeFilterRules, diags := applyPlan(ctx, r.Meta, client, plan, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RouteFilterID
+	resp.Diagnostics.Append(plan.parse(ctx, routeFilterRules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	routeFilterID := state.RouteFilterID.ValueString()
+	if routeFilterID == "" {
+		routeFilterID = state.ID.ValueString()
+	}
+
+	routeFilterRules, err := listRouteFilterRules(ctx, client, routeFilterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Route Filter Rules of Route Filter %s", routeFilterID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, activeRules(routeFilterRules))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	routeFilterRules, diags := applyPlan(ctx, r.Meta, client, plan, updateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, routeFilterRules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+	routeFilterID := state.RouteFilterID.ValueString()
+
+	routeFilterRules, err := listRouteFilterRules(ctx, client, routeFilterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Route Filter Rules of Route Filter %s", routeFilterID), err.Error())
+		return
+	}
+
+	changes := computeRuleChanges(activeRules(routeFilterRules), nil)
+	if err = applyRuleChanges(ctx, r.Meta, client, routeFilterID, changes, state.batchSize(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed deleting Route Filter Rules of Route Filter %s", routeFilterID), err.Error())
+	}
+}
+
+// applyPlan brings the Rules of the Route Filter in line with the plan and returns
+// the resulting active Rules
+func applyPlan(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, plan ResourceModel, timeout time.Duration) ([]fabricv4.RouteFilterRulesData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	routeFilterID := plan.RouteFilterID.ValueString()
+
+	desired, d := plan.rules(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	routeFilterRules, err := listRouteFilterRules(ctx, client, routeFilterID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed retrieving Route Filter Rules of Route Filter %s", routeFilterID), err.Error())
+		return nil, diags
+	}
+
+	changes := computeRuleChanges(activeRules(routeFilterRules), desired)
+	if changes.isEmpty() {
+		return activeRules(routeFilterRules), diags
+	}
+
+	if err = applyRuleChanges(ctx, meta, client, routeFilterID, changes, plan.batchSize(), timeout); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed updating Route Filter Rules of Route Filter %s", routeFilterID), err.Error())
+		return nil, diags
+	}
+
+	routeFilterRules, err = listRouteFilterRules(ctx, client, routeFilterID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed retrieving Route Filter Rules of Route Filter %s", routeFilterID), err.Error())
+		return nil, diags
+	}
+	return activeRules(routeFilterRules), diags
+}
+
+// applyRuleChanges removes, patches and then adds Rules in batches of at most
+// batchSize, waiting once for every batch to settle
+func applyRuleChanges(ctx context.Context, meta *config.Config, client *fabricv4.APIClient, routeFilterID string, changes ruleChanges, batchSize int, timeout time.Duration) error {
+	for batch := range slices.Chunk(changes.removals, batchSize) {
+		for _, ruleID := range batch {
+			_, _, err := client.RouteFilterRulesApi.DeleteRouteFilterRuleByUuid(ctx, routeFilterID, ruleID).Execute()
+			if err != nil {
+				if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
+					if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
+						// EQ-3142509 = Route Filter Rule already deleted
+						if equinix_errors.HasErrorCode(fabricErrs, "EQ-3142509") {
+							continue
+						}
+					}
+				}
+				return fmt.Errorf("error removing Route Filter Rule %s: %w", ruleID, equinix_errors.FormatFabricError(err))
+			}
+		}
+		if _, err := meta.WaitForStateContext(ctx, getBatchDeleteWaiter(ctx, client, routeFilterID, batch, timeout)); err != nil {
+			return fmt.Errorf("error waiting for Route Filter Rules to be removed: %w", err)
+		}
+	}
+
+	for batch := range slices.Chunk(changes.patches, batchSize) {
+		ruleIDs := make([]string, 0, len(batch))
+		for _, patch := range batch {
+			_, _, err := client.RouteFilterRulesApi.PatchRouteFilterRuleByUuid(ctx, routeFilterID, patch.uuid).RouteFilterRulesPatchRequestItem(patch.operations).Execute()
+			if err != nil {
+				return fmt.Errorf("error updating Route Filter Rule %s: %w", patch.uuid, equinix_errors.FormatFabricError(err))
+			}
+			ruleIDs = append(ruleIDs, patch.uuid)
+		}
+		if _, err := meta.WaitForStateContext(ctx, getBatchProvisionWaiter(ctx, client, routeFilterID, ruleIDs, timeout)); err != nil {
+			return fmt.Errorf("error waiting for Route Filter Rules to be updated: %w", err)
+		}
+	}
+
+	for batch := range slices.Chunk(changes.additions, batchSize) {
+		bulkRequest := fabricv4.RouteFilterRulesPostRequest{}
+		bulkRequest.SetData(batch)
+		created, _, err := client.RouteFilterRulesApi.CreateRouteFilterRulesInBulk(ctx, routeFilterID).RouteFilterRulesPostRequest(bulkRequest).Execute()
+		if err != nil {
+			return fmt.Errorf("error adding Route Filter Rules: %w", equinix_errors.FormatFabricError(err))
+		}
+		ruleIDs := make([]string, 0, len(created.GetData()))
+		for _, routeFilterRule := range created.GetData() {
+			ruleIDs = append(ruleIDs, routeFilterRule.GetUuid())
+		}
+		if _, err = meta.WaitForStateContext(ctx, getBatchProvisionWaiter(ctx, client, routeFilterID, ruleIDs, timeout)); err != nil {
+			return fmt.Errorf("error waiting for Route Filter Rules to be added: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func listRouteFilterRules(ctx context.Context, client *fabricv4.APIClient, routeFilterID string) ([]fabricv4.RouteFilterRulesData, error) {
+	var routeFilterRules []fabricv4.RouteFilterRulesData
+	limit := int32(100)
+	for offset := int32(0); ; offset += limit {
+		response, _, err := client.RouteFilterRulesApi.GetRouteFilterRules(ctx, routeFilterID).Offset(offset).Limit(limit).Execute()
+		if err != nil {
+			return nil, equinix_errors.FormatFabricError(err)
+		}
+		routeFilterRules = append(routeFilterRules, response.GetData()...)
+
+		pagination := response.GetPagination()
+		if len(response.GetData()) == 0 || offset+limit >= pagination.GetTotal() {
+			return routeFilterRules, nil
+		}
+	}
+}
+
+// provisionState is PROVISIONED once all the given Rules are provisioned
+func provisionState(routeFilterRules []fabricv4.RouteFilterRulesData, ruleIDs []string) string {
+	states := make(map[string]fabricv4.RouteFilterRuleState, len(routeFilterRules))
+	for _, routeFilterRule := range routeFilterRules {
+		states[routeFilterRule.GetUuid()] = routeFilterRule.GetState()
+	}
+
+	for _, ruleID := range ruleIDs {
+		state, ok := states[ruleID]
+		if !ok {
+			return string(fabricv4.ROUTEFILTERRULESTATE_PROVISIONING)
+		}
+		if state != fabricv4.ROUTEFILTERRULESTATE_PROVISIONED {
+			return string(state)
+		}
+	}
+	return string(fabricv4.ROUTEFILTERRULESTATE_PROVISIONED)
+}
+
+// deleteState is DEPROVISIONED once none of the given Rules is left on the
+// Route Filter
+func deleteState(routeFilterRules []fabricv4.RouteFilterRulesData, ruleIDs []string) string {
+	for _, routeFilterRule := range routeFilterRules {
+		if !slices.Contains(ruleIDs, routeFilterRule.GetUuid()) {
+			continue
+		}
+		if state := routeFilterRule.GetState(); state != fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED {
+			return string(state)
+		}
+	}
+	return string(fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED)
+}
+
+func getBatchProvisionWaiter(ctx context.Context, client *fabricv4.APIClient, routeFilterID string, ruleIDs []string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.ROUTEFILTERRULESTATE_PROVISIONING),
+			string(fabricv4.ROUTEFILTERRULESTATE_REPROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			routeFilterRules, err := listRouteFilterRules(ctx, client, routeFilterID)
+			if err != nil {
+				return nil, "", err
+			}
+			return routeFilterRules, provisionState(routeFilterRules, ruleIDs), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
+
+func getBatchDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, routeFilterID string, ruleIDs []string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.ROUTEFILTERRULESTATE_PROVISIONED),
+			string(fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			routeFilterRules, err := listRouteFilterRules(ctx, client, routeFilterID)
+			if err != nil {
+				return nil, "", err
+			}
+			return routeFilterRules, deleteState(routeFilterRules, ruleIDs), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
@@ -0,0 +1,82 @@
+package route_filter_rules
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// routeFilterRuleState holds the attributes of an equinix_fabric_route_filter_rule
+// that carry over to the plural resource
+type routeFilterRuleState struct {
+	ID            string `json:"id"`
+	RouteFilterID string `json:"route_filter_id"`
+	Prefix        string `json:"prefix"`
+	PrefixMatch   string `json:"prefix_match"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+}
+
+// MoveState supports `moved` blocks from an equinix_fabric_route_filter_rule.
+// The moved rule becomes the only known rule, and the other Rules of the Route
+// Filter are picked up by the next refresh
+func (r *Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "equinix_fabric_route_filter_rule" ||
+					!strings.HasSuffix(req.SourceProviderAddress, "equinix/equinix") {
+					return
+				}
+				if req.SourceRawState == nil {
+					resp.Diagnostics.AddError("Unable to Move Route Filter Rule", "source state is empty")
+					return
+				}
+
+				var source routeFilterRuleState
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError("Unable to Move Route Filter Rule", err.Error())
+					return
+				}
+
+				rule := RuleModel{
+					Prefix:      types.StringValue(source.Prefix),
+					PrefixMatch: types.StringValue(source.PrefixMatch),
+					Name:        types.StringNull(),
+					Description: types.StringNull(),
+				}
+				if source.PrefixMatch == "" {
+					rule.PrefixMatch = types.StringValue(prefixMatchOrLonger)
+				}
+				if source.Name != "" {
+					rule.Name = types.StringValue(source.Name)
+				}
+				if source.Description != "" {
+					rule.Description = types.StringValue(source.Description)
+				}
+
+				rules, diags := types.SetValueFrom(ctx, ruleObjectType(ctx), []RuleModel{rule})
+				resp.Diagnostics.Append(diags...)
+				ruleUUIDs, diags := types.MapValue(types.StringType, map[string]attr.Value{
+					source.Prefix: types.StringValue(source.ID),
+				})
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Timeouts are left unset
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), source.RouteFilterID)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("route_filter_id"), source.RouteFilterID)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("rules"), rules)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("rule_uuids"), ruleUUIDs)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("batch_size"), int64(defaultBatchSize))...)
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package route_filter_rules
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows management of the full set of Rules of an Equinix Fabric Route Filter.
+Rules are added and removed in batches through the bulk Route Filter Rules API, and any Rule of the Route Filter
+that is not part of the configuration is removed, including Rules that already exist on the Route Filter when the
+resource is created. One equinix_fabric_route_filter_rule resource can be migrated into this resource with a moved
+block; the other equinix_fabric_route_filter_rule resources of the Route Filter must be dropped from the state with
+removed blocks that set lifecycle.destroy to false, otherwise destroying them deletes Rules this resource manages.
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/FCR-route-filters.htm
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#route-filter-rules`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"route_filter_id": schema.StringAttribute{
+				Description: "UUID of the Route Filter Policy to apply the Rules to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.SetNestedAttribute{
+				Description: "Complete set of Rules of the Route Filter. Rules are identified by their prefix",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Description: "IP Address Prefix to Filter on",
+							Required:    true,
+						},
+						"prefix_match": schema.StringAttribute{
+							Description: "Prefix matching operator. One of [ orlonger, exact ] Default: \"orlonger\"",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(prefixMatchOrLonger),
+							Validators: []validator.String{
+								stringvalidator.OneOf(prefixMatchOrLonger, prefixMatchExact),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the Route Filter Rule",
+							Optional:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the Route Filter Rule. Changing it removes the Rule and adds it back",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"rule_uuids": schema.MapAttribute{
+				Description: "Equinix-assigned Route Filter Rule identifiers, keyed by prefix",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Maximum number of Rules added, updated or removed before waiting for the Route Filter Rules to settle. Default: 50",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultBatchSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package route_filter_rules_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFabricRouteFilterRules_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckRouteFilterRulesDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricRouteFilterRulesConfig(`
+					{
+						prefix       = "192.168.0.0/24"
+						prefix_match = "exact"
+						name         = "RF_Rule_A_PFCR"
+						description  = "Route Filter Rule for X Purpose"
+					},
+					{
+						prefix = "192.168.1.0/24"
+					},
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("equinix_fabric_route_filter_rules.test", "id", "equinix_fabric_route_filter.test", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_route_filter_rules.test", "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("equinix_fabric_route_filter_rules.test", "rules.*", map[string]string{
+						"prefix":       "192.168.0.0/24",
+						"prefix_match": "exact",
+						"name":         "RF_Rule_A_PFCR",
+						"description":  "Route Filter Rule for X Purpose",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("equinix_fabric_route_filter_rules.test", "rules.*", map[string]string{
+						"prefix":       "192.168.1.0/24",
+						"prefix_match": "orlonger",
+					}),
+					resource.TestCheckResourceAttrSet("equinix_fabric_route_filter_rules.test", "rule_uuids.192.168.0.0/24"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_route_filter_rules.test", "rule_uuids.192.168.1.0/24"),
+					resource.TestCheckResourceAttr("equinix_fabric_route_filter_rules.test", "batch_size", "50"),
+				),
+			},
+			{
+				Config: testAccFabricRouteFilterRulesConfig(`
+					{
+						prefix       = "192.168.0.0/24"
+						prefix_match = "orlonger"
+						name         = "RF_Rule_B_PFCR"
+						description  = "Route Filter Rule for Y Purpose"
+					},
+					{
+						prefix = "192.172.0.0/24"
+					},
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("equinix_fabric_route_filter_rules.test", "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("equinix_fabric_route_filter_rules.test", "rules.*", map[string]string{
+						"prefix":       "192.168.0.0/24",
+						"prefix_match": "orlonger",
+						"name":         "RF_Rule_B_PFCR",
+						"description":  "Route Filter Rule for Y Purpose",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("equinix_fabric_route_filter_rules.test", "rules.*", map[string]string{
+						"prefix": "192.172.0.0/24",
+					}),
+					resource.TestCheckNoResourceAttr("equinix_fabric_route_filter_rules.test", "rule_uuids.192.168.1.0/24"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_route_filter_rules.test", "rule_uuids.192.172.0.0/24"),
+				),
+			},
+			{
+				ResourceName:            "equinix_fabric_route_filter_rules.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts", "rules"},
+			},
+		},
+	})
+}
+
+func TestAccFabricRouteFilterRules_Moved_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckRouteFilterRulesDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricRouteFilterConfig() + `
+					resource "equinix_fabric_route_filter_rule" "test" {
+						route_filter_id = equinix_fabric_route_filter.test.id
+						name            = "RF_Rule_Moved_PFCR"
+						prefix          = "192.168.0.0/24"
+						prefix_match    = "exact"
+						description     = "Route Filter Rule for X Purpose"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("equinix_fabric_route_filter_rule.test", "id"),
+				),
+			},
+			{
+				Config: testAccFabricRouteFilterRulesConfig(`
+					{
+						prefix       = "192.168.0.0/24"
+						prefix_match = "exact"
+						name         = "RF_Rule_Moved_PFCR"
+						description  = "Route Filter Rule for X Purpose"
+					},
+				`) + `
+					moved {
+						from = equinix_fabric_route_filter_rule.test
+						to   = equinix_fabric_route_filter_rules.test
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("equinix_fabric_route_filter_rules.test", "id", "equinix_fabric_route_filter.test", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_route_filter_rules.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_route_filter_rules.test", "rule_uuids.192.168.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricRouteFilterConfig() string {
+	return `
+		resource "equinix_fabric_route_filter" "test" {
+			name = "rf_rules_test_PFCR"
+			project {
+				project_id = "291639000636552"
+			}
+			type = "BGP_IPv4_PREFIX_FILTER"
+			description = "Route Filter Policy for X Purpose"
+		}
+	`
+}
+
+func testAccFabricRouteFilterRulesConfig(rules string) string {
+	return testAccFabricRouteFilterConfig() + fmt.Sprintf(`
+		resource "equinix_fabric_route_filter_rules" "test" {
+			route_filter_id = equinix_fabric_route_filter.test.id
+			rules = [
+				%s
+			]
+		}
+	`, rules)
+}
+
+func CheckRouteFilterRulesDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewFabricClientForTesting(ctx)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_route_filter_rules" {
+			continue
+		}
+
+		routeFilterRules, _, err := client.RouteFilterRulesApi.GetRouteFilterRules(ctx, rs.Primary.ID).Execute()
+		if err != nil {
+			continue
+		}
+		for _, routeFilterRule := range routeFilterRules.GetData() {
+			if routeFilterRule.GetState() != fabricv4.ROUTEFILTERRULESTATE_DEPROVISIONED {
+				return fmt.Errorf("route filter rule %s still exists on route filter %s", routeFilterRule.GetUuid(), rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
@@ -67,7 +67,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, routeAggregation.GetUuid(), createTimeout)
-	routeAggregationChecked, err := createWaiter.WaitForStateContext(ctx)
+	routeAggregationChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed creating Route Aggregation %s", routeAggregation.GetUuid()), err.Error())
@@ -163,7 +163,7 @@ func (r *Resource) Update(
 	}
 
 	updateWaiter := getCreateUpdateWaiter(ctx, client, id, updateTimeout)
-	routeAggregationChecked, err := updateWaiter.WaitForStateContext(ctx)
+	routeAggregationChecked, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed updating Route Aggregation %s", id), err.Error())
 		return
@@ -210,7 +210,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deletewaiter := getDeleteWaiter(ctx, client, id, deleteTimeout)
-	_, err = deletewaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deletewaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed deleting Route Aggregation %s", id), err.Error())
@@ -252,9 +252,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, id s
 			}
 			return routeAggregation, string(routeAggregation.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 }
 
@@ -281,8 +280,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, id string,
 			}
 			return routeAggregation, string(routeAggregation.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
@@ -68,7 +68,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, routeAggregationID, routeAggregationRule.GetUuid(), createTimeout)
-	routeAggregationRuleChecked, err := createWaiter.WaitForStateContext(ctx)
+	routeAggregationRuleChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed creating Route Aggregation Rule %s", routeAggregationRule.GetUuid()), err.Error())
 		return
@@ -159,7 +159,7 @@ func (r *Resource) Update(
 	}
 
 	updateWaiter := getCreateUpdateWaiter(ctx, client, routeAggregationID, id, updateTimeout)
-	routeAggregationRuleChecked, err := updateWaiter.WaitForStateContext(ctx)
+	routeAggregationRuleChecked, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed updating Route Aggregation Rule%s", id), err.Error())
 		return
@@ -206,7 +206,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deletewaiter := getDeleteWaiter(ctx, client, routeAggregationID, id, deleteTimeout)
-	_, err = deletewaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deletewaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed deleting Route Aggregation Rule %s", id), err.Error())
@@ -240,9 +240,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, rout
 			}
 			return routeAggregationRule, string(routeAggregationRule.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 }
 
@@ -274,8 +273,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, routeAggre
 			}
 			return routeAggregationRule, string(routeAggregationRule.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
@@ -0,0 +1,99 @@
+package routing_protocol
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+const importIDFormat = "<connection_uuid>/<routing_protocol_uuid> or <connection_uuid>/<BGP|DIRECT>"
+
+// importID holds the parts of a routing protocol import identifier. Exactly
+// one of RoutingProtocolID and Type is set.
+type importID struct {
+	ConnectionID      string
+	RoutingProtocolID string
+	Type              string
+}
+
+// parseImportID parses the import identifier of a routing protocol, either
+// <connection_uuid>/<routing_protocol_uuid> or <connection_uuid>/<type> where
+// type is BGP or DIRECT
+func parseImportID(id string) (importID, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return importID{}, fmt.Errorf("unexpected format of ID (%s), expected %s", id, importIDFormat)
+	}
+
+	parsed := importID{ConnectionID: parts[0]}
+	switch type_ := strings.ToUpper(parts[1]); type_ {
+	case typeBGP, typeDirect:
+		parsed.Type = type_
+	default:
+		parsed.RoutingProtocolID = parts[1]
+	}
+	return parsed, nil
+}
+
+// findRoutingProtocolByType returns the uuid of the single routing protocol of
+// the given type on the connection
+func findRoutingProtocolByType(ctx context.Context, client *fabricv4.APIClient, connectionID, type_ string) (string, error) {
+	var routingProtocols []fabricv4.RoutingProtocolData
+	limit := int32(20)
+	for offset := int32(0); ; offset += limit {
+		response, _, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocols(ctx, connectionID).Offset(offset).Limit(limit).Execute()
+		if err != nil {
+			return "", equinix_errors.FormatFabricError(err)
+		}
+		routingProtocols = append(routingProtocols, response.GetData()...)
+
+		pagination := response.GetPagination()
+		if len(response.GetData()) == 0 || offset+limit >= pagination.GetTotal() {
+			break
+		}
+	}
+
+	return selectRoutingProtocolByType(routingProtocols, connectionID, type_)
+}
+
+// selectRoutingProtocolByType picks the uuid of the only routing protocol of
+// the given type, ignoring the ones that have already been deprovisioned
+func selectRoutingProtocolByType(routingProtocols []fabricv4.RoutingProtocolData, connectionID, type_ string) (string, error) {
+	var matches []string
+	for i := range routingProtocols {
+		routingProtocol := &routingProtocols[i]
+		if routingProtocolDataType(routingProtocol) != type_ {
+			continue
+		}
+		state := routingProtocolState(routingProtocol)
+		if state == string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_DEPROVISIONING) ||
+			state == string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_DEPROVISIONED) {
+			continue
+		}
+		matches = append(matches, routingProtocolUUID(routingProtocol))
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s routing protocol found on connection %s", type_, connectionID)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found %d %s routing protocols on connection %s (%s); import one of them by uuid instead",
+			len(matches), type_, connectionID, strings.Join(matches, ", "))
+	}
+}
+
+func routingProtocolDataType(routingProtocol *fabricv4.RoutingProtocolData) string {
+	switch routingProtocol.GetActualInstance().(type) {
+	case *fabricv4.RoutingProtocolBGPData:
+		return typeBGP
+	case *fabricv4.RoutingProtocolDirectData:
+		return typeDirect
+	}
+	return ""
+}
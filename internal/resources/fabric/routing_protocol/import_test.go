@@ -0,0 +1,156 @@
+package routing_protocol
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		want    importID
+		wantErr bool
+	}{
+		"routing protocol uuid": {
+			id:   "conn-uuid/rp-uuid",
+			want: importID{ConnectionID: "conn-uuid", RoutingProtocolID: "rp-uuid"},
+		},
+		"bgp type": {
+			id:   "conn-uuid/BGP",
+			want: importID{ConnectionID: "conn-uuid", Type: "BGP"},
+		},
+		"lower case direct type": {
+			id:   "conn-uuid/direct",
+			want: importID{ConnectionID: "conn-uuid", Type: "DIRECT"},
+		},
+		"missing separator":        {id: "conn-uuid", wantErr: true},
+		"missing connection uuid":  {id: "/rp-uuid", wantErr: true},
+		"missing routing protocol": {id: "conn-uuid/", wantErr: true},
+		"too many parts":           {id: "conn-uuid/rp-uuid/extra", wantErr: true},
+		"empty identifier":         {id: "", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseImportID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func testRoutingProtocol(uuid, type_ string, state fabricv4.RoutingProtocolBGPDataState) fabricv4.RoutingProtocolData {
+	if type_ == typeBGP {
+		return fabricv4.RoutingProtocolBGPDataAsRoutingProtocolData(&fabricv4.RoutingProtocolBGPData{
+			Uuid:  &uuid,
+			State: &state,
+		})
+	}
+	return fabricv4.RoutingProtocolDirectDataAsRoutingProtocolData(&fabricv4.RoutingProtocolDirectData{
+		Uuid:  &uuid,
+		State: &state,
+	})
+}
+
+func TestSelectRoutingProtocolByType(t *testing.T) {
+	routingProtocols := []fabricv4.RoutingProtocolData{
+		testRoutingProtocol("direct", typeDirect, fabricv4.ROUTINGPROTOCOLBGPDATASTATE_PROVISIONED),
+		testRoutingProtocol("bgp-old", typeBGP, fabricv4.ROUTINGPROTOCOLBGPDATASTATE_DEPROVISIONED),
+		testRoutingProtocol("bgp", typeBGP, fabricv4.ROUTINGPROTOCOLBGPDATASTATE_PROVISIONED),
+	}
+
+	got, err := selectRoutingProtocolByType(routingProtocols, "conn-uuid", typeBGP)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "bgp" {
+		t.Errorf("expected bgp, got %s", got)
+	}
+
+	got, err = selectRoutingProtocolByType(routingProtocols, "conn-uuid", typeDirect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "direct" {
+		t.Errorf("expected direct, got %s", got)
+	}
+
+	if _, err = selectRoutingProtocolByType(routingProtocols[:2], "conn-uuid", typeBGP); err == nil {
+		t.Error("expected an error when only deprovisioned protocols match")
+	}
+
+	routingProtocols = append(routingProtocols, testRoutingProtocol("bgp-2", typeBGP, fabricv4.ROUTINGPROTOCOLBGPDATASTATE_PROVISIONING))
+	_, err = selectRoutingProtocolByType(routingProtocols, "conn-uuid", typeBGP)
+	if err == nil || !strings.Contains(err.Error(), "bgp, bgp-2") {
+		t.Errorf("expected an error listing both BGP routing protocols, got %v", err)
+	}
+}
+
+func TestImportState_byType(t *testing.T) {
+	ctx := context.Background()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth2/v1/token":
+			fmt.Fprint(w, `{"access_token":"token","token_timeout":"3600"}`)
+		case "/fabric/v4/connections/conn-uuid/routingProtocols":
+			fmt.Fprint(w, `{"data":[{"type":"DIRECT","uuid":"direct-uuid","state":"PROVISIONED"},{"type":"BGP","uuid":"bgp-uuid","state":"PROVISIONED"}],"pagination":{"offset":0,"limit":20,"total":2}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(handler))
+	defer mockAPI.Close()
+
+	r := NewResource().(*Resource)
+	r.Meta = &config.Config{BaseURL: mockAPI.URL, ClientID: "id", ClientSecret: "secret"}
+
+	s := resourceSchema(ctx)
+	req := resource.ImportStateRequest{ID: "conn-uuid/BGP"}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var id, connectionID types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("connection_uuid"), &connectionID)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if id.ValueString() != "bgp-uuid" {
+		t.Errorf("expected id bgp-uuid, got %s", id)
+	}
+	if connectionID.ValueString() != "conn-uuid" {
+		t.Errorf("expected connection_uuid conn-uuid, got %s", connectionID)
+	}
+}
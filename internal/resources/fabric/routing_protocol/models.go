@@ -0,0 +1,531 @@
+package routing_protocol
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	typeBGP    = "BGP"
+	typeDirect = "DIRECT"
+)
+
+type ResourceModel struct {
+	ID                types.String                                        `tfsdk:"id"`
+	Timeouts          timeouts.Value                                      `tfsdk:"timeouts"`
+	ConnectionUUID    types.String                                        `tfsdk:"connection_uuid"`
+	Href              types.String                                        `tfsdk:"href"`
+	Type              types.String                                        `tfsdk:"type"`
+	UUID              types.String                                        `tfsdk:"uuid"`
+	Name              types.String                                        `tfsdk:"name"`
+	Description       types.String                                        `tfsdk:"description"`
+	State             types.String                                        `tfsdk:"state"`
+	CustomerAsn       types.Int64                                         `tfsdk:"customer_asn"`
+	EquinixAsn        types.Int64                                         `tfsdk:"equinix_asn"`
+	BgpAuthKey        types.String                                        `tfsdk:"bgp_auth_key"`
+	AsOverrideEnabled types.Bool                                          `tfsdk:"as_override_enabled"`
+	Operation         fwtypes.ListNestedObjectValueOf[OperationModel]     `tfsdk:"operation"`
+	Change            fwtypes.ListNestedObjectValueOf[ChangeModel]        `tfsdk:"change"`
+	ChangeLog         fwtypes.ListNestedObjectValueOf[ChangeLogModel]     `tfsdk:"change_log"`
+	DirectIpv4        fwtypes.ListNestedObjectValueOf[DirectIpv4Model]    `tfsdk:"direct_ipv4"`
+	DirectIpv6        fwtypes.ListNestedObjectValueOf[DirectIpv6Model]    `tfsdk:"direct_ipv6"`
+	BgpIpv4           fwtypes.ListNestedObjectValueOf[BgpConnectionModel] `tfsdk:"bgp_ipv4"`
+	BgpIpv6           fwtypes.ListNestedObjectValueOf[BgpConnectionModel] `tfsdk:"bgp_ipv6"`
+	Bfd               fwtypes.ListNestedObjectValueOf[BfdModel]           `tfsdk:"bfd"`
+}
+
+type DirectIpv4Model struct {
+	EquinixIfaceIP types.String `tfsdk:"equinix_iface_ip"`
+}
+
+type DirectIpv6Model struct {
+	EquinixIfaceIP types.String `tfsdk:"equinix_iface_ip"`
+}
+
+type BgpConnectionModel struct {
+	CustomerPeerIP         types.String `tfsdk:"customer_peer_ip"`
+	EquinixPeerIP          types.String `tfsdk:"equinix_peer_ip"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	OutboundAsPrependCount types.String `tfsdk:"outbound_as_prepend_count"`
+	InboundMed             types.Int64  `tfsdk:"inbound_med"`
+	OutboundMed            types.Int64  `tfsdk:"outbound_med"`
+}
+
+type BfdModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Interval types.String `tfsdk:"interval"`
+}
+
+type OperationModel struct {
+	Errors fwtypes.ListNestedObjectValueOf[ErrorModel] `tfsdk:"errors"`
+}
+
+type ErrorModel struct {
+	ErrorCode      types.String                                              `tfsdk:"error_code"`
+	ErrorMessage   types.String                                              `tfsdk:"error_message"`
+	CorrelationID  types.String                                              `tfsdk:"correlation_id"`
+	Details        types.String                                              `tfsdk:"details"`
+	Help           types.String                                              `tfsdk:"help"`
+	AdditionalInfo fwtypes.ListNestedObjectValueOf[ErrorAdditionalInfoModel] `tfsdk:"additional_info"`
+}
+
+type ErrorAdditionalInfoModel struct {
+	Property types.String `tfsdk:"property"`
+	Reason   types.String `tfsdk:"reason"`
+}
+
+type ChangeModel struct {
+	UUID types.String `tfsdk:"uuid"`
+	Type types.String `tfsdk:"type"`
+	Href types.String `tfsdk:"href"`
+}
+
+type ChangeLogModel struct {
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedByFullName types.String `tfsdk:"created_by_full_name"`
+	CreatedByEmail    types.String `tfsdk:"created_by_email"`
+	CreatedDateTime   types.String `tfsdk:"created_date_time"`
+	UpdatedBy         types.String `tfsdk:"updated_by"`
+	UpdatedByFullName types.String `tfsdk:"updated_by_full_name"`
+	UpdatedByEmail    types.String `tfsdk:"updated_by_email"`
+	UpdatedDateTime   types.String `tfsdk:"updated_date_time"`
+	DeletedBy         types.String `tfsdk:"deleted_by"`
+	DeletedByFullName types.String `tfsdk:"deleted_by_full_name"`
+	DeletedByEmail    types.String `tfsdk:"deleted_by_email"`
+	DeletedDateTime   types.String `tfsdk:"deleted_date_time"`
+}
+
+// routingProtocolType returns the configured type, falling back to the type
+// implied by the configured blocks when it is left to be computed
+func (m *ResourceModel) routingProtocolType() string {
+	if !m.Type.IsNull() && !m.Type.IsUnknown() {
+		return strings.ToUpper(m.Type.ValueString())
+	}
+	if len(m.BgpIpv4.Elements()) > 0 || len(m.BgpIpv6.Elements()) > 0 {
+		return typeBGP
+	}
+	return typeDirect
+}
+
+// validate returns an error for every block or attribute that does not apply
+// to the routing protocol type, or to the type implied by the configured
+// blocks when type is not set
+func (m *ResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.Type.IsUnknown() {
+		return diags
+	}
+
+	routingProtocolType := m.routingProtocolType()
+	invalid := func(attribute string) {
+		detail := attribute + " cannot be set on a " + routingProtocolType + " routing protocol"
+		if m.Type.IsNull() {
+			detail += "; the type is implied by the bgp_* blocks, set type to choose it explicitly"
+		}
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Routing Protocol Configuration",
+			detail,
+		)
+	}
+
+	switch routingProtocolType {
+	case typeBGP:
+		if len(m.DirectIpv4.Elements()) > 0 {
+			invalid("direct_ipv4")
+		}
+		if len(m.DirectIpv6.Elements()) > 0 {
+			invalid("direct_ipv6")
+		}
+	case typeDirect:
+		if len(m.BgpIpv4.Elements()) > 0 {
+			invalid("bgp_ipv4")
+		}
+		if len(m.BgpIpv6.Elements()) > 0 {
+			invalid("bgp_ipv6")
+		}
+		if len(m.Bfd.Elements()) > 0 {
+			invalid("bfd")
+		}
+		if !m.CustomerAsn.IsNull() {
+			invalid("customer_asn")
+		}
+		if !m.BgpAuthKey.IsNull() {
+			invalid("bgp_auth_key")
+		}
+		if !m.AsOverrideEnabled.IsNull() {
+			invalid("as_override_enabled")
+		}
+	}
+	return diags
+}
+
+// requestChanged reports whether an update would send the API a routing
+// protocol that differs from the prior state. Attributes that are neither
+// configured nor defaulted keep their prior value and are not a change
+func requestChanged(ctx context.Context, config, plan, state ResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !config.Type.IsNull() && !strings.EqualFold(config.Type.ValueString(), state.Type.ValueString()) {
+		return true, diags
+	}
+	if valueChanged(config.Name, plan.Name, state.Name) ||
+		valueChanged(config.CustomerAsn, plan.CustomerAsn, state.CustomerAsn) ||
+		valueChanged(config.BgpAuthKey, plan.BgpAuthKey, state.BgpAuthKey) ||
+		valueChanged(config.AsOverrideEnabled, plan.AsOverrideEnabled, state.AsOverrideEnabled) {
+		return true, diags
+	}
+
+	for _, block := range []attr.Value{config.DirectIpv4, config.DirectIpv6, config.BgpIpv4, config.BgpIpv6, config.Bfd} {
+		if block.IsUnknown() {
+			return true, diags
+		}
+	}
+
+	directIpv4, d := toPtrs(ctx, config.DirectIpv4, plan.DirectIpv4, state.DirectIpv4)
+	diags.Append(d...)
+	directIpv6, d := toPtrs(ctx, config.DirectIpv6, plan.DirectIpv6, state.DirectIpv6)
+	diags.Append(d...)
+	bgpIpv4, d := toPtrs(ctx, config.BgpIpv4, plan.BgpIpv4, state.BgpIpv4)
+	diags.Append(d...)
+	bgpIpv6, d := toPtrs(ctx, config.BgpIpv6, plan.BgpIpv6, state.BgpIpv6)
+	diags.Append(d...)
+	bfd, d := toPtrs(ctx, config.Bfd, plan.Bfd, state.Bfd)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if blockPresenceChanged(directIpv4) || blockPresenceChanged(directIpv6) || blockPresenceChanged(bgpIpv4) || blockPresenceChanged(bgpIpv6) || blockPresenceChanged(bfd) {
+		return true, diags
+	}
+
+	if directIpv4[0] != nil && valueChanged(directIpv4[0].EquinixIfaceIP, directIpv4[1].EquinixIfaceIP, directIpv4[2].EquinixIfaceIP) {
+		return true, diags
+	}
+	if directIpv6[0] != nil && valueChanged(directIpv6[0].EquinixIfaceIP, directIpv6[1].EquinixIfaceIP, directIpv6[2].EquinixIfaceIP) {
+		return true, diags
+	}
+	for _, bgp := range [][3]*BgpConnectionModel{bgpIpv4, bgpIpv6} {
+		if bgp[0] != nil && (valueChanged(bgp[0].CustomerPeerIP, bgp[1].CustomerPeerIP, bgp[2].CustomerPeerIP) ||
+			valueChanged(bgp[0].Enabled, bgp[1].Enabled, bgp[2].Enabled) ||
+			valueChanged(bgp[0].OutboundAsPrependCount, bgp[1].OutboundAsPrependCount, bgp[2].OutboundAsPrependCount) ||
+			valueChanged(bgp[0].InboundMed, bgp[1].InboundMed, bgp[2].InboundMed) ||
+			valueChanged(bgp[0].OutboundMed, bgp[1].OutboundMed, bgp[2].OutboundMed)) {
+			return true, diags
+		}
+	}
+	if bfd[0] != nil && (valueChanged(bfd[0].Enabled, bfd[1].Enabled, bfd[2].Enabled) ||
+		valueChanged(bfd[0].Interval, bfd[1].Interval, bfd[2].Interval)) {
+		return true, diags
+	}
+	return false, diags
+}
+
+// valueChanged compares the configured value, or the planned value when it
+// comes from a default, with the prior state
+func valueChanged(config, plan, state attr.Value) bool {
+	if !config.IsNull() {
+		return !config.Equal(state)
+	}
+	if !plan.IsNull() && !plan.IsUnknown() {
+		return !plan.Equal(state)
+	}
+	return false
+}
+
+// blockPresenceChanged reports whether a block is configured but not in the prior
+// state, or the other way around
+func blockPresenceChanged[T any](ptrs [3]*T) bool {
+	return (ptrs[0] == nil) != (ptrs[2] == nil)
+}
+
+func toPtrs[T any](ctx context.Context, config, plan, state fwtypes.ListNestedObjectValueOf[T]) ([3]*T, diag.Diagnostics) {
+	var ptrs [3]*T
+	var diags diag.Diagnostics
+	for i, value := range []fwtypes.ListNestedObjectValueOf[T]{config, plan, state} {
+		ptr, d := value.ToPtr(ctx)
+		diags.Append(d...)
+		ptrs[i] = ptr
+	}
+	return ptrs, diags
+}
+
+func (m *ResourceModel) parse(ctx context.Context, routingProtocolData *fabricv4.RoutingProtocolData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var (
+		operation *fabricv4.RoutingProtocolOperation
+		change    *fabricv4.RoutingProtocolChange
+		changeLog *fabricv4.Changelog
+	)
+
+	switch rp := routingProtocolData.GetActualInstance().(type) {
+	case *fabricv4.RoutingProtocolBGPData:
+		m.ID = types.StringValue(rp.GetUuid())
+		m.UUID = types.StringValue(rp.GetUuid())
+		m.Href = types.StringValue(rp.GetHref())
+		m.Type = types.StringValue(string(rp.GetType()))
+		m.Name = types.StringValue(rp.GetName())
+		m.State = types.StringValue(string(rp.GetState()))
+		m.CustomerAsn = types.Int64Value(rp.GetCustomerAsn())
+		m.EquinixAsn = types.Int64Value(rp.GetEquinixAsn())
+		m.BgpAuthKey = types.StringValue(rp.GetBgpAuthKey())
+		m.AsOverrideEnabled = types.BoolValue(rp.GetAsOverrideEnabled())
+		m.BgpIpv4 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []BgpConnectionModel{})
+		if rp.BgpIpv4 != nil {
+			m.BgpIpv4 = parseBgpConnection(ctx, rp.BgpIpv4)
+		}
+		m.BgpIpv6 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []BgpConnectionModel{})
+		if rp.BgpIpv6 != nil {
+			m.BgpIpv6 = parseBgpConnection(ctx, rp.BgpIpv6)
+		}
+		m.Bfd = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []BfdModel{})
+		if rp.Bfd != nil {
+			m.Bfd = fwtypes.NewListNestedObjectValueOfPtr(ctx, &BfdModel{
+				Enabled:  types.BoolValue(rp.Bfd.GetEnabled()),
+				Interval: types.StringValue(rp.Bfd.GetInterval()),
+			})
+		}
+		m.DirectIpv4 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []DirectIpv4Model{})
+		m.DirectIpv6 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []DirectIpv6Model{})
+		operation, change, changeLog = rp.Operation, rp.Change, rp.Changelog
+	case *fabricv4.RoutingProtocolDirectData:
+		m.ID = types.StringValue(rp.GetUuid())
+		m.UUID = types.StringValue(rp.GetUuid())
+		m.Href = types.StringValue(rp.GetHref())
+		m.Type = types.StringValue(string(rp.GetType()))
+		m.Name = types.StringValue(rp.GetName())
+		m.State = types.StringValue(string(rp.GetState()))
+		m.CustomerAsn = types.Int64Null()
+		m.EquinixAsn = types.Int64Null()
+		m.BgpAuthKey = types.StringNull()
+		m.AsOverrideEnabled = types.BoolNull()
+		m.DirectIpv4 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []DirectIpv4Model{})
+		if rp.DirectIpv4 != nil {
+			m.DirectIpv4 = fwtypes.NewListNestedObjectValueOfPtr(ctx, &DirectIpv4Model{
+				EquinixIfaceIP: types.StringValue(rp.DirectIpv4.GetEquinixIfaceIp()),
+			})
+		}
+		m.DirectIpv6 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []DirectIpv6Model{})
+		if rp.DirectIpv6 != nil {
+			m.DirectIpv6 = fwtypes.NewListNestedObjectValueOfPtr(ctx, &DirectIpv6Model{
+				EquinixIfaceIP: types.StringValue(rp.DirectIpv6.GetEquinixIfaceIp()),
+			})
+		}
+		m.BgpIpv4 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []BgpConnectionModel{})
+		m.BgpIpv6 = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []BgpConnectionModel{})
+		m.Bfd = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []BfdModel{})
+		operation, change, changeLog = rp.Operation, rp.Change, rp.Changelog
+	}
+
+	// The API does not return the description; keep the configured one
+	if m.Description.IsUnknown() {
+		m.Description = types.StringValue("")
+	}
+
+	m.Operation = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []OperationModel{})
+	if operation != nil {
+		m.Operation = fwtypes.NewListNestedObjectValueOfPtr(ctx, &OperationModel{
+			Errors: parseErrors(ctx, operation.GetErrors()),
+		})
+	}
+
+	m.Change = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []ChangeModel{})
+	if change != nil {
+		m.Change = fwtypes.NewListNestedObjectValueOfPtr(ctx, &ChangeModel{
+			UUID: types.StringValue(change.GetUuid()),
+			Type: types.StringValue(string(change.GetType())),
+			Href: types.StringValue(change.GetHref()),
+		})
+	}
+
+	m.ChangeLog = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []ChangeLogModel{})
+	if changeLog != nil {
+		m.ChangeLog = fwtypes.NewListNestedObjectValueOfPtr(ctx, &ChangeLogModel{
+			CreatedBy:         types.StringValue(changeLog.GetCreatedBy()),
+			CreatedByFullName: types.StringValue(changeLog.GetCreatedByFullName()),
+			CreatedByEmail:    types.StringValue(changeLog.GetCreatedByEmail()),
+			CreatedDateTime:   types.StringValue(changeLog.GetCreatedDateTime().Format(fabric.TimeFormat)),
+			UpdatedBy:         types.StringValue(changeLog.GetUpdatedBy()),
+			UpdatedByFullName: types.StringValue(changeLog.GetUpdatedByFullName()),
+			UpdatedByEmail:    types.StringValue(changeLog.GetUpdatedByEmail()),
+			UpdatedDateTime:   types.StringValue(changeLog.GetUpdatedDateTime().Format(fabric.TimeFormat)),
+			DeletedBy:         types.StringValue(changeLog.GetDeletedBy()),
+			DeletedByFullName: types.StringValue(changeLog.GetDeletedByFullName()),
+			DeletedByEmail:    types.StringValue(changeLog.GetDeletedByEmail()),
+			DeletedDateTime:   types.StringValue(changeLog.GetDeletedDateTime().Format(fabric.TimeFormat)),
+		})
+	}
+
+	return diags
+}
+
+// bgpConnection is implemented by both fabricv4.BGPConnectionIpv4 and
+// fabricv4.BGPConnectionIpv6
+type bgpConnection interface {
+	GetCustomerPeerIp() string
+	GetEquinixPeerIp() string
+	GetEnabled() bool
+	GetOutboundASPrependCount() int64
+	GetInboundMED() int64
+	GetOutboundMED() int64
+}
+
+func parseBgpConnection(ctx context.Context, bgpConnection bgpConnection) fwtypes.ListNestedObjectValueOf[BgpConnectionModel] {
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, &BgpConnectionModel{
+		CustomerPeerIP:         types.StringValue(bgpConnection.GetCustomerPeerIp()),
+		EquinixPeerIP:          types.StringValue(bgpConnection.GetEquinixPeerIp()),
+		Enabled:                types.BoolValue(bgpConnection.GetEnabled()),
+		OutboundAsPrependCount: types.StringValue(strconv.FormatInt(bgpConnection.GetOutboundASPrependCount(), 10)),
+		InboundMed:             types.Int64Value(bgpConnection.GetInboundMED()),
+		OutboundMed:            types.Int64Value(bgpConnection.GetOutboundMED()),
+	})
+}
+
+func parseErrors(ctx context.Context, errors []fabricv4.Error) fwtypes.ListNestedObjectValueOf[ErrorModel] {
+	errorModels := make([]ErrorModel, len(errors))
+	for i, operationError := range errors {
+		additionalInfo := make([]ErrorAdditionalInfoModel, len(operationError.GetAdditionalInfo()))
+		for j, info := range operationError.GetAdditionalInfo() {
+			additionalInfo[j] = ErrorAdditionalInfoModel{
+				Property: types.StringValue(info.GetProperty()),
+				Reason:   types.StringValue(info.GetReason()),
+			}
+		}
+		errorModels[i] = ErrorModel{
+			ErrorCode:      types.StringValue(operationError.GetErrorCode()),
+			ErrorMessage:   types.StringValue(operationError.GetErrorMessage()),
+			CorrelationID:  types.StringValue(operationError.GetCorrelationId()),
+			Details:        types.StringValue(operationError.GetDetails()),
+			Help:           types.StringValue(operationError.GetHelp()),
+			AdditionalInfo: fwtypes.NewListNestedObjectValueOfValueSlice(ctx, additionalInfo),
+		}
+	}
+	return fwtypes.NewListNestedObjectValueOfValueSlice(ctx, errorModels)
+}
+
+func buildRequest(ctx context.Context, plan ResourceModel) (fabricv4.RoutingProtocolBase, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.routingProtocolType() == typeBGP {
+		bgpRP := fabricv4.RoutingProtocolBGPType{}
+		bgpRP.SetType(fabricv4.ROUTINGPROTOCOLBGPTYPETYPE_BGP)
+		if name := plan.Name.ValueString(); name != "" {
+			bgpRP.SetName(name)
+		}
+		if !plan.CustomerAsn.IsNull() && !plan.CustomerAsn.IsUnknown() {
+			bgpRP.SetCustomerAsn(plan.CustomerAsn.ValueInt64())
+		}
+		if bgpAuthKey := plan.BgpAuthKey.ValueString(); bgpAuthKey != "" {
+			bgpRP.SetBgpAuthKey(bgpAuthKey)
+		}
+		if !plan.AsOverrideEnabled.IsNull() && !plan.AsOverrideEnabled.IsUnknown() {
+			bgpRP.SetAsOverrideEnabled(plan.AsOverrideEnabled.ValueBool())
+		}
+
+		bgpIpv4, d := plan.BgpIpv4.ToPtr(ctx)
+		diags.Append(d...)
+		bgpIpv6, d := plan.BgpIpv6.ToPtr(ctx)
+		diags.Append(d...)
+		bfd, d := plan.Bfd.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fabricv4.RoutingProtocolBase{}, diags
+		}
+
+		if bgpIpv4 != nil {
+			connection, err := bgpIpv4.toBgpConnection()
+			if err != nil {
+				diags.AddAttributeError(path.Root("bgp_ipv4"), "Invalid BGP IPv4 configuration", err.Error())
+				return fabricv4.RoutingProtocolBase{}, diags
+			}
+			bgpRP.SetBgpIpv4(connection)
+		}
+		if bgpIpv6 != nil {
+			connection, err := bgpIpv6.toBgpConnection()
+			if err != nil {
+				diags.AddAttributeError(path.Root("bgp_ipv6"), "Invalid BGP IPv6 configuration", err.Error())
+				return fabricv4.RoutingProtocolBase{}, diags
+			}
+			bgpRP.SetBgpIpv6(fabricv4.BGPConnectionIpv6{
+				CustomerPeerIp:         connection.CustomerPeerIp,
+				Enabled:                connection.Enabled,
+				OutboundASPrependCount: connection.OutboundASPrependCount,
+				InboundMED:             connection.InboundMED,
+				OutboundMED:            connection.OutboundMED,
+			})
+		}
+		if bfd != nil {
+			routingProtocolBfd := fabricv4.RoutingProtocolBFD{}
+			routingProtocolBfd.SetEnabled(bfd.Enabled.ValueBool())
+			if interval := bfd.Interval.ValueString(); interval != "" {
+				routingProtocolBfd.SetInterval(interval)
+			}
+			bgpRP.SetBfd(routingProtocolBfd)
+		}
+
+		return fabricv4.RoutingProtocolBGPTypeAsRoutingProtocolBase(&bgpRP), diags
+	}
+
+	directRP := fabricv4.RoutingProtocolDirectType{}
+	directRP.SetType(fabricv4.ROUTINGPROTOCOLDIRECTTYPETYPE_DIRECT)
+	if name := plan.Name.ValueString(); name != "" {
+		directRP.SetName(name)
+	}
+
+	directIpv4, d := plan.DirectIpv4.ToPtr(ctx)
+	diags.Append(d...)
+	directIpv6, d := plan.DirectIpv6.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fabricv4.RoutingProtocolBase{}, diags
+	}
+
+	if directIpv4 != nil {
+		connection := fabricv4.DirectConnectionIpv4{}
+		connection.SetEquinixIfaceIp(directIpv4.EquinixIfaceIP.ValueString())
+		directRP.SetDirectIpv4(connection)
+	}
+	if directIpv6 != nil {
+		connection := fabricv4.DirectConnectionIpv6{}
+		if equinixIfaceIP := directIpv6.EquinixIfaceIP.ValueString(); equinixIfaceIP != "" {
+			connection.SetEquinixIfaceIp(equinixIfaceIP)
+		}
+		directRP.SetDirectIpv6(connection)
+	}
+
+	return fabricv4.RoutingProtocolDirectTypeAsRoutingProtocolBase(&directRP), diags
+}
+
+func (m *BgpConnectionModel) toBgpConnection() (fabricv4.BGPConnectionIpv4, error) {
+	connection := fabricv4.BGPConnectionIpv4{}
+	connection.SetCustomerPeerIp(m.CustomerPeerIP.ValueString())
+	connection.SetEnabled(m.Enabled.ValueBool())
+	if outboundAsPrependCount := m.OutboundAsPrependCount.ValueString(); outboundAsPrependCount != "" {
+		count, err := strconv.ParseInt(outboundAsPrependCount, 10, 64)
+		if err != nil {
+			return connection, err
+		}
+		connection.SetOutboundASPrependCount(count)
+	}
+	if inboundMed := m.InboundMed.ValueInt64(); inboundMed > 0 {
+		connection.SetInboundMED(inboundMed)
+	}
+	if outboundMed := m.OutboundMed.ValueInt64(); outboundMed > 0 {
+		connection.SetOutboundMED(outboundMed)
+	}
+	return connection, nil
+}
@@ -0,0 +1,153 @@
+package routing_protocol
+
+import (
+	"context"
+	"testing"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidate(t *testing.T) {
+	ctx := context.Background()
+	directIpv4 := fwtypes.NewListNestedObjectValueOfPtr(ctx, &DirectIpv4Model{
+		EquinixIfaceIP: types.StringValue("190.1.1.1/30"),
+	})
+	bgpIpv4 := fwtypes.NewListNestedObjectValueOfPtr(ctx, &BgpConnectionModel{
+		CustomerPeerIP: types.StringValue("190.1.1.2"),
+	})
+
+	tests := map[string]struct {
+		model   ResourceModel
+		invalid []string
+	}{
+		"direct": {
+			model: ResourceModel{Type: types.StringValue("DIRECT"), DirectIpv4: directIpv4},
+		},
+		"bgp": {
+			model: ResourceModel{Type: types.StringValue("bgp"), BgpIpv4: bgpIpv4, CustomerAsn: types.Int64Value(100)},
+		},
+		"direct with bgp attributes": {
+			model:   ResourceModel{Type: types.StringValue("DIRECT"), BgpIpv4: bgpIpv4, CustomerAsn: types.Int64Value(100)},
+			invalid: []string{"bgp_ipv4", "customer_asn"},
+		},
+		"bgp with direct block": {
+			model:   ResourceModel{Type: types.StringValue("BGP"), DirectIpv4: directIpv4, BgpIpv4: bgpIpv4},
+			invalid: []string{"direct_ipv4"},
+		},
+		"implied direct with bgp attributes": {
+			model:   ResourceModel{DirectIpv4: directIpv4, BgpAuthKey: types.StringValue("key")},
+			invalid: []string{"bgp_auth_key"},
+		},
+		"implied type with mixed blocks": {
+			model:   ResourceModel{DirectIpv4: directIpv4, BgpIpv4: bgpIpv4},
+			invalid: []string{"direct_ipv4"},
+		},
+		"unknown type": {
+			model: ResourceModel{Type: types.StringUnknown(), DirectIpv4: directIpv4, BgpIpv4: bgpIpv4},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := tc.model.validate()
+			if len(diags) != len(tc.invalid) {
+				t.Fatalf("expected %d errors, got %v", len(tc.invalid), diags)
+			}
+			for i, attribute := range tc.invalid {
+				withPath, ok := diags[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root(attribute)) {
+					t.Errorf("expected error %d on %s, got %v", i, attribute, diags[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRequestChanged(t *testing.T) {
+	ctx := context.Background()
+	bgpIpv4 := func(enabled types.Bool, outboundMed types.Int64) fwtypes.ListNestedObjectValueOf[BgpConnectionModel] {
+		return fwtypes.NewListNestedObjectValueOfPtr(ctx, &BgpConnectionModel{
+			CustomerPeerIP:         types.StringValue("190.1.1.2"),
+			EquinixPeerIP:          types.StringNull(),
+			Enabled:                enabled,
+			OutboundAsPrependCount: types.StringNull(),
+			InboundMed:             types.Int64Null(),
+			OutboundMed:            outboundMed,
+		})
+	}
+	state := ResourceModel{
+		Type:        types.StringValue("BGP"),
+		Name:        types.StringValue("rp"),
+		CustomerAsn: types.Int64Value(100),
+		BgpIpv4: fwtypes.NewListNestedObjectValueOfPtr(ctx, &BgpConnectionModel{
+			CustomerPeerIP:         types.StringValue("190.1.1.2"),
+			EquinixPeerIP:          types.StringValue("190.1.1.1"),
+			Enabled:                types.BoolValue(true),
+			OutboundAsPrependCount: types.StringValue("0"),
+			InboundMed:             types.Int64Value(0),
+			OutboundMed:            types.Int64Value(0),
+		}),
+	}
+
+	tests := map[string]struct {
+		config ResourceModel
+		plan   ResourceModel
+		want   bool
+	}{
+		"unchanged with computed attributes left out": {
+			config: ResourceModel{Type: types.StringValue("bgp"), BgpIpv4: bgpIpv4(types.BoolNull(), types.Int64Null())},
+			plan:   ResourceModel{BgpIpv4: bgpIpv4(types.BoolValue(true), types.Int64Unknown())},
+		},
+		"name changed": {
+			config: ResourceModel{Name: types.StringValue("other"), BgpIpv4: bgpIpv4(types.BoolNull(), types.Int64Null())},
+			want:   true,
+		},
+		"type changed": {
+			config: ResourceModel{Type: types.StringValue("DIRECT")},
+			want:   true,
+		},
+		"nested attribute changed": {
+			config: ResourceModel{BgpIpv4: bgpIpv4(types.BoolNull(), types.Int64Value(10))},
+			want:   true,
+		},
+		"defaulted nested attribute changed": {
+			config: ResourceModel{BgpIpv4: bgpIpv4(types.BoolNull(), types.Int64Null())},
+			plan:   ResourceModel{BgpIpv4: bgpIpv4(types.BoolValue(false), types.Int64Null())},
+			want:   true,
+		},
+		"block removed": {
+			config: ResourceModel{},
+			want:   true,
+		},
+		"block added": {
+			config: ResourceModel{
+				BgpIpv4: bgpIpv4(types.BoolNull(), types.Int64Null()),
+				Bfd:     fwtypes.NewListNestedObjectValueOfPtr(ctx, &BfdModel{Enabled: types.BoolValue(true), Interval: types.StringNull()}),
+			},
+			want: true,
+		},
+		"unknown block": {
+			config: ResourceModel{BgpIpv4: fwtypes.NewListNestedObjectValueOfUnknown[BgpConnectionModel](ctx)},
+			want:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tc.plan
+			if plan.BgpIpv4.IsNull() {
+				plan.BgpIpv4 = tc.config.BgpIpv4
+			}
+			plan.Bfd = tc.config.Bfd
+			got, diags := requestChanged(ctx, tc.config, plan, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package routing_protocol
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// useStateUnlessRequestChanged keeps the prior state of computed attributes
+// that only change when Update sends the API a new routing protocol, like the
+// change and change_log, instead of planning them as unknown on every update
+type useStateUnlessRequestChanged struct{}
+
+func (m useStateUnlessRequestChanged) Description(_ context.Context) string {
+	return "Uses the prior state unless the routing protocol is sent to the API again."
+}
+
+func (m useStateUnlessRequestChanged) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateUnlessRequestChanged) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	useState, diags := useStateForUnknown(ctx, req.Config, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if useState {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m useStateUnlessRequestChanged) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	useState, diags := useStateForUnknown(ctx, req.Config, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+	if useState {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func useStateForUnknown(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var configModel, planModel, stateModel ResourceModel
+	var diags diag.Diagnostics
+	diags.Append(config.Get(ctx, &configModel)...)
+	diags.Append(plan.Get(ctx, &planModel)...)
+	diags.Append(state.Get(ctx, &stateModel)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	changed, d := requestChanged(ctx, configModel, planModel, stateModel)
+	diags.Append(d...)
+	return !changed && !diags.HasError(), diags
+}
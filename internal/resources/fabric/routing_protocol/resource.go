@@ -0,0 +1,400 @@
+package routing_protocol
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_routing_protocol",
+			},
+		),
+	}
+}
+
+type Resource struct {
+	framework.BaseResource
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// ImportState parses the import identifier as <connection_uuid>/<rp_uuid>, or
+// as <connection_uuid>/<type> to adopt the only BGP or DIRECT routing protocol
+// of the connection
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parsed, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	id := parsed.RoutingProtocolID
+	if parsed.Type != "" {
+		// Provider meta is not available while importing
+		client := r.Meta.NewFabricClientForFramework(ctx, tfsdk.Config{})
+		id, err = findRoutingProtocolByType(ctx, client, parsed.ConnectionID, parsed.Type)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed importing Routing Protocol %s", req.ID), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_uuid"), parsed.ConnectionID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	createRequest, diags := buildRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionID := plan.ConnectionUUID.ValueString()
+	routingProtocol, _, err := client.RoutingProtocolsApi.CreateConnectionRoutingProtocol(ctx, connectionID).RoutingProtocolBase(createRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed creating Routing Protocol", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	id := routingProtocolUUID(routingProtocol)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	createWaiter := getCreateUpdateWaiter(ctx, client, connectionID, id, createTimeout)
+	routingProtocolChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed creating Routing Protocol %s", id), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, routingProtocolChecked.(*fabricv4.RoutingProtocolData))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	routingProtocol, httpResp, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolByUuid(ctx, id, state.ConnectionUUID.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving Routing Protocol %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.parse(ctx, routingProtocol)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var config, state, plan ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed, diags := requestChanged(ctx, config, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !changed {
+		// Only attributes the API does not know about changed, like the
+		// description or timeouts; the computed attributes were planned from
+		// the prior state
+		state.Description = plan.Description
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	connectionID := state.ConnectionUUID.ValueString()
+
+	updateRequest, diags := buildRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	routingProtocol, _, err := client.RoutingProtocolsApi.ReplaceConnectionRoutingProtocolByUuid(ctx, id, connectionID).RoutingProtocolBase(updateRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed updating Routing Protocol %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	changeWaiter := getChangeWaiter(ctx, client, connectionID, id, routingProtocolChangeUUID(routingProtocol), updateTimeout)
+	if _, err = r.Meta.WaitForStateContext(ctx, changeWaiter); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed updating Routing Protocol %s", id), err.Error())
+		return
+	}
+
+	updateWaiter := getCreateUpdateWaiter(ctx, client, connectionID, id, updateTimeout)
+	routingProtocolChecked, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed updating Routing Protocol %s", id), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.parse(ctx, routingProtocolChecked.(*fabricv4.RoutingProtocolData))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	id := state.ID.ValueString()
+	connectionID := state.ConnectionUUID.ValueString()
+
+	_, deleteResp, err := client.RoutingProtocolsApi.DeleteConnectionRoutingProtocolByUuid(ctx, id, connectionID).Execute()
+	if err != nil {
+		if deleteResp != nil && deleteResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		if genericError, ok := err.(*fabricv4.GenericOpenAPIError); ok {
+			if fabricErrs, ok := genericError.Model().([]fabricv4.Error); ok {
+				// EQ-3041121 = Routing Protocol already deleted
+				if equinix_errors.HasErrorCode(fabricErrs, "EQ-3041121") {
+					return
+				}
+			}
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed deleting Routing Protocol %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	deleteWaiter := GetDeleteWaiter(ctx, client, connectionID, id, deleteTimeout)
+	if _, err = r.Meta.WaitForStateContext(ctx, deleteWaiter); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed deleting Routing Protocol %s", id), err.Error())
+	}
+}
+
+func routingProtocolUUID(routingProtocol *fabricv4.RoutingProtocolData) string {
+	switch rp := routingProtocol.GetActualInstance().(type) {
+	case *fabricv4.RoutingProtocolBGPData:
+		return rp.GetUuid()
+	case *fabricv4.RoutingProtocolDirectData:
+		return rp.GetUuid()
+	}
+	return ""
+}
+
+func routingProtocolChangeUUID(routingProtocol *fabricv4.RoutingProtocolData) string {
+	var change fabricv4.RoutingProtocolChange
+	switch rp := routingProtocol.GetActualInstance().(type) {
+	case *fabricv4.RoutingProtocolBGPData:
+		change = rp.GetChange()
+	case *fabricv4.RoutingProtocolDirectData:
+		change = rp.GetChange()
+	}
+	return change.GetUuid()
+}
+
+func routingProtocolState(routingProtocol *fabricv4.RoutingProtocolData) string {
+	switch rp := routingProtocol.GetActualInstance().(type) {
+	case *fabricv4.RoutingProtocolBGPData:
+		return string(rp.GetState())
+	case *fabricv4.RoutingProtocolDirectData:
+		return string(rp.GetState())
+	}
+	return ""
+}
+
+func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, connectionID, id string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_PROVISIONING),
+			string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_REPROVISIONING),
+		},
+		Target: []string{
+			string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_PROVISIONED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			routingProtocol, _, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolByUuid(ctx, id, connectionID).Execute()
+			if err != nil {
+				return nil, "", equinix_errors.FormatFabricError(err)
+			}
+			return routingProtocol, routingProtocolState(routingProtocol), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
+
+func getChangeWaiter(ctx context.Context, client *fabricv4.APIClient, connectionID, id, changeID string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+		},
+		Target: []string{
+			"COMPLETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			change, _, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolsChangeByUuid(ctx, connectionID, id, changeID).Execute()
+			if err != nil {
+				return nil, "", equinix_errors.FormatFabricError(err)
+			}
+			// Any status other than COMPLETED means the change is still being applied
+			if change.GetStatus() != "COMPLETED" {
+				return change, "PENDING", nil
+			}
+			return change, change.GetStatus(), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
+
+// GetDeleteWaiter is exported for the acceptance tests to check that Routing
+// Protocols are gone once destroyed
+func GetDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, connectionID, id string, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_PROVISIONED),
+			string(fabricv4.ROUTINGPROTOCOLBGPDATASTATE_DEPROVISIONING),
+		},
+		Target: []string{
+			strconv.Itoa(http.StatusNotFound),
+		},
+		Refresh: func() (interface{}, string, error) {
+			routingProtocol, httpResp, err := client.RoutingProtocolsApi.GetConnectionRoutingProtocolByUuid(ctx, id, connectionID).Execute()
+			if err != nil {
+				if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+					return routingProtocol, strconv.Itoa(http.StatusNotFound), nil
+				}
+				return nil, "", equinix_errors.FormatFabricError(err)
+			}
+			return routingProtocol, routingProtocolState(routingProtocol), nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+}
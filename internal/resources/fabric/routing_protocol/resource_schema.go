@@ -0,0 +1,369 @@
+package routing_protocol
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric connection routing protocols. A routing protocol is either DIRECT or BGP; the direct_* blocks can only be set on DIRECT routing protocols and the bgp_* blocks, ASNs and BFD only on BGP routing protocols
+
+Additional documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/Interconnection/FCR/connections/FCR-connect-azureQC.htm#ConfigureRoutingDetailsintheFabricPortal
+* API: https://developer.equinix.com/dev-docs/fabric/api-reference/fabric-v4-apis#routing-protocols`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"connection_uuid": schema.StringAttribute{
+				Description: "Connection URI associated with Routing Protocol",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"href": schema.StringAttribute{
+				Description: "Routing Protocol URI information",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Defines the routing protocol type like BGP or DIRECT",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("BGP", "DIRECT"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Equinix-assigned routing protocol identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Routing Protocol name. An alpha-numeric 24 characters string which can include only hyphens and underscores",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Customer-provided Fabric Routing Protocol description",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Routing Protocol overall state",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					useStateUnlessRequestChanged{},
+				},
+			},
+			"customer_asn": schema.Int64Attribute{
+				Description: "Customer-provided ASN",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"equinix_asn": schema.Int64Attribute{
+				Description: "Equinix ASN",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"bgp_auth_key": schema.StringAttribute{
+				Description: "BGP authorization key",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"as_override_enabled": schema.BoolAttribute{
+				Description: "Enable AS number override",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation": schema.ListNestedAttribute{
+				Description: "Routing Protocol type-specific operational data",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[OperationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					useStateUnlessRequestChanged{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"errors": schema.ListNestedAttribute{
+							Description: "Errors occurred",
+							Computed:    true,
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[ErrorModel](ctx),
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"error_code": schema.StringAttribute{
+										Description: "Error  code",
+										Computed:    true,
+									},
+									"error_message": schema.StringAttribute{
+										Description: "Error Message",
+										Computed:    true,
+									},
+									"correlation_id": schema.StringAttribute{
+										Description: "CorrelationId",
+										Computed:    true,
+									},
+									"details": schema.StringAttribute{
+										Description: "Details",
+										Computed:    true,
+									},
+									"help": schema.StringAttribute{
+										Description: "Help",
+										Computed:    true,
+									},
+									"additional_info": schema.ListNestedAttribute{
+										Description: "Pricing error additional Info",
+										Computed:    true,
+										CustomType:  fwtypes.NewListNestedObjectTypeOf[ErrorAdditionalInfoModel](ctx),
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"property": schema.StringAttribute{
+													Description: "Property at which the error potentially occurred",
+													Computed:    true,
+												},
+												"reason": schema.StringAttribute{
+													Description: "Reason for the error",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"change": schema.ListNestedAttribute{
+				Description: "Routing Protocol configuration Changes",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ChangeModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					useStateUnlessRequestChanged{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "Uniquely identifies a change",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of change",
+							Computed:    true,
+						},
+						"href": schema.StringAttribute{
+							Description: "Routing Protocol Change URI",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"change_log": schema.ListNestedAttribute{
+				Description: "Captures Routing Protocol lifecycle change information",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ChangeLogModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					useStateUnlessRequestChanged{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_by": schema.StringAttribute{
+							Description: "Created by User Key",
+							Computed:    true,
+						},
+						"created_by_full_name": schema.StringAttribute{
+							Description: "Created by User Full Name",
+							Computed:    true,
+						},
+						"created_by_email": schema.StringAttribute{
+							Description: "Created by User Email Address",
+							Computed:    true,
+						},
+						"created_date_time": schema.StringAttribute{
+							Description: "Created by Date and Time",
+							Computed:    true,
+						},
+						"updated_by": schema.StringAttribute{
+							Description: "Updated by User Key",
+							Computed:    true,
+						},
+						"updated_by_full_name": schema.StringAttribute{
+							Description: "Updated by User Full Name",
+							Computed:    true,
+						},
+						"updated_by_email": schema.StringAttribute{
+							Description: "Updated by User Email Address",
+							Computed:    true,
+						},
+						"updated_date_time": schema.StringAttribute{
+							Description: "Updated by Date and Time",
+							Computed:    true,
+						},
+						"deleted_by": schema.StringAttribute{
+							Description: "Deleted by User Key",
+							Computed:    true,
+						},
+						"deleted_by_full_name": schema.StringAttribute{
+							Description: "Deleted by User Full Name",
+							Computed:    true,
+						},
+						"deleted_by_email": schema.StringAttribute{
+							Description: "Deleted by User Email Address",
+							Computed:    true,
+						},
+						"deleted_date_time": schema.StringAttribute{
+							Description: "Deleted by Date and Time",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"direct_ipv4": schema.ListNestedBlock{
+				Description: "Routing Protocol Direct IPv4",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[DirectIpv4Model](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"equinix_iface_ip": schema.StringAttribute{
+							Description: "Equinix side Interface IP address",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"direct_ipv6": schema.ListNestedBlock{
+				Description: "Routing Protocol Direct IPv6",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[DirectIpv6Model](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"equinix_iface_ip": schema.StringAttribute{
+							Description: "Equinix side Interface IP address",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"bgp_ipv4": bgpConnectionSchema(ctx, "Routing Protocol BGP IPv4"),
+			"bgp_ipv6": bgpConnectionSchema(ctx, "Routing Protocol BGP IPv6"),
+			"bfd": schema.ListNestedBlock{
+				Description: "Bidirectional Forwarding Detection",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[BfdModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Description: "Bidirectional Forwarding Detection enablement",
+							Required:    true,
+						},
+						"interval": schema.StringAttribute{
+							Description: "Interval range between the received BFD control packets",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("100"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func bgpConnectionSchema(ctx context.Context, description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[BgpConnectionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"customer_peer_ip": schema.StringAttribute{
+					Description: "Customer side peering ip",
+					Required:    true,
+				},
+				"equinix_peer_ip": schema.StringAttribute{
+					Description: "Equinix side peering ip",
+					Computed:    true,
+				},
+				"enabled": schema.BoolAttribute{
+					Description: "Admin status for the BGP session",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+				},
+				"outbound_as_prepend_count": schema.StringAttribute{
+					Description: "AS path prepend count. One of: 0, 1, 3, 5",
+					Optional:    true,
+					Computed:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("0", "1", "3", "5"),
+					},
+				},
+				"inbound_med": schema.Int64Attribute{
+					Description: "Inbound Multi Exit Discriminator attribute",
+					Optional:    true,
+					Computed:    true,
+				},
+				"outbound_med": schema.Int64Attribute{
+					Description: "Outbound Multi Exit Discriminator attribute",
+					Optional:    true,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
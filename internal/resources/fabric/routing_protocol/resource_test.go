@@ -1,20 +1,38 @@
-package equinix_test
+package routing_protocol_test
 
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
-	"github.com/equinix/terraform-provider-equinix/equinix"
 	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
 	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routing_protocol"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func CheckRoutingProtocolDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewFabricClientForTesting(ctx)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_routing_protocol" {
+			continue
+		}
+
+		deleteWaiter := routing_protocol.GetDeleteWaiter(ctx, client, rs.Primary.Attributes["connection_uuid"], rs.Primary.ID, 10*time.Minute)
+		if _, err := deleteWaiter.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("API call failed while waiting for resource deletion")
+		}
+	}
+	return nil
+}
+
 // Note:
 // Keeping data "equinix_fabric_routing_protocol" tests in this file
 // due to the long setup times required for RP tests.
@@ -31,9 +49,10 @@ func TestAccFabricCreateRoutingProtocols_PFCR(t *testing.T) {
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
-		Providers:    acceptance.TestAccProviders,
-		CheckDestroy: checkRoutingProtocolDelete,
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckRoutingProtocolDelete,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFabricCreateRoutingProtocolConfig("RP_Conn_Test_PFCR", portUUID),
@@ -85,12 +104,71 @@ func TestAccFabricCreateRoutingProtocols_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttr("data.equinix_fabric_routing_protocol.bgp", "bgp_ipv6.0.equinix_peer_ip", "190::1:1"),
 					resource.TestCheckResourceAttr("data.equinix_fabric_routing_protocol.bgp", "bgp_ipv6.0.enabled", "true"),
 					resource.TestCheckResourceAttr("data.equinix_fabric_routing_protocol.bgp", "customer_asn", "100"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_routing_protocol.bgp", "bgp_ipv4_operational_status"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_routing_protocol.bgp", "bgp_ipv6_operational_status"),
 				),
 			},
+			{
+				ResourceName:            "equinix_fabric_routing_protocol.bgp",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccFabricRoutingProtocolImportStateIdFunc("equinix_fabric_routing_protocol.bgp"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts", "bgp_auth_key"},
+			},
+			{
+				ResourceName:            "equinix_fabric_routing_protocol.bgp",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccFabricRoutingProtocolImportStateIdByTypeFunc("equinix_fabric_routing_protocol.bgp", "BGP"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts", "bgp_auth_key"},
+			},
+		},
+	})
+}
+
+func TestAccFabricRoutingProtocolInvalidConfig_PFCR(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "equinix_fabric_routing_protocol" "invalid" {
+	connection_uuid = "0ae3d0a3-0b27-4e3d-a8d4-5f5e8c3e8a11"
+	type = "DIRECT"
+	name = "rp_invalid_PFCR"
+	bgp_ipv4{
+		customer_peer_ip = "190.1.1.2"
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Routing Protocol Configuration"),
+			},
 		},
 	})
 }
 
+func testAccFabricRoutingProtocolImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["connection_uuid"], rs.Primary.ID), nil
+	}
+}
+
+func testAccFabricRoutingProtocolImportStateIdByTypeFunc(resourceName, type_ string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["connection_uuid"], type_), nil
+	}
+}
+
 func testAccFabricCreateRoutingProtocolConfig(name, portUUID string) string {
 	return fmt.Sprintf(`
 
@@ -208,17 +286,3 @@ data "equinix_fabric_routing_protocol" "bgp" {
 
 `, name, portUUID)
 }
-
-func checkRoutingProtocolDelete(s *terraform.State) error {
-	ctx := context.Background()
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "equinix_fabric_routing_protocol" {
-			continue
-		}
-		err := equinix.WaitUntilRoutingProtocolIsDeprovisioned(rs.Primary.ID, rs.Primary.Attributes["connection_uuid"], acceptance.TestAccProvider.Meta(), &schema.ResourceData{}, ctx, 10*time.Minute)
-		if err != nil {
-			return fmt.Errorf("API call failed while waiting for resource deletion")
-		}
-	}
-	return nil
-}
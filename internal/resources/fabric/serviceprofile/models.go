@@ -0,0 +1,82 @@
+package serviceprofile
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AccessResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	ServiceProfileID types.String   `tfsdk:"service_profile_id"`
+	Email            types.String   `tfsdk:"email"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func accessID(serviceProfileID, email string) string {
+	return serviceProfileID + "/" + email
+}
+
+// parseAccessID splits an identifier of the form <service_profile_id>/<email>
+func parseAccessID(id string) (string, string, bool) {
+	serviceProfileID, email, ok := strings.Cut(id, "/")
+	return serviceProfileID, email, ok && serviceProfileID != "" && email != ""
+}
+
+func containsEmail(emails []string, email string) bool {
+	return slices.ContainsFunc(emails, func(allowed string) bool {
+		return strings.EqualFold(allowed, email)
+	})
+}
+
+// addEmail returns the allowed emails with email appended, and whether that
+// changed them
+func addEmail(emails []string, email string) ([]string, bool) {
+	if containsEmail(emails, email) {
+		return emails, false
+	}
+	return append(slices.Clone(emails), email), true
+}
+
+// removeEmail returns the allowed emails without email, and whether that
+// changed them
+func removeEmail(emails []string, email string) ([]string, bool) {
+	remaining := slices.DeleteFunc(slices.Clone(emails), func(allowed string) bool {
+		return strings.EqualFold(allowed, email)
+	})
+	return remaining, len(remaining) != len(emails)
+}
+
+// ETag returns the unquoted ETag header of a service profile response
+func ETag(res *http.Response) string {
+	if res == nil {
+		return ""
+	}
+	return strings.Trim(res.Header.Get("ETag"), "\"")
+}
+
+// updateRequest builds the request replacing the service profile with its
+// current definition and the given allowed emails
+func updateRequest(serviceProfile *fabricv4.ServiceProfile, allowedEmails []string) fabricv4.ServiceProfileRequest {
+	request := fabricv4.ServiceProfileRequest{}
+	request.SetType(serviceProfile.GetType())
+	request.SetName(serviceProfile.GetName())
+	request.SetDescription(serviceProfile.GetDescription())
+	request.SetAllowedEmails(allowedEmails)
+	request.Notifications = serviceProfile.Notifications
+	request.Tags = serviceProfile.Tags
+	request.Visibility = serviceProfile.Visibility
+	request.AccessPointTypeConfigs = serviceProfile.AccessPointTypeConfigs
+	request.CustomFields = serviceProfile.CustomFields
+	request.MarketingInfo = serviceProfile.MarketingInfo
+	request.Ports = serviceProfile.Ports
+	request.VirtualDevices = serviceProfile.VirtualDevices
+	request.Metros = serviceProfile.Metros
+	request.SelfProfile = serviceProfile.SelfProfile
+	request.Project = serviceProfile.Project
+	return request
+}
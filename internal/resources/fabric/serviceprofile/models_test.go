@@ -0,0 +1,100 @@
+package serviceprofile
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func TestParseAccessID(t *testing.T) {
+	tests := map[string]struct {
+		id                   string
+		wantServiceProfileID string
+		wantEmail            string
+		wantOK               bool
+	}{
+		"valid":              {id: "sp-uuid/buyer@example.com", wantServiceProfileID: "sp-uuid", wantEmail: "buyer@example.com", wantOK: true},
+		"missing email":      {id: "sp-uuid/", wantServiceProfileID: "sp-uuid"},
+		"missing profile id": {id: "/buyer@example.com", wantEmail: "buyer@example.com"},
+		"no separator":       {id: "sp-uuid", wantServiceProfileID: "sp-uuid"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			serviceProfileID, email, ok := parseAccessID(tc.id)
+			if serviceProfileID != tc.wantServiceProfileID || email != tc.wantEmail || ok != tc.wantOK {
+				t.Errorf("expected (%q, %q, %v), got (%q, %q, %v)",
+					tc.wantServiceProfileID, tc.wantEmail, tc.wantOK, serviceProfileID, email, ok)
+			}
+		})
+	}
+}
+
+func TestAllowedEmailChanges(t *testing.T) {
+	emails := []string{"a@example.com", "B@example.com"}
+
+	tests := map[string]struct {
+		change      func([]string, string) ([]string, bool)
+		email       string
+		want        []string
+		wantChanged bool
+	}{
+		"add new email": {
+			change:      addEmail,
+			email:       "c@example.com",
+			want:        []string{"a@example.com", "B@example.com", "c@example.com"},
+			wantChanged: true,
+		},
+		"add existing email ignores case": {
+			change: addEmail,
+			email:  "b@EXAMPLE.com",
+			want:   []string{"a@example.com", "B@example.com"},
+		},
+		"remove email ignores case": {
+			change:      removeEmail,
+			email:       "b@example.com",
+			want:        []string{"a@example.com"},
+			wantChanged: true,
+		},
+		"remove missing email": {
+			change: removeEmail,
+			email:  "c@example.com",
+			want:   []string{"a@example.com", "B@example.com"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, changed := tc.change(emails, tc.email)
+			if !slices.Equal(got, tc.want) || changed != tc.wantChanged {
+				t.Errorf("expected (%v, %v), got (%v, %v)", tc.want, tc.wantChanged, got, changed)
+			}
+			if !slices.Equal(emails, []string{"a@example.com", "B@example.com"}) {
+				t.Errorf("input emails were modified: %v", emails)
+			}
+		})
+	}
+}
+
+func TestUpdateRequestKeepsServiceProfile(t *testing.T) {
+	serviceProfile := fabricv4.ServiceProfile{}
+	serviceProfile.SetType(fabricv4.SERVICEPROFILETYPEENUM_L2_PROFILE)
+	serviceProfile.SetName("sp")
+	serviceProfile.SetDescription("description")
+	serviceProfile.SetVisibility(fabricv4.SERVICEPROFILEVISIBILITYENUM_PRIVATE)
+	serviceProfile.SetTags([]string{"tag"})
+	serviceProfile.SetAllowedEmails([]string{"a@example.com"})
+	serviceProfile.SetSelfProfile(false)
+
+	request := updateRequest(&serviceProfile, []string{"a@example.com", "b@example.com"})
+
+	if request.GetType() != fabricv4.SERVICEPROFILETYPEENUM_L2_PROFILE || request.GetName() != "sp" ||
+		request.GetDescription() != "description" || request.GetVisibility() != fabricv4.SERVICEPROFILEVISIBILITYENUM_PRIVATE ||
+		!slices.Equal(request.GetTags(), []string{"tag"}) || request.SelfProfile == nil {
+		t.Errorf("service profile definition not carried over to the update request: %+v", request)
+	}
+	if !slices.Equal(request.GetAllowedEmails(), []string{"a@example.com", "b@example.com"}) {
+		t.Errorf("expected updated allowed emails, got %v", request.GetAllowedEmails())
+	}
+}
@@ -0,0 +1,245 @@
+package serviceprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func NewAccessResource() resource.Resource {
+	return &AccessResource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_service_profile_access",
+			},
+		),
+	}
+}
+
+type AccessResource struct {
+	framework.BaseResource
+}
+
+func (r *AccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = accessResourceSchema(ctx)
+}
+
+func (r *AccessResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan AccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	serviceProfileID := plan.ServiceProfileID.ValueString()
+	email := plan.Email.ValueString()
+	serviceProfile, err := updateAllowedEmails(ctx, client, serviceProfileID, createTimeout, func(emails []string) ([]string, bool) {
+		return addEmail(emails, email)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed granting %s access to service profile %s", email, serviceProfileID), err.Error())
+		return
+	}
+	if serviceProfile.GetVisibility() == fabricv4.SERVICEPROFILEVISIBILITYENUM_PUBLIC {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("service_profile_id"),
+			"Service profile is public",
+			fmt.Sprintf("Service profile %s is PUBLIC; allowed emails only restrict access to PRIVATE service profiles", serviceProfileID),
+		)
+	}
+
+	plan.ID = types.StringValue(accessID(serviceProfileID, email))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AccessResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state AccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	serviceProfileID := state.ServiceProfileID.ValueString()
+	serviceProfile, httpResp, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, serviceProfileID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving service profile %s", serviceProfileID), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	if serviceProfile.GetState() == fabricv4.SERVICEPROFILESTATEENUM_DELETED ||
+		!containsEmail(serviceProfile.GetAllowedEmails(), state.Email.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(accessID(serviceProfileID, state.Email.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only persists changes to timeouts; every other argument grants
+// access again
+func (r *AccessResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan AccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AccessResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state AccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	serviceProfileID := state.ServiceProfileID.ValueString()
+	email := state.Email.ValueString()
+	_, err := updateAllowedEmails(ctx, client, serviceProfileID, deleteTimeout, func(emails []string) ([]string, bool) {
+		return removeEmail(emails, email)
+	})
+	if err != nil {
+		var notFound *serviceProfileNotFoundError
+		if errors.As(err, &notFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed revoking %s access to service profile %s", email, serviceProfileID), err.Error())
+	}
+}
+
+func (r *AccessResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	serviceProfileID, email, ok := parseAccessID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid Import Identifier",
+			fmt.Sprintf("expected an identifier of the form <service_profile_id>/<email>, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_profile_id"), serviceProfileID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
+
+type serviceProfileNotFoundError struct {
+	serviceProfileID string
+}
+
+func (e *serviceProfileNotFoundError) Error() string {
+	return fmt.Sprintf("service profile %s not found", e.serviceProfileID)
+}
+
+// updateAllowedEmails applies change to the allowed emails of an active service
+// profile. The service profile is replaced conditionally on the ETag it was read
+// with, so concurrent edits are never overwritten: when the service profile
+// changes in between, or is still processing a previous update, it is read
+// again and the change reapplied until the timeout expires
+func updateAllowedEmails(
+	ctx context.Context,
+	client *fabricv4.APIClient,
+	serviceProfileID string,
+	timeout time.Duration,
+	change func([]string) ([]string, bool),
+) (*fabricv4.ServiceProfile, error) {
+	var updated *fabricv4.ServiceProfile
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		serviceProfile, httpResp, err := client.ServiceProfilesApi.GetServiceProfileByUuid(ctx, serviceProfileID).Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				return retry.NonRetryableError(&serviceProfileNotFoundError{serviceProfileID: serviceProfileID})
+			}
+			return retry.NonRetryableError(equinix_errors.FormatFabricError(err))
+		}
+		if serviceProfile.GetState() == fabricv4.SERVICEPROFILESTATEENUM_DELETED {
+			return retry.NonRetryableError(&serviceProfileNotFoundError{serviceProfileID: serviceProfileID})
+		}
+
+		allowedEmails, changed := change(serviceProfile.GetAllowedEmails())
+		if !changed {
+			updated = serviceProfile
+			return nil
+		}
+		if serviceProfile.GetState() != fabricv4.SERVICEPROFILESTATEENUM_ACTIVE {
+			return retry.RetryableError(fmt.Errorf("service profile %s is %s", serviceProfileID, serviceProfile.GetState()))
+		}
+
+		updated, httpResp, err = client.ServiceProfilesApi.PutServiceProfileByUuid(ctx, serviceProfileID).
+			IfMatch(ETag(httpResp)).
+			ServiceProfileRequest(updateRequest(serviceProfile, allowedEmails)).
+			Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusPreconditionFailed {
+				return retry.RetryableError(fmt.Errorf("service profile %s was modified concurrently", serviceProfileID))
+			}
+			return retry.NonRetryableError(equinix_errors.FormatFabricError(err))
+		}
+		return nil
+	})
+	return updated, err
+}
@@ -0,0 +1,91 @@
+package serviceprofile_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricServiceProfileAccess_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var portUUID, portType, portMetroCode string
+	if len(ports) > 0 {
+		port := ports["pfcr"]["dot1q"][0]
+		portUUID = port.GetUuid()
+		portType = string(port.GetType())
+		portLocation := port.GetLocation()
+		portMetroCode = portLocation.GetMetroCode()
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricServiceProfileAccessConfig(portUUID, portType, portMetroCode),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("equinix_fabric_service_profile_access.team_a", "email", "team-a@example.com"),
+					resource.TestCheckResourceAttrPair("equinix_fabric_service_profile_access.team_a", "service_profile_id", "equinix_fabric_service_profile.test", "uuid"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_service_profile_access.team_a", "id"),
+					resource.TestCheckResourceAttr("equinix_fabric_service_profile_access.team_b", "email", "team-b@example.com"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:      "equinix_fabric_service_profile_access.team_a",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+		},
+	})
+}
+
+func testAccFabricServiceProfileAccessConfig(portUUID, portType, portMetroCode string) string {
+	return fmt.Sprintf(`
+	resource "equinix_fabric_service_profile" "test" {
+		name        = "SP_Access_PFCR"
+		description = "Service profile access test"
+		type        = "L2_PROFILE"
+		visibility  = "PRIVATE"
+		notifications {
+			emails = ["opsuser100@equinix.com"]
+			type   = "BANDWIDTH_ALERT"
+		}
+		ports {
+			uuid = "%[1]s"
+			type = "%[2]s"
+			location {
+				metro_code = "%[3]s"
+			}
+		}
+		access_point_type_configs {
+			type                           = "COLO"
+			connection_redundancy_required = false
+			allow_remote_connections       = false
+			allow_custom_bandwidth         = true
+			supported_bandwidths           = [500]
+		}
+		lifecycle {
+			ignore_changes = [allowed_emails]
+		}
+	}
+
+	resource "equinix_fabric_service_profile_access" "team_a" {
+		service_profile_id = equinix_fabric_service_profile.test.uuid
+		email              = "team-a@example.com"
+	}
+
+	resource "equinix_fabric_service_profile_access" "team_b" {
+		service_profile_id = equinix_fabric_service_profile.test.uuid
+		email              = "team-b@example.com"
+	}
+	`, portUUID, portType, portMetroCode)
+}
@@ -7,6 +7,7 @@ import (
 
 func dataSourceBaseSchema() map[string]*schema.Schema {
 	sch := resourceSchema()
+	delete(sch, "renew_before")
 	for key := range sch {
 		if key == "uuid" {
 			sch[key].Required = true
@@ -18,6 +19,7 @@ func dataSourceBaseSchema() map[string]*schema.Schema {
 			sch[key].Computed = true
 			sch[key].MaxItems = 0
 			sch[key].ValidateFunc = nil
+			sch[key].DiffSuppressFunc = nil
 		}
 	}
 	return sch
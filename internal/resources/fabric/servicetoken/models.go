@@ -45,6 +45,106 @@ func buildCreateRequest(d *schema.ResourceData) fabricv4.ServiceToken {
 
 }
 
+const (
+	redemptionStatusUnredeemed = "UNREDEEMED"
+	redemptionStatusRedeemed   = "REDEEMED"
+	redemptionStatusExpired    = "EXPIRED"
+	redemptionStatusDeleted    = "DELETED"
+)
+
+// redemptionStatus maps the service token state to whether the token has
+// been used by a partner to create a connection
+func redemptionStatus(state fabricv4.ServiceTokenState) string {
+	switch state {
+	case fabricv4.SERVICETOKENSTATE_ACTIVE:
+		return redemptionStatusRedeemed
+	case fabricv4.SERVICETOKENSTATE_EXPIRED:
+		return redemptionStatusExpired
+	case fabricv4.SERVICETOKENSTATE_DELETED:
+		return redemptionStatusDeleted
+	default:
+		return redemptionStatusUnredeemed
+	}
+}
+
+func validateRenewBefore(v interface{}, k string) (ws []string, errs []error) {
+	renewBefore, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as 72h: %w", k, err)}
+	}
+	if renewBefore <= 0 {
+		return nil, []error{fmt.Errorf("%q must be a positive duration, got %s", k, renewBefore)}
+	}
+	return nil, nil
+}
+
+// suppressRenewedExpiration hides the difference between the configured
+// expiration and a later one set by a renewal
+func suppressRenewedExpiration(_, old, new string, d *schema.ResourceData) bool {
+	if d.Get("renew_before").(string) == "" {
+		return false
+	}
+	oldExpiration, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newExpiration, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldExpiration.After(newExpiration)
+}
+
+// renewalDue reports whether an unused service token expires within renewBefore
+func renewalDue(state fabricv4.ServiceTokenState, expiration time.Time, renewBefore time.Duration, now time.Time) bool {
+	return renewBefore > 0 && state == fabricv4.SERVICETOKENSTATE_INACTIVE && expiration.Sub(now) < renewBefore
+}
+
+// renewedExpiration pushes the expiry of a service token forward by the
+// validity period it was originally configured with
+func renewedExpiration(createdDateTime, configuredExpiration time.Time, renewBefore time.Duration, now time.Time) (time.Time, error) {
+	validity := configuredExpiration.Sub(createdDateTime)
+	if validity <= renewBefore {
+		return time.Time{}, fmt.Errorf("renew_before (%s) must be shorter than the service token validity period (%s)", renewBefore, validity.Round(time.Second))
+	}
+	return now.Add(validity), nil
+}
+
+func buildRenewalRequest(d *schema.ResourceData, serviceToken *fabricv4.ServiceToken, now time.Time) ([]fabricv4.ServiceTokenChangeOperation, error) {
+	renewBeforeConfig := d.Get("renew_before").(string)
+	if renewBeforeConfig == "" {
+		return nil, nil
+	}
+	renewBefore, err := time.ParseDuration(renewBeforeConfig)
+	if err != nil {
+		return nil, err
+	}
+	if !renewalDue(serviceToken.GetState(), serviceToken.GetExpirationDateTime(), renewBefore, now) {
+		return nil, nil
+	}
+
+	configuredExpiration := d.GetRawConfig().GetAttr("expiration_date_time")
+	if !configuredExpiration.IsKnown() || configuredExpiration.IsNull() {
+		return nil, nil
+	}
+	expirationConfig, err := time.Parse(time.RFC3339, configuredExpiration.AsString())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing expiration_date_time: %w", err)
+	}
+	changelog := serviceToken.GetChangelog()
+	expiration, err := renewedExpiration(changelog.GetCreatedDateTime(), expirationConfig, renewBefore, now)
+	if err != nil {
+		return nil, err
+	}
+
+	const TimeFormat = "2006-01-02T15:04:05.000Z"
+	return []fabricv4.ServiceTokenChangeOperation{{
+		Op:    "replace",
+		Path:  "/expirationDateTime",
+		Value: expiration.UTC().Format(TimeFormat),
+	}}, nil
+}
+
 func buildUpdateRequest(d *schema.ResourceData) ([][]fabricv4.ServiceTokenChangeOperation, error) {
 	patches := make([][]fabricv4.ServiceTokenChangeOperation, 0)
 	oldName, newName := d.GetChange("name")
@@ -263,6 +363,7 @@ func serviceTokenResponseMap(token *fabricv4.ServiceToken) map[string]interface{
 	}
 	if token.State != nil {
 		serviceToken["state"] = token.GetState()
+		serviceToken["redemption_status"] = redemptionStatus(token.GetState())
 	}
 	if token.IssuerSide != nil {
 		serviceToken["issuer_side"] = token.GetIssuerSide()
@@ -0,0 +1,96 @@
+package servicetoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func TestRenewalDue(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		state       fabricv4.ServiceTokenState
+		expiration  time.Time
+		renewBefore time.Duration
+		want        bool
+	}{
+		"unused token about to expire": {
+			state:       fabricv4.SERVICETOKENSTATE_INACTIVE,
+			expiration:  now.Add(24 * time.Hour),
+			renewBefore: 72 * time.Hour,
+			want:        true,
+		},
+		"unused token far from expiry": {
+			state:       fabricv4.SERVICETOKENSTATE_INACTIVE,
+			expiration:  now.Add(96 * time.Hour),
+			renewBefore: 72 * time.Hour,
+			want:        false,
+		},
+		"redeemed token": {
+			state:       fabricv4.SERVICETOKENSTATE_ACTIVE,
+			expiration:  now.Add(24 * time.Hour),
+			renewBefore: 72 * time.Hour,
+			want:        false,
+		},
+		"renewal disabled": {
+			state:      fabricv4.SERVICETOKENSTATE_INACTIVE,
+			expiration: now.Add(24 * time.Hour),
+			want:       false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := renewalDue(tc.state, tc.expiration, tc.renewBefore, now); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenewedExpiration(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	configured := created.Add(30 * 24 * time.Hour)
+	now := configured.Add(-24 * time.Hour)
+
+	expiration, err := renewedExpiration(created, configured, 72*time.Hour, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := now.Add(30 * 24 * time.Hour); !expiration.Equal(want) {
+		t.Errorf("expected %s, got %s", want, expiration)
+	}
+
+	if _, err := renewedExpiration(created, configured, 31*24*time.Hour, now); err == nil {
+		t.Error("expected an error when renew_before exceeds the validity period")
+	}
+}
+
+func TestRedemptionStatus(t *testing.T) {
+	tests := map[fabricv4.ServiceTokenState]string{
+		fabricv4.SERVICETOKENSTATE_INACTIVE: redemptionStatusUnredeemed,
+		fabricv4.SERVICETOKENSTATE_ACTIVE:   redemptionStatusRedeemed,
+		fabricv4.SERVICETOKENSTATE_EXPIRED:  redemptionStatusExpired,
+		fabricv4.SERVICETOKENSTATE_DELETED:  redemptionStatusDeleted,
+	}
+	for state, want := range tests {
+		if got := redemptionStatus(state); got != want {
+			t.Errorf("expected %s for state %s, got %s", want, state, got)
+		}
+	}
+}
+
+func TestValidateRenewBefore(t *testing.T) {
+	tests := map[string]bool{
+		"72h":   false,
+		"30m":   false,
+		"-1h":   true,
+		"0s":    true,
+		"3days": true,
+	}
+	for value, wantErr := range tests {
+		if _, errs := validateRenewBefore(value, "renew_before"); (len(errs) > 0) != wantErr {
+			t.Errorf("unexpected validation result for %q: %v", value, errs)
+		}
+	}
+}
@@ -28,6 +28,7 @@ func Resource() *schema.Resource {
 		CreateContext: resourceCreate,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
+		CustomizeDiff: resourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -38,8 +39,13 @@ func Resource() *schema.Resource {
 
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
-	serviceToken, _, err := client.ServiceTokensApi.GetServiceTokenByUuid(ctx, d.Id()).Execute()
+	serviceToken, body, err := client.ServiceTokensApi.GetServiceTokenByUuid(ctx, d.Id()).Execute()
 	if err != nil {
+		if body != nil && body.StatusCode >= 400 && body.StatusCode <= 499 && d.Get("redemption_status").(string) == redemptionStatusRedeemed {
+			// Redeemed service tokens belong to the connection that consumed them
+			log.Printf("[WARN] Redeemed Service Token %s is no longer returned, keeping it in state, error %s", d.Id(), err)
+			return nil
+		}
 		log.Printf("[WARN] Service Token %s not found , error %s", d.Id(), err)
 		if !strings.Contains(err.Error(), "500") {
 			d.SetId("")
@@ -82,11 +88,24 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("either timed out or errored out while fetching Fabric Service Token for uuid %s and error %v", d.Id(), err)
 	}
 	diags := diag.Diagnostics{}
+	if dbToken.GetState() == fabricv4.SERVICETOKENSTATE_ACTIVE {
+		diags = append(diags, diag.Diagnostic{Severity: 1, Summary: fmt.Sprintf("service token %s has been redeemed and is managed by its connection; configuration changes are not applied", d.Id())})
+		return append(diags, setServiceTokenMap(d, dbToken)...)
+	}
 	updates, err := buildUpdateRequest(d)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{Severity: 1, Summary: err.Error()})
 		return diags
 	}
+	if !hasExpirationUpdate(updates) {
+		renewal, err := buildRenewalRequest(d, dbToken, time.Now())
+		if err != nil {
+			return append(diags, diag.Errorf("error renewing service token %s: %v", d.Id(), err)...)
+		}
+		if renewal != nil {
+			updates = append(updates, renewal)
+		}
+	}
 	for _, update := range updates {
 		_, _, err = client.ServiceTokensApi.UpdateServiceTokenByUuid(ctx, d.Id()).ServiceTokenChangeOperation(update).Execute()
 		if err != nil {
@@ -108,6 +127,10 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
+	if d.Get("redemption_status").(string) == redemptionStatusRedeemed {
+		log.Printf("[WARN] Service Token %s has been redeemed and is managed by its connection, removing it from state only", d.Id())
+		return diags
+	}
 	client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
 
 	start := time.Now()
@@ -131,11 +154,44 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{
 	return diags
 }
 
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("renew_before").(string) == "" {
+		return nil
+	}
+	renewBefore, err := time.ParseDuration(d.Get("renew_before").(string))
+	if err != nil {
+		return err
+	}
+	expirationState, _ := d.GetChange("expiration_date_time")
+	expiration, err := time.Parse(time.RFC3339, expirationState.(string))
+	if err != nil {
+		return nil
+	}
+	if renewalDue(fabricv4.ServiceTokenState(d.Get("state").(string)), expiration, renewBefore, time.Now()) {
+		// Plan an in-place update so the expiry is renewed on apply
+		return d.SetNewComputed("change_log")
+	}
+	return nil
+}
+
+func hasExpirationUpdate(updates [][]fabricv4.ServiceTokenChangeOperation) bool {
+	for _, update := range updates {
+		for _, operation := range update {
+			if operation.Path == "/expirationDateTime" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func waitForStability(ctx context.Context, uuid string, meta interface{}, d *schema.ResourceData, timeout time.Duration) (*fabricv4.ServiceToken, error) {
-	log.Printf("Waiting for service token to be created, uuid %s", uuid)
+	log.Printf("Waiting for service token to be stable, uuid %s", uuid)
 	stateConf := &retry.StateChangeConf{
 		Target: []string{
 			string(fabricv4.SERVICETOKENSTATE_INACTIVE),
+			string(fabricv4.SERVICETOKENSTATE_ACTIVE),
+			string(fabricv4.SERVICETOKENSTATE_EXPIRED),
 		},
 		Refresh: func() (interface{}, string, error) {
 			client := meta.(*config.Config).NewFabricClientForSDK(ctx, d)
@@ -146,16 +202,15 @@ func waitForStability(ctx context.Context, uuid string, meta interface{}, d *sch
 			currentState := string(serviceToken.GetState())
 			return serviceToken, currentState, nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	inter, err := stateConf.WaitForStateContext(ctx)
+	inter, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 	var serviceToken *fabricv4.ServiceToken
 
 	if err != nil {
-		log.Printf("[ERROR] Error while waiting for service token to go to INACTIVE state: %v", err)
+		log.Printf("[ERROR] Error while waiting for service token to go to a stable state: %v", err)
 		return nil, err
 	}
 
@@ -171,6 +226,8 @@ func WaitForDeletion(ctx context.Context, uuid string, meta interface{}, d *sche
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			string(fabricv4.SERVICETOKENSTATE_INACTIVE),
+			string(fabricv4.SERVICETOKENSTATE_ACTIVE),
+			string(fabricv4.SERVICETOKENSTATE_EXPIRED),
 		},
 		Target: []string{
 			string(fabricv4.SERVICETOKENSTATE_DELETED),
@@ -187,12 +244,11 @@ func WaitForDeletion(ctx context.Context, uuid string, meta interface{}, d *sche
 			}
 			return serviceToken, string(serviceToken.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := meta.(*config.Config).WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -42,9 +42,21 @@ func resourceSchema() map[string]*schema.Schema {
 			Description: "Optional Description to the Service Token you will be creating",
 		},
 		"expiration_date_time": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressRenewedExpiration,
+			Description:      "Expiration date and time of the service token; 2020-11-06T07:00:00Z",
+		},
+		"renew_before": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateRenewBefore,
+			Description:  "Duration before expiry, e.g. 72h, within which an unused service token has its expiration date and time pushed forward in place by its original validity period",
+		},
+		"redemption_status": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Expiration date and time of the service token; 2020-11-06T07:00:00Z",
+			Computed:    true,
+			Description: "Redemption status of the service token; UNREDEEMED, REDEEMED, EXPIRED, DELETED",
 		},
 		"service_token_connection": {
 			Type:        schema.TypeSet,
@@ -0,0 +1,76 @@
+package statistics
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewDataSourceConnectionStats() datasource.DataSource {
+	return &DataSourceConnectionStats{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_connection_stats",
+			},
+		),
+	}
+}
+
+type DataSourceConnectionStats struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourceConnectionStats) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceConnectionStatsSchema(ctx)
+}
+
+func (r *DataSourceConnectionStats) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data DataSourceConnectionStatsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := data.window()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	viewPoint := fabricv4.VIEWPOINT_A_SIDE
+	if data.ViewPoint.ValueString() != "" {
+		viewPoint = fabricv4.ViewPoint(data.ViewPoint.ValueString())
+	}
+
+	stats, _, err := client.StatisticsApi.GetConnectionStatsByPortUuid(ctx, data.ConnectionID.ValueString()).
+		StartDateTime(window.start).
+		EndDateTime(window.end).
+		ViewPoint(viewPoint).
+		Execute()
+	if err != nil {
+		response.State.RemoveResource(ctx)
+		response.Diagnostics.AddError("api error retrieving connection stats data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	data.ID = types.StringValue(data.ConnectionID.ValueString())
+	data.ViewPoint = types.StringValue(string(viewPoint))
+	response.Diagnostics.Append(data.parse(ctx, stats, window)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
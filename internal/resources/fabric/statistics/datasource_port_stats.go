@@ -0,0 +1,67 @@
+package statistics
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewDataSourcePortStats() datasource.DataSource {
+	return &DataSourcePortStats{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_port_stats",
+			},
+		),
+	}
+}
+
+type DataSourcePortStats struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourcePortStats) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourcePortStatsSchema(ctx)
+}
+
+func (r *DataSourcePortStats) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	var data DataSourcePortStatsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := data.window()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	stats, _, err := client.StatisticsApi.GetPortStatsByPortUuid(ctx, data.PortID.ValueString()).
+		StartDateTime(window.start).
+		EndDateTime(window.end).
+		Execute()
+	if err != nil {
+		response.State.RemoveResource(ctx)
+		response.Diagnostics.AddError("api error retrieving port stats data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	data.ID = types.StringValue(data.PortID.ValueString())
+	response.Diagnostics.Append(data.parse(ctx, stats, window)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
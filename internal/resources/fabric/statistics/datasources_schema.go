@@ -0,0 +1,128 @@
+package statistics
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func dataSourceConnectionStatsSchema(ctx context.Context) schema.Schema {
+	attributes := statsSchema(ctx)
+	attributes["connection_id"] = schema.StringAttribute{
+		Description: "The uuid of the connection to retrieve utilization statistics for",
+		Required:    true,
+	}
+	attributes["view_point"] = schema.StringAttribute{
+		Description: "Side of the connection the statistics are measured at. One of aSide, zSide. Defaults to aSide",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				string(fabricv4.VIEWPOINT_A_SIDE),
+				string(fabricv4.VIEWPOINT_Z_SIDE),
+			),
+		},
+	}
+
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data source that allows user to fetch bandwidth utilization statistics of an Equinix Fabric Connection for a time window
+
+Additional Documentation:
+* API: https://developer.equinix.com/catalog/fabricv4#tag/Statistics`,
+		Attributes: attributes,
+	}
+}
+
+func dataSourcePortStatsSchema(ctx context.Context) schema.Schema {
+	attributes := statsSchema(ctx)
+	attributes["port_id"] = schema.StringAttribute{
+		Description: "The uuid of the port to retrieve utilization statistics for",
+		Required:    true,
+	}
+
+	return schema.Schema{
+		Description: `Fabric V4 API compatible data source that allows user to fetch bandwidth utilization statistics of an Equinix Fabric Port for a time window
+
+Additional Documentation:
+* API: https://developer.equinix.com/catalog/fabricv4#tag/Statistics`,
+		Attributes: attributes,
+	}
+}
+
+func statsSchema(ctx context.Context) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": framework.IDAttributeDefaultDescription(),
+		"start_date_time": schema.StringAttribute{
+			Description: "Start of the statistics window as an RFC 3339 timestamp, i.e. 2024-01-01T00:00:00Z",
+			Required:    true,
+		},
+		"end_date_time": schema.StringAttribute{
+			Description: "End of the statistics window as an RFC 3339 timestamp, i.e. 2024-01-02T00:00:00Z",
+			Required:    true,
+		},
+		"granularity": schema.StringAttribute{
+			Description: "ISO 8601 duration, i.e. PT15M or PT1H, to merge the returned metrics into. Ignored when it is finer than the interval chosen by the API for the window",
+			Optional:    true,
+		},
+		"unit": schema.StringAttribute{
+			Description: "Unit of the utilization values, i.e. Mbps",
+			Computed:    true,
+		},
+		"metric_interval": schema.StringAttribute{
+			Description: "Interval between the returned metrics",
+			Computed:    true,
+		},
+		"inbound":  directionSchema(ctx, "Inbound utilization"),
+		"outbound": directionSchema(ctx, "Outbound utilization"),
+	}
+}
+
+func directionSchema(ctx context.Context, description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		CustomType:  fwtypes.NewObjectTypeOf[DirectionModel](ctx),
+		Attributes: map[string]schema.Attribute{
+			"max": schema.Float64Attribute{
+				Description: "Peak utilization over the window",
+				Computed:    true,
+			},
+			"mean": schema.Float64Attribute{
+				Description: "Mean utilization over the window",
+				Computed:    true,
+			},
+			"p95": schema.Float64Attribute{
+				Description: "95th percentile of the per-interval peak utilization over the window",
+				Computed:    true,
+			},
+			"metrics": schema.ListNestedAttribute{
+				Description: "Utilization series ordered by interval end",
+				Computed:    true,
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[MetricModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interval_end_date_time": schema.StringAttribute{
+							Description: "End time of the interval",
+							Computed:    true,
+						},
+						"max": schema.Float64Attribute{
+							Description: "Peak utilization in the interval",
+							Computed:    true,
+						},
+						"mean": schema.Float64Attribute{
+							Description: "Mean utilization in the interval",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
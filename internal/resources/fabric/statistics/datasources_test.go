@@ -0,0 +1,53 @@
+package statistics_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFabricPortStatsDataSource_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var portUUID string
+	if len(ports) > 0 {
+		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+	}
+	end := time.Now().UTC().Truncate(time.Hour)
+	start := end.Add(-24 * time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricPortStatsDataSourceConfig(portUUID, start.Format(time.RFC3339), end.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_stats.test", "id", portUUID),
+					resource.TestCheckResourceAttr("data.equinix_fabric_port_stats.test", "metric_interval", "PT1H"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_stats.test", "unit"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_stats.test", "inbound.max"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_stats.test", "inbound.p95"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_stats.test", "outbound.max"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_port_stats.test", "outbound.p95"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFabricPortStatsDataSourceConfig(portUUID, start, end string) string {
+	return fmt.Sprintf(`
+	data "equinix_fabric_port_stats" "test" {
+		port_id         = "%s"
+		start_date_time = "%s"
+		end_date_time   = "%s"
+		granularity     = "PT1H"
+	}
+	`, portUUID, start, end)
+}
@@ -0,0 +1,238 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceConnectionStatsModel struct {
+	ID           types.String `tfsdk:"id"`
+	ConnectionID types.String `tfsdk:"connection_id"`
+	ViewPoint    types.String `tfsdk:"view_point"`
+	StatsModel
+}
+
+type DataSourcePortStatsModel struct {
+	ID     types.String `tfsdk:"id"`
+	PortID types.String `tfsdk:"port_id"`
+	StatsModel
+}
+
+type StatsModel struct {
+	StartDateTime  types.String                          `tfsdk:"start_date_time"`
+	EndDateTime    types.String                          `tfsdk:"end_date_time"`
+	Granularity    types.String                          `tfsdk:"granularity"`
+	Unit           types.String                          `tfsdk:"unit"`
+	MetricInterval types.String                          `tfsdk:"metric_interval"`
+	Inbound        fwtypes.ObjectValueOf[DirectionModel] `tfsdk:"inbound"`
+	Outbound       fwtypes.ObjectValueOf[DirectionModel] `tfsdk:"outbound"`
+}
+
+type DirectionModel struct {
+	Max     types.Float64                                `tfsdk:"max"`
+	Mean    types.Float64                                `tfsdk:"mean"`
+	P95     types.Float64                                `tfsdk:"p95"`
+	Metrics fwtypes.ListNestedObjectValueOf[MetricModel] `tfsdk:"metrics"`
+}
+
+type MetricModel struct {
+	IntervalEndDateTime types.String  `tfsdk:"interval_end_date_time"`
+	Max                 types.Float64 `tfsdk:"max"`
+	Mean                types.Float64 `tfsdk:"mean"`
+}
+
+// statsWindow is the parsed time window and optional granularity of a stats
+// data source configuration
+type statsWindow struct {
+	start       time.Time
+	end         time.Time
+	granularity time.Duration
+}
+
+func (m *StatsModel) window() (statsWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var window statsWindow
+	var err error
+
+	window.start, err = time.Parse(time.RFC3339, m.StartDateTime.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("start_date_time"), "invalid start_date_time", "start_date_time must be an RFC 3339 timestamp, i.e. 2024-01-01T00:00:00Z")
+	}
+	window.end, err = time.Parse(time.RFC3339, m.EndDateTime.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("end_date_time"), "invalid end_date_time", "end_date_time must be an RFC 3339 timestamp, i.e. 2024-01-02T00:00:00Z")
+	}
+	if diags.HasError() {
+		return window, diags
+	}
+	if !window.end.After(window.start) {
+		diags.AddAttributeError(path.Root("end_date_time"), "invalid end_date_time", "end_date_time must be after start_date_time")
+		return window, diags
+	}
+
+	if !m.Granularity.IsNull() && !m.Granularity.IsUnknown() {
+		window.granularity, err = parseDuration(m.Granularity.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("granularity"), "invalid granularity", err.Error())
+		}
+	}
+
+	return window, diags
+}
+
+var durationRegex = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?$`)
+
+// parseDuration parses the time part of an ISO 8601 duration, i.e. PT15M or PT1H
+func parseDuration(value string) (time.Duration, error) {
+	matches := durationRegex.FindStringSubmatch(value)
+	if matches == nil || value == "PT" {
+		return 0, fmt.Errorf("%q is not an ISO 8601 duration like PT5M, PT15M or PT1H", value)
+	}
+	var duration time.Duration
+	for index, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if matches[index+1] == "" {
+			continue
+		}
+		amount, err := strconv.Atoi(matches[index+1])
+		if err != nil {
+			return 0, err
+		}
+		duration += time.Duration(amount) * unit
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("%q must be a positive duration", value)
+	}
+	return duration, nil
+}
+
+func (m *StatsModel) parse(ctx context.Context, stats *fabricv4.Statistics, window statsWindow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	utilization := stats.GetBandwidthUtilization()
+	m.Unit = types.StringValue(string(utilization.GetUnit()))
+	m.MetricInterval = types.StringValue(utilization.GetMetricInterval())
+
+	apiInterval, err := parseDuration(utilization.GetMetricInterval())
+	resample := window.granularity > 0 && (err != nil || window.granularity > apiInterval)
+	if resample {
+		m.MetricInterval = types.StringValue(m.Granularity.ValueString())
+	}
+
+	inbound := utilization.GetInbound()
+	outbound := utilization.GetOutbound()
+	m.Inbound = parseDirection(ctx, inbound, window, resample)
+	m.Outbound = parseDirection(ctx, outbound, window, resample)
+
+	return diags
+}
+
+func parseDirection(ctx context.Context, direction fabricv4.Direction, window statsWindow, resample bool) fwtypes.ObjectValueOf[DirectionModel] {
+	metrics := direction.GetMetrics()
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].GetIntervalEndTimestamp().Before(metrics[j].GetIntervalEndTimestamp())
+	})
+
+	maxValues := make([]float64, len(metrics))
+	for index, metric := range metrics {
+		maxValues[index] = float32Value(metric.GetMax()).ValueFloat64()
+	}
+
+	if resample {
+		metrics = resampleMetrics(metrics, window)
+	}
+
+	metricModels := make([]MetricModel, len(metrics))
+	for index, metric := range metrics {
+		metricModels[index] = MetricModel{
+			IntervalEndDateTime: types.StringValue(metric.GetIntervalEndTimestamp().Format(fabric.TimeFormat)),
+			Max:                 float32Value(metric.GetMax()),
+			Mean:                float32Value(metric.GetMean()),
+		}
+	}
+
+	directionModel := DirectionModel{
+		Max:     float32Value(direction.GetMax()),
+		Mean:    float32Value(direction.GetMean()),
+		P95:     types.Float64Value(percentile(maxValues, 95)),
+		Metrics: fwtypes.NewListNestedObjectValueOfValueSlice[MetricModel](ctx, metricModels),
+	}
+	return fwtypes.NewObjectValueOf[DirectionModel](ctx, &directionModel)
+}
+
+// resampleMetrics merges consecutive API intervals into buckets of the
+// configured granularity, keeping the peak of the maxima and the average of
+// the means of each bucket. Metrics must be sorted by interval end.
+func resampleMetrics(metrics []fabricv4.Metrics, window statsWindow) []fabricv4.Metrics {
+	var resampled []fabricv4.Metrics
+	var bucket int64 = -1
+	var meanSum float32
+	var count int
+
+	for _, metric := range metrics {
+		elapsed := metric.GetIntervalEndTimestamp().Sub(window.start)
+		metricBucket := int64(0)
+		if elapsed > 0 {
+			metricBucket = int64((elapsed - 1) / window.granularity)
+		}
+		if metricBucket != bucket {
+			bucket = metricBucket
+			meanSum = 0
+			count = 0
+			bucketEnd := window.start.Add(time.Duration(bucket+1) * window.granularity)
+			if bucketEnd.After(window.end) {
+				bucketEnd = window.end
+			}
+			merged := fabricv4.Metrics{}
+			merged.SetIntervalEndTimestamp(bucketEnd)
+			merged.SetMax(metric.GetMax())
+			resampled = append(resampled, merged)
+		}
+		current := &resampled[len(resampled)-1]
+		if metric.GetMax() > current.GetMax() {
+			current.SetMax(metric.GetMax())
+		}
+		meanSum += metric.GetMean()
+		count++
+		current.SetMean(meanSum / float32(count))
+	}
+
+	return resampled
+}
+
+// percentile returns the nearest-rank percentile of the given values
+func percentile(values []float64, rank float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	index := int(math.Ceil(rank/100*float64(len(sorted)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index]
+}
+
+// float32Value widens an API float32 using its shortest decimal representation
+// so that, for example, 0.35 is not reported as 0.3499999940395355
+func float32Value(value float32) types.Float64 {
+	widened, err := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+	if err != nil {
+		return types.Float64Value(float64(value))
+	}
+	return types.Float64Value(widened)
+}
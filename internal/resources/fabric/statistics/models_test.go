@@ -0,0 +1,80 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		"minutes":          {value: "PT15M", want: 15 * time.Minute},
+		"hours":            {value: "PT1H", want: time.Hour},
+		"hours and minute": {value: "PT1H30M", want: 90 * time.Minute},
+		"empty time part":  {value: "PT", wantErr: true},
+		"zero":             {value: "PT0M", wantErr: true},
+		"go duration":      {value: "15m", wantErr: true},
+		"days":             {value: "P1D", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseDuration(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestResampleMetrics(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	window := statsWindow{start: start, end: start.Add(time.Hour), granularity: 30 * time.Minute}
+
+	var metrics []fabricv4.Metrics
+	for index, values := range [][2]float32{{10, 4}, {30, 8}, {20, 6}, {5, 2}} {
+		metric := fabricv4.Metrics{}
+		metric.SetIntervalEndTimestamp(start.Add(time.Duration(index+1) * 15 * time.Minute))
+		metric.SetMax(values[0])
+		metric.SetMean(values[1])
+		metrics = append(metrics, metric)
+	}
+
+	resampled := resampleMetrics(metrics, window)
+	if len(resampled) != 2 {
+		t.Fatalf("expected 2 buckets, got %d", len(resampled))
+	}
+	if resampled[0].GetMax() != 30 || resampled[0].GetMean() != 6 {
+		t.Errorf("unexpected first bucket: max %v mean %v", resampled[0].GetMax(), resampled[0].GetMean())
+	}
+	if !resampled[0].GetIntervalEndTimestamp().Equal(start.Add(30 * time.Minute)) {
+		t.Errorf("unexpected first bucket end: %s", resampled[0].GetIntervalEndTimestamp())
+	}
+	if resampled[1].GetMax() != 20 || resampled[1].GetMean() != 4 {
+		t.Errorf("unexpected second bucket: max %v mean %v", resampled[1].GetMax(), resampled[1].GetMean())
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := make([]float64, 100)
+	for index := range values {
+		values[index] = float64(100 - index)
+	}
+	if got := percentile(values, 95); got != 95 {
+		t.Errorf("expected 95, got %v", got)
+	}
+	if got := percentile(nil, 95); got != 0 {
+		t.Errorf("expected 0 for no values, got %v", got)
+	}
+	if got := percentile([]float64{0.35}, 95); got != 0.35 {
+		t.Errorf("expected 0.35, got %v", got)
+	}
+}
@@ -73,7 +73,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, stream.GetUuid(), createTimeout)
-	streamChecked, err := createWaiter.WaitForStateContext(ctx)
+	streamChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed creating Stream %s", stream.GetUuid()), err.Error())
@@ -175,7 +175,7 @@ func (r *Resource) Update(
 	}
 
 	updateWaiter := getCreateUpdateWaiter(ctx, client, id, updateTimeout)
-	streamChecked, err := updateWaiter.WaitForStateContext(ctx)
+	streamChecked, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed updating Stream %s", id), err.Error())
@@ -224,7 +224,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deleteWaiter := getDeleteWaiter(ctx, client, id, deleteTimeout)
-	_, err = deleteWaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -269,9 +269,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, id s
 			}
 			return stream, stream.GetState(), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
 
@@ -298,8 +297,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, id string,
 			}
 			return stream, stream.GetState(), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
@@ -16,7 +16,7 @@ import (
 func AddTestSweeper() {
 	resource.AddTestSweepers("equinix_fabric_stream", &resource.Sweeper{
 		Name:         "equinix_fabric_stream",
-		Dependencies: []string{},
+		Dependencies: []string{"equinix_fabric_stream_alert_rule"},
 		F:            testSweepStreams,
 	})
 }
@@ -0,0 +1,61 @@
+package streamalertrule
+
+import (
+	"context"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func NewDataSourceByIDs() datasource.DataSource {
+	return &DataSourceByIDs{
+		BaseDataSource: framework.NewBaseDataSource(
+			framework.BaseDataSourceConfig{
+				Name: "equinix_fabric_stream_alert_rule",
+			},
+		),
+	}
+}
+
+type DataSourceByIDs struct {
+	framework.BaseDataSource
+}
+
+func (r *DataSourceByIDs) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = dataSourceStreamAlertRuleByIDs(ctx)
+}
+
+func (r *DataSourceByIDs) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	client := r.Meta.NewFabricClientForFramework(ctx, request.ProviderMeta)
+
+	// Retrieve values from plan
+	var data dataSourceByIDsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Use API client to get the current state of the resource
+	streamAlertRule, _, err := client.StreamAlertRulesApi.GetStreamAlertRuleByUuid(ctx, data.StreamID.ValueString(), data.AlertRuleID.ValueString()).Execute()
+
+	if err != nil {
+		response.State.RemoveResource(ctx)
+		response.Diagnostics.AddError("api error retrieving stream alert rule data", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// Set state to fully populated data
+	response.Diagnostics.Append(data.parse(ctx, data.StreamID.ValueString(), streamAlertRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the Terraform state
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
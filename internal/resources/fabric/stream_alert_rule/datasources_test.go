@@ -0,0 +1,3 @@
+package streamalertrule_test
+
+// Tested in resource_test.go because of the heavy resource setup constraints
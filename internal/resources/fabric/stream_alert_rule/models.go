@@ -0,0 +1,211 @@
+package streamalertrule
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/equinix/terraform-provider-equinix/internal/fabric"
+	int_fw "github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceByIDsModel struct {
+	ID          types.String `tfsdk:"id"`
+	StreamID    types.String `tfsdk:"stream_id"`
+	AlertRuleID types.String `tfsdk:"alert_rule_id"`
+	baseStreamAlertRuleModel
+}
+
+type dataSourceAll struct {
+	ID         types.String                                              `tfsdk:"id"`
+	StreamID   types.String                                              `tfsdk:"stream_id"`
+	Pagination fwtypes.ObjectValueOf[paginationModel]                    `tfsdk:"pagination"`
+	Data       fwtypes.ListNestedObjectValueOf[baseStreamAlertRuleModel] `tfsdk:"data"`
+}
+
+type paginationModel struct {
+	Offset   types.Int32  `tfsdk:"offset"`
+	Limit    types.Int32  `tfsdk:"limit"`
+	Total    types.Int32  `tfsdk:"total"`
+	Next     types.String `tfsdk:"next"`
+	Previous types.String `tfsdk:"previous"`
+}
+
+type resourceModel struct {
+	StreamID types.String   `tfsdk:"stream_id"`
+	ID       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	baseStreamAlertRuleModel
+}
+
+type baseStreamAlertRuleModel struct {
+	Type              types.String                                 `tfsdk:"type"`
+	Name              types.String                                 `tfsdk:"name"`
+	Description       types.String                                 `tfsdk:"description"`
+	Enabled           types.Bool                                   `tfsdk:"enabled"`
+	MetricName        types.String                                 `tfsdk:"metric_name"`
+	ResourceSelector  fwtypes.ObjectValueOf[resourceSelectorModel] `tfsdk:"resource_selector"`
+	WindowSize        types.String                                 `tfsdk:"window_size"`
+	Operand           types.String                                 `tfsdk:"operand"`
+	WarningThreshold  types.String                                 `tfsdk:"warning_threshold"`
+	CriticalThreshold types.String                                 `tfsdk:"critical_threshold"`
+	Href              types.String                                 `tfsdk:"href"`
+	UUID              types.String                                 `tfsdk:"uuid"`
+	State             types.String                                 `tfsdk:"state"`
+	ChangeLog         fwtypes.ObjectValueOf[changeLogModel]        `tfsdk:"change_log"`
+}
+
+type resourceSelectorModel struct {
+	Include fwtypes.ListValueOf[types.String] `tfsdk:"include"`
+}
+
+type changeLogModel struct {
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedByFullName types.String `tfsdk:"created_by_full_name"`
+	CreatedByEmail    types.String `tfsdk:"created_by_email"`
+	CreatedDateTime   types.String `tfsdk:"created_date_time"`
+	UpdatedBy         types.String `tfsdk:"updated_by"`
+	UpdatedByFullName types.String `tfsdk:"updated_by_full_name"`
+	UpdatedByEmail    types.String `tfsdk:"updated_by_email"`
+	UpdatedDateTime   types.String `tfsdk:"updated_date_time"`
+	DeletedBy         types.String `tfsdk:"deleted_by"`
+	DeletedByFullName types.String `tfsdk:"deleted_by_full_name"`
+	DeletedByEmail    types.String `tfsdk:"deleted_by_email"`
+	DeletedDateTime   types.String `tfsdk:"deleted_date_time"`
+}
+
+// getAllStreamAlertRulesResponse is the list response of the stream alert
+// rules endpoint. The SDK decodes that response into a single StreamAlertRule,
+// which leaves the pagination and data keys in its AdditionalProperties.
+type getAllStreamAlertRulesResponse struct {
+	Pagination fabricv4.Pagination        `json:"pagination"`
+	Data       []fabricv4.StreamAlertRule `json:"data"`
+}
+
+func parseGetAllStreamAlertRulesResponse(streamAlertRules *fabricv4.StreamAlertRule) (*getAllStreamAlertRulesResponse, error) {
+	var response getAllStreamAlertRulesResponse
+	if streamAlertRules == nil {
+		return &response, nil
+	}
+	body, err := json.Marshal(streamAlertRules.AdditionalProperties)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (m *dataSourceByIDsModel) parse(ctx context.Context, streamID string, streamAlertRule *fabricv4.StreamAlertRule) diag.Diagnostics {
+	m.StreamID = types.StringValue(streamID)
+	m.AlertRuleID = types.StringValue(streamAlertRule.GetUuid())
+	m.ID = types.StringValue(streamAlertRule.GetUuid())
+
+	diags := m.baseStreamAlertRuleModel.parse(ctx, streamAlertRule)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func (m *dataSourceAll) parse(ctx context.Context, streamID string, streamAlertRulesResponse *getAllStreamAlertRulesResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(streamAlertRulesResponse.Data) < 1 {
+		diags.AddError("no data retrieved by stream alert rules data source",
+			"either the stream does not have any alert rules to pull or the combination of limit and offset needs to be updated")
+		return diags
+	}
+
+	data := make([]baseStreamAlertRuleModel, len(streamAlertRulesResponse.Data))
+	for index, streamAlertRule := range streamAlertRulesResponse.Data {
+		var streamAlertRuleModel baseStreamAlertRuleModel
+		diags = streamAlertRuleModel.parse(ctx, &streamAlertRule)
+		if diags.HasError() {
+			return diags
+		}
+		data[index] = streamAlertRuleModel
+	}
+	responsePagination := streamAlertRulesResponse.Pagination
+	pagination := paginationModel{
+		Offset:   types.Int32Value(responsePagination.GetOffset()),
+		Limit:    types.Int32Value(responsePagination.GetLimit()),
+		Total:    types.Int32Value(responsePagination.GetTotal()),
+		Next:     types.StringValue(responsePagination.GetNext()),
+		Previous: types.StringValue(responsePagination.GetPrevious()),
+	}
+
+	m.ID = types.StringValue(streamID)
+	m.StreamID = types.StringValue(streamID)
+	m.Pagination = fwtypes.NewObjectValueOf[paginationModel](ctx, &pagination)
+	m.Data = fwtypes.NewListNestedObjectValueOfValueSlice[baseStreamAlertRuleModel](ctx, data)
+
+	return diags
+}
+
+func (m *resourceModel) parse(ctx context.Context, streamAlertRule *fabricv4.StreamAlertRule) diag.Diagnostics {
+	m.ID = types.StringValue(streamAlertRule.GetUuid())
+
+	diags := m.baseStreamAlertRuleModel.parse(ctx, streamAlertRule)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func (m *baseStreamAlertRuleModel) parse(ctx context.Context, streamAlertRule *fabricv4.StreamAlertRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Type = types.StringValue(string(streamAlertRule.GetType()))
+	m.Name = types.StringValue(streamAlertRule.GetName())
+	m.Description = types.StringValue(streamAlertRule.GetDescription())
+	m.Enabled = types.BoolValue(streamAlertRule.GetEnabled())
+	m.MetricName = types.StringValue(string(streamAlertRule.GetMetricName()))
+	m.WindowSize = types.StringValue(streamAlertRule.GetWindowSize())
+	m.Operand = types.StringValue(string(streamAlertRule.GetOperand()))
+	m.WarningThreshold = types.StringValue(streamAlertRule.GetWarningThreshold())
+	m.CriticalThreshold = types.StringValue(streamAlertRule.GetCriticalThreshold())
+	m.Href = types.StringValue(streamAlertRule.GetHref())
+	m.UUID = types.StringValue(streamAlertRule.GetUuid())
+	m.State = types.StringValue(string(streamAlertRule.GetState()))
+
+	// Parse ResourceSelector
+	resourceSelector := streamAlertRule.GetResourceSelector()
+	inclusions, diags := fwtypes.NewListValueOf[types.String](ctx, int_fw.StringSliceToAttrValue(resourceSelector.GetInclude()))
+	if diags.HasError() {
+		return diags
+	}
+	selector := resourceSelectorModel{
+		Include: inclusions,
+	}
+	m.ResourceSelector = fwtypes.NewObjectValueOf[resourceSelectorModel](ctx, &selector)
+
+	// Parse ChangeLog
+	streamAlertRuleChangeLog := streamAlertRule.GetChangeLog()
+	changeLog := changeLogModel{
+		CreatedBy:         types.StringValue(streamAlertRuleChangeLog.GetCreatedBy()),
+		CreatedByFullName: types.StringValue(streamAlertRuleChangeLog.GetCreatedByFullName()),
+		CreatedByEmail:    types.StringValue(streamAlertRuleChangeLog.GetCreatedByEmail()),
+		CreatedDateTime:   types.StringValue(streamAlertRuleChangeLog.GetCreatedDateTime().Format(fabric.TimeFormat)),
+		UpdatedBy:         types.StringValue(streamAlertRuleChangeLog.GetUpdatedBy()),
+		UpdatedByFullName: types.StringValue(streamAlertRuleChangeLog.GetUpdatedByFullName()),
+		UpdatedByEmail:    types.StringValue(streamAlertRuleChangeLog.GetUpdatedByEmail()),
+		UpdatedDateTime:   types.StringValue(streamAlertRuleChangeLog.GetUpdatedDateTime().Format(fabric.TimeFormat)),
+		DeletedBy:         types.StringValue(streamAlertRuleChangeLog.GetDeletedBy()),
+		DeletedByFullName: types.StringValue(streamAlertRuleChangeLog.GetDeletedByFullName()),
+		DeletedByEmail:    types.StringValue(streamAlertRuleChangeLog.GetDeletedByEmail()),
+		DeletedDateTime:   types.StringValue(streamAlertRuleChangeLog.GetDeletedDateTime().Format(fabric.TimeFormat)),
+	}
+	m.ChangeLog = fwtypes.NewObjectValueOf[changeLogModel](ctx, &changeLog)
+
+	return diags
+}
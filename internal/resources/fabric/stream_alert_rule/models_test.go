@@ -0,0 +1,36 @@
+package streamalertrule
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+)
+
+func TestParseGetAllStreamAlertRulesResponse(t *testing.T) {
+	body := `{
+		"pagination": {"offset": 0, "limit": 20, "total": 2},
+		"data": [
+			{"uuid": "rule-1", "name": "first", "operand": "ABOVE", "state": "ACTIVE"},
+			{"uuid": "rule-2", "name": "second", "operand": "BELOW", "state": "INACTIVE"}
+		]
+	}`
+	var streamAlertRule fabricv4.StreamAlertRule
+	if err := json.Unmarshal([]byte(body), &streamAlertRule); err != nil {
+		t.Fatalf("unexpected error decoding SDK response: %v", err)
+	}
+
+	response, err := parseGetAllStreamAlertRulesResponse(&streamAlertRule)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Pagination.GetTotal() != 2 || response.Pagination.GetLimit() != 20 {
+		t.Errorf("unexpected pagination: %+v", response.Pagination)
+	}
+	if len(response.Data) != 2 {
+		t.Fatalf("expected 2 alert rules, got %d", len(response.Data))
+	}
+	if response.Data[1].GetUuid() != "rule-2" || response.Data[1].GetOperand() != fabricv4.STREAMALERTRULEOPERAND_BELOW {
+		t.Errorf("unexpected second alert rule: %+v", response.Data[1])
+	}
+}
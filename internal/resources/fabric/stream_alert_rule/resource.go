@@ -0,0 +1,310 @@
+package streamalertrule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// NewResource creates a new stream alert rule resource
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_fabric_stream_alert_rule",
+			},
+		),
+	}
+}
+
+// Resource represents the stream alert rule
+type Resource struct {
+	framework.BaseResource
+}
+
+// Schema returns the resource schema
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+// Create provisions a new stream alert rule
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve the API client from the provider metadata
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	createRequest, diags := buildCreateRequest(ctx, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	streamAlertRule, _, err := client.StreamAlertRulesApi.CreateStreamAlertRules(ctx, plan.StreamID.ValueString()).AlertRulePostRequest(createRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("failed creating stream alert rule", equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// Parse API response into the Terraform state
+	resp.Diagnostics.Append(plan.parse(ctx, streamAlertRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read retrieves the stream alert rule
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state resourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve the API client from the provider metadata
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	// Extract the ID of the resource from the state
+	id := state.ID.ValueString()
+	streamID := state.StreamID.ValueString()
+
+	streamAlertRule, getResp, err := client.StreamAlertRulesApi.GetStreamAlertRuleByUuid(ctx, streamID, id).Execute()
+	if err != nil {
+		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed retrieving stream alert rule %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(state.parse(ctx, streamAlertRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update modifies an existing stream alert rule
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	// Retrieve values from plan
+	var state, plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	streamID := state.StreamID.ValueString()
+
+	updateRequest, diags := buildUpdateRequest(ctx, plan)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	streamAlertRule, _, err := client.StreamAlertRulesApi.UpdateStreamAlertRuleByUuid(ctx, streamID, id).AlertRulePutRequest(updateRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed updating stream alert rule %s", id), equinix_errors.FormatFabricError(err).Error())
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(plan.parse(ctx, streamAlertRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the updated state back into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the stream alert rule
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Retrieve the API client
+	client := r.Meta.NewFabricClientForFramework(ctx, req.ProviderMeta)
+
+	// Retrieve the current state
+	var state resourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	streamID := state.StreamID.ValueString()
+
+	_, deleteResp, err := client.StreamAlertRulesApi.DeleteStreamAlertRuleByUuid(ctx, streamID, id).Execute()
+	if err != nil {
+		if deleteResp == nil || !slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, deleteResp.StatusCode) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("failed deleting stream alert rule %s", id), equinix_errors.FormatFabricError(err).Error())
+			return
+		}
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	deleteWaiter := getDeleteWaiter(ctx, client, streamID, id, deleteTimeout)
+	_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed deleting stream alert rule %s", id), err.Error())
+		return
+	}
+}
+
+func buildCreateRequest(ctx context.Context, plan resourceModel) (fabricv4.AlertRulePostRequest, diag.Diagnostics) {
+	request := fabricv4.AlertRulePostRequest{}
+
+	request.SetType(fabricv4.AlertRulePostRequestType(plan.Type.ValueString()))
+
+	putRequest, diags := buildUpdateRequest(ctx, plan)
+	if diags.HasError() {
+		return fabricv4.AlertRulePostRequest{}, diags
+	}
+
+	request.SetName(putRequest.GetName())
+	request.SetMetricName(putRequest.GetMetricName())
+	request.SetResourceSelector(putRequest.GetResourceSelector())
+	request.SetOperand(putRequest.GetOperand())
+	request.SetCriticalThreshold(putRequest.GetCriticalThreshold())
+	if putRequest.HasDescription() {
+		request.SetDescription(putRequest.GetDescription())
+	}
+	if putRequest.HasEnabled() {
+		request.SetEnabled(putRequest.GetEnabled())
+	}
+	if putRequest.HasWindowSize() {
+		request.SetWindowSize(putRequest.GetWindowSize())
+	}
+	if putRequest.HasWarningThreshold() {
+		request.SetWarningThreshold(putRequest.GetWarningThreshold())
+	}
+
+	return request, diags
+}
+
+func buildUpdateRequest(ctx context.Context, plan resourceModel) (fabricv4.AlertRulePutRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := fabricv4.AlertRulePutRequest{}
+
+	request.SetName(plan.Name.ValueString())
+	request.SetMetricName(fabricv4.StreamAlertRuleMetricName(plan.MetricName.ValueString()))
+	request.SetOperand(fabricv4.StreamAlertRuleOperand(plan.Operand.ValueString()))
+	request.SetCriticalThreshold(plan.CriticalThreshold.ValueString())
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		request.SetDescription(plan.Description.ValueString())
+	}
+
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		request.SetEnabled(plan.Enabled.ValueBool())
+	}
+
+	if !plan.WindowSize.IsNull() && !plan.WindowSize.IsUnknown() {
+		request.SetWindowSize(plan.WindowSize.ValueString())
+	}
+
+	if !plan.WarningThreshold.IsNull() && !plan.WarningThreshold.IsUnknown() {
+		request.SetWarningThreshold(plan.WarningThreshold.ValueString())
+	}
+
+	if !plan.ResourceSelector.IsNull() && !plan.ResourceSelector.IsUnknown() {
+		var selectorValue resourceSelectorModel
+		diags = plan.ResourceSelector.As(ctx, &selectorValue, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return fabricv4.AlertRulePutRequest{}, diags
+		}
+
+		var resourceSelector fabricv4.ResourceSelector
+		include := []string{}
+		diags = selectorValue.Include.ElementsAs(ctx, &include, false)
+		if diags.HasError() {
+			return fabricv4.AlertRulePutRequest{}, diags
+		}
+		resourceSelector.SetInclude(include)
+		request.SetResourceSelector(resourceSelector)
+	}
+
+	return request, diags
+}
+
+func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, streamID, streamAlertRuleID string, timeout time.Duration) *retry.StateChangeConf {
+	// deletedMarker is a terraform-provider-only value that is used by the waiter
+	// to indicate that the alert rule appears to be deleted successfully based on
+	// status code
+	deletedMarker := "tf-marker-for-deleted-stream-alert-rule"
+	return &retry.StateChangeConf{
+		Pending: []string{
+			string(fabricv4.STREAMALERTRULESTATE_ACTIVE),
+			string(fabricv4.STREAMALERTRULESTATE_INACTIVE),
+		},
+		Target: []string{
+			deletedMarker,
+		},
+		Refresh: func() (interface{}, string, error) {
+			streamAlertRule, resp, err := client.StreamAlertRulesApi.GetStreamAlertRuleByUuid(ctx, streamID, streamAlertRuleID).Execute()
+			if err != nil {
+				if resp != nil && slices.Contains([]int{http.StatusForbidden, http.StatusNotFound}, resp.StatusCode) {
+					return streamAlertRule, deletedMarker, nil
+				}
+				return 0, "", err
+			}
+			return streamAlertRule, string(streamAlertRule.GetState()), nil
+		},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+}
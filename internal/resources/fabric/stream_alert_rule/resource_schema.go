@@ -0,0 +1,204 @@
+package streamalertrule
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Stream Alert Rules
+
+Additional Documentation:
+* Getting Started: https://docs.equinix.com/en-us/Content/KnowledgeCenter/Fabric/GettingStarted/Integrating-with-Fabric-V4-APIs/IntegrateWithSink.htm
+* API: https://developer.equinix.com/catalog/fabricv4#tag/Stream-Alert-Rules`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+			"stream_id": schema.StringAttribute{
+				Description: "The uuid of the stream that is the target of the stream alert rule",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the stream alert rule. Defaults to METRIC_ALERT",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(fabricv4.ALERTRULEPOSTREQUESTTYPE_METRIC_ALERT)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(fabricv4.ALERTRULEPOSTREQUESTTYPE_METRIC_ALERT),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Customer-provided stream alert rule name",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Customer-provided stream alert rule description",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Stream alert rule enabled status",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metric_name": schema.StringAttribute{
+				Description: "Stream alert rule metric name, i.e. equinix.fabric.connection.bandwidth_tx.usage, equinix.fabric.port.packets_dropped_rx.count or equinix.fabric.metro.<source_metro_code>_<destination_metro_code>.latency",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resource_selector": schema.SingleNestedAttribute{
+				Description: "Lists of resources the stream alert rule is evaluated against",
+				Required:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[resourceSelectorModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"include": schema.ListAttribute{
+						Description: "List of resource hrefs to include, i.e. */connections/<connection_uuid>",
+						ElementType: types.StringType,
+						CustomType:  fwtypes.ListOfStringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"window_size": schema.StringAttribute{
+				Description: "Stream alert rule metric window size, i.e. PT15M",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operand": schema.StringAttribute{
+				Description: "Stream alert rule metric operand. One of ABOVE, BELOW",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(fabricv4.STREAMALERTRULEOPERAND_ABOVE),
+						string(fabricv4.STREAMALERTRULEOPERAND_BELOW),
+					),
+				},
+			},
+			"warning_threshold": schema.StringAttribute{
+				Description: "Stream alert rule metric warning threshold",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"critical_threshold": schema.StringAttribute{
+				Description: "Stream alert rule metric critical threshold",
+				Required:    true,
+			},
+			"href": schema.StringAttribute{
+				Description: "Equinix assigned URI of the stream alert rule resource",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Equinix assigned unique identifier of the stream alert rule resource",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Value representing the state of the stream alert rule. One of ACTIVE, INACTIVE",
+				Computed:    true,
+			},
+			"change_log": schema.SingleNestedAttribute{
+				Description: "Details of the last change on the stream alert rule resource",
+				Computed:    true,
+				CustomType:  fwtypes.NewObjectTypeOf[changeLogModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"created_by": schema.StringAttribute{
+						Description: "User name of creator of the stream alert rule resource",
+						Computed:    true,
+					},
+					"created_by_full_name": schema.StringAttribute{
+						Description: "Legal name of creator of the stream alert rule resource",
+						Computed:    true,
+					},
+					"created_by_email": schema.StringAttribute{
+						Description: "Email of creator of the stream alert rule resource",
+						Computed:    true,
+					},
+					"created_date_time": schema.StringAttribute{
+						Description: "Creation time of the stream alert rule resource",
+						Computed:    true,
+					},
+					"updated_by": schema.StringAttribute{
+						Description: "User name of last updater of the stream alert rule resource",
+						Computed:    true,
+					},
+					"updated_by_full_name": schema.StringAttribute{
+						Description: "Legal name of last updater of the stream alert rule resource",
+						Computed:    true,
+					},
+					"updated_by_email": schema.StringAttribute{
+						Description: "Email of last updater of the stream alert rule resource",
+						Computed:    true,
+					},
+					"updated_date_time": schema.StringAttribute{
+						Description: "Last update time of the stream alert rule resource",
+						Computed:    true,
+					},
+					"deleted_by": schema.StringAttribute{
+						Description: "User name of deleter of the stream alert rule resource",
+						Computed:    true,
+					},
+					"deleted_by_full_name": schema.StringAttribute{
+						Description: "Legal name of deleter of the stream alert rule resource",
+						Computed:    true,
+					},
+					"deleted_by_email": schema.StringAttribute{
+						Description: "Email of deleter of the stream alert rule resource",
+						Computed:    true,
+					},
+					"deleted_date_time": schema.StringAttribute{
+						Description: "Deletion time of the stream alert rule resource",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
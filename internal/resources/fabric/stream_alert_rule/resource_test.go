@@ -0,0 +1,128 @@
+package streamalertrule_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+	"github.com/equinix/terraform-provider-equinix/internal/config"
+	testinghelpers "github.com/equinix/terraform-provider-equinix/internal/fabric/testing_helpers"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func CheckStreamAlertRuleDelete(s *terraform.State) error {
+	ctx := context.Background()
+	client := acceptance.TestAccProvider.Meta().(*config.Config).NewFabricClientForTesting(ctx)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "equinix_fabric_stream_alert_rule" {
+			continue
+		}
+
+		streamID := rs.Primary.Attributes["stream_id"]
+
+		if _, _, err := client.StreamAlertRulesApi.GetStreamAlertRuleByUuid(ctx, streamID, rs.Primary.ID).Execute(); err == nil {
+			return fmt.Errorf("fabric stream alert rule %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccFabricStreamAlertRuleConfig(portUUID, criticalThreshold string) string {
+	return fmt.Sprintf(`
+		resource "equinix_fabric_stream" "new_stream" {
+		  type = "TELEMETRY_STREAM"
+		  name = "Alert_Rule_Test_PFCR"
+		  description = "Testing stream alert rules resource"
+		  project = {
+			project_id = "291639000636552"
+		  }
+		}
+
+		resource "equinix_fabric_stream_alert_rule" "port_rx" {
+		  stream_id = equinix_fabric_stream.new_stream.id
+		  name = "Port_RX_PFCR"
+		  description = "Stream Alert Rule TF Testing"
+		  enabled = true
+		  metric_name = "equinix.fabric.port.bandwidth_rx.usage"
+		  resource_selector = {
+			include = ["*/ports/%s"]
+		  }
+		  window_size = "PT15M"
+		  operand = "ABOVE"
+		  warning_threshold = "40000000"
+		  critical_threshold = "%s"
+		}
+
+		data "equinix_fabric_stream_alert_rule" "by_ids" {
+		  stream_id = equinix_fabric_stream.new_stream.id
+		  alert_rule_id = equinix_fabric_stream_alert_rule.port_rx.id
+		}
+
+		data "equinix_fabric_stream_alert_rules" "all" {
+		  depends_on = [equinix_fabric_stream_alert_rule.port_rx]
+		  stream_id = equinix_fabric_stream.new_stream.id
+		  pagination = {
+			limit = 20
+			offset = 0
+		  }
+		}
+	`, portUUID, criticalThreshold)
+}
+
+func TestAccFabricStreamAlertRule_PFCR(t *testing.T) {
+	ports := testinghelpers.GetFabricEnvPorts(t)
+	var portUUID string
+	if len(ports) > 0 {
+		portUUID = ports["pfcr"]["dot1q"][0].GetUuid()
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t); acceptance.TestAccPreCheckProviderConfigured(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             CheckStreamAlertRuleDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFabricStreamAlertRuleConfig(portUUID, "50000000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "name", "Port_RX_PFCR"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "type", "METRIC_ALERT"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "metric_name", "equinix.fabric.port.bandwidth_rx.usage"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "operand", "ABOVE"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "critical_threshold", "50000000"),
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "resource_selector.include.#", "1"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_alert_rule.port_rx", "stream_id"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_alert_rule.port_rx", "state"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_alert_rule.port_rx", "uuid"),
+					resource.TestCheckResourceAttr(
+						"data.equinix_fabric_stream_alert_rule.by_ids", "name", "Port_RX_PFCR"),
+					resource.TestCheckResourceAttr(
+						"data.equinix_fabric_stream_alert_rule.by_ids", "operand", "ABOVE"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_alert_rule.by_ids", "uuid"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_alert_rules.all", "data.0.name"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_alert_rules.all", "data.0.metric_name"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_alert_rules.all", "data.0.uuid"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_alert_rules.all", "pagination.total"),
+				),
+			},
+			{
+				Config: testAccFabricStreamAlertRuleConfig(portUUID, "60000000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"equinix_fabric_stream_alert_rule.port_rx", "critical_threshold", "60000000"),
+					resource.TestCheckResourceAttr(
+						"data.equinix_fabric_stream_alert_rule.by_ids", "critical_threshold", "60000000"),
+				),
+			},
+		},
+	})
+}
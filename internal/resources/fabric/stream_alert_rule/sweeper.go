@@ -0,0 +1,67 @@
+package streamalertrule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/sweep"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func AddTestSweeper() {
+	resource.AddTestSweepers("equinix_fabric_stream_alert_rule", &resource.Sweeper{
+		Name:         "equinix_fabric_stream_alert_rule",
+		Dependencies: []string{},
+		F:            testSweepStreamAlertRules,
+	})
+}
+
+func testSweepStreamAlertRules(_ string) error {
+	var errs []error
+	log.Printf("[DEBUG] Sweeping Fabric Stream Alert Rules")
+	ctx := context.Background()
+	meta, err := sweep.GetConfigForFabric()
+	if err != nil {
+		return fmt.Errorf("error getting configuration for sweeping Stream Alert Rules: %s", err)
+	}
+	configLoadErr := meta.Load(ctx)
+	if configLoadErr != nil {
+		return fmt.Errorf("error loading configuration for sweeping Stream Alert Rules: %s", err)
+	}
+	fabric := meta.NewFabricClientForTesting(ctx)
+	limit := int32(100)
+
+	streams, _, err := fabric.StreamsApi.GetStreams(ctx).Limit(limit).Execute()
+	if err != nil {
+		return fmt.Errorf("error getting streams list for sweeping fabric stream alert rules: %s", err)
+	}
+
+	for _, stream := range streams.GetData() {
+		streamAlertRules, _, err := fabric.StreamAlertRulesApi.GetStreamAlertRules(ctx, stream.GetUuid()).Limit(limit).Execute()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting fabric stream alert rules on stream %s: %s", stream.GetUuid(), err))
+			continue
+		}
+		streamAlertRulesResponse, err := parseGetAllStreamAlertRulesResponse(streamAlertRules)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error decoding fabric stream alert rules on stream %s: %s", stream.GetUuid(), err))
+			continue
+		}
+		for _, alertRule := range streamAlertRulesResponse.Data {
+			if sweep.IsSweepableFabricTestResource(alertRule.GetName()) {
+				log.Printf("[DEBUG] Deleting stream alert rule: %s", alertRule.GetName())
+				_, resp, err := fabric.StreamAlertRulesApi.DeleteStreamAlertRuleByUuid(ctx, stream.GetUuid(), alertRule.GetUuid()).Execute()
+				if equinix_errors.IgnoreHttpResponseErrors(http.StatusForbidden, http.StatusNotFound)(resp, err) != nil {
+					errs = append(errs, fmt.Errorf("error deleting fabric stream alert rule %s on stream %s: %s", alertRule.GetUuid(), stream.GetUuid(), err))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
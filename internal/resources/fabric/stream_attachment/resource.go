@@ -70,7 +70,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, assetID, asset, streamID, createTimeout)
-	attachment, err := createWaiter.WaitForStateContext(ctx)
+	attachment, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed creating stream attachment %s", attachment.(*fabricv4.StreamAsset).GetUuid()), err.Error())
@@ -160,7 +160,7 @@ func (r *Resource) Update(
 	}
 
 	updateWaiter := getCreateUpdateWaiter(ctx, client, assetID, asset, streamID, updateTimeout)
-	attachment, err := updateWaiter.WaitForStateContext(ctx)
+	attachment, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed updating stream attachment %s", id), err.Error())
@@ -211,7 +211,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deleteWaiter := getDeleteWaiter(ctx, client, assetID, asset, streamID, deleteTimeout)
-	_, err = deleteWaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -236,9 +236,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, asse
 			}
 			return stream, string(stream.GetAttachmentStatus()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
 
@@ -267,8 +266,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, assetID, a
 			}
 			return stream, string(stream.GetAttachmentStatus()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
@@ -143,10 +143,6 @@ func getStreamSubscriptionSchema(ctx context.Context) map[string]schema.Attribut
 			Computed:    true,
 			CustomType:  fwtypes.NewObjectTypeOf[sinkModel](ctx),
 			Attributes: map[string]schema.Attribute{
-				"uri": schema.StringAttribute{
-					Description: "Publicly reachable http endpoint destination for data stream",
-					Computed:    true,
-				},
 				"type": schema.StringAttribute{
 					Description: "Type of the subscriber",
 					Computed:    true,
@@ -163,63 +159,137 @@ func getStreamSubscriptionSchema(ctx context.Context) map[string]schema.Attribut
 					Description: "Maximum time to wait for batch delivery if enabled",
 					Computed:    true,
 				},
-				"host": schema.StringAttribute{
-					Description: "Known hostname of certain data stream subscription products. Not to be confused with a variable URI",
+				"splunk_hec": schema.SingleNestedAttribute{
+					Description: "Splunk HTTP Event Collector subscriber",
 					Computed:    true,
-				},
-				"credential": schema.SingleNestedAttribute{
-					Description: "Access details for the specified sink type",
-					Computed:    true,
-					CustomType:  fwtypes.NewObjectTypeOf[sinkCredentialModel](ctx),
+					CustomType:  fwtypes.NewObjectTypeOf[splunkHECSinkModel](ctx),
 					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							Description: "Type of the credential being passed",
+						"uri": schema.StringAttribute{
+							Description: "Splunk HEC endpoint",
 							Computed:    true,
 						},
 						"access_token": schema.StringAttribute{
-							Description: "Passed as Authorization header value",
+							Description: "Splunk HEC token",
 							Computed:    true,
+							Sensitive:   true,
 						},
-						"integration_key": schema.StringAttribute{
-							Description: "Passed as Authorization header value",
-							Computed:    true,
-						},
-						"api_key": schema.StringAttribute{
-							Description: "Passed as Authorization header value",
+						"event_index": schema.StringAttribute{
+							Description: "Splunk index receiving events",
 							Computed:    true,
 						},
-						"username": schema.StringAttribute{
-							Description: "Passed as Authorization header value",
+						"metric_index": schema.StringAttribute{
+							Description: "Splunk index receiving metrics",
 							Computed:    true,
 						},
-						"password": schema.StringAttribute{
-							Description: "Passed as Authorization header value",
+						"source": schema.StringAttribute{
+							Description: "Splunk source of the delivered data",
 							Computed:    true,
 						},
 					},
 				},
-				"settings": schema.SingleNestedAttribute{
-					Description: "Stream subscription sink settings",
+				"datadog": schema.SingleNestedAttribute{
+					Description: "Datadog subscriber",
 					Computed:    true,
-					CustomType:  fwtypes.NewObjectTypeOf[sinkCredentialModel](ctx),
+					CustomType:  fwtypes.NewObjectTypeOf[datadogSinkModel](ctx),
 					Attributes: map[string]schema.Attribute{
-						"event_index": schema.StringAttribute{
-							Computed: true,
+						"host": schema.StringAttribute{
+							Description: "Datadog site host",
+							Computed:    true,
 						},
-						"metric_index": schema.StringAttribute{
-							Computed: true,
+						"api_key": schema.StringAttribute{
+							Description: "Datadog API key",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"application_key": schema.StringAttribute{
+							Description: "Datadog application key",
+							Computed:    true,
+							Sensitive:   true,
 						},
 						"source": schema.StringAttribute{
-							Computed: true,
+							Description: "Datadog source of the delivered data",
+							Computed:    true,
 						},
-						"application_key": schema.StringAttribute{
-							Computed: true,
+						"event_uri": schema.StringAttribute{
+							Description: "Datadog endpoint receiving events",
+							Computed:    true,
+						},
+						"metric_uri": schema.StringAttribute{
+							Description: "Datadog endpoint receiving metrics",
+							Computed:    true,
+						},
+					},
+				},
+				"pagerduty": schema.SingleNestedAttribute{
+					Description: "PagerDuty subscriber",
+					Computed:    true,
+					CustomType:  fwtypes.NewObjectTypeOf[pagerDutySinkModel](ctx),
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "PagerDuty events host",
+							Computed:    true,
+						},
+						"integration_key": schema.StringAttribute{
+							Description: "PagerDuty integration key",
+							Computed:    true,
+							Sensitive:   true,
 						},
 						"event_uri": schema.StringAttribute{
-							Computed: true,
+							Description: "PagerDuty endpoint receiving change events",
+							Computed:    true,
 						},
 						"metric_uri": schema.StringAttribute{
-							Computed: true,
+							Description: "PagerDuty endpoint receiving alert events",
+							Computed:    true,
+						},
+					},
+				},
+				"slack": schema.SingleNestedAttribute{
+					Description: "Slack subscriber",
+					Computed:    true,
+					CustomType:  fwtypes.NewObjectTypeOf[uriSinkModel](ctx),
+					Attributes: map[string]schema.Attribute{
+						"uri": schema.StringAttribute{
+							Description: "Slack incoming webhook URL",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+				"teams": schema.SingleNestedAttribute{
+					Description: "Microsoft Teams subscriber",
+					Computed:    true,
+					CustomType:  fwtypes.NewObjectTypeOf[uriSinkModel](ctx),
+					Attributes: map[string]schema.Attribute{
+						"uri": schema.StringAttribute{
+							Description: "Microsoft Teams incoming webhook URL",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+				"webhook": schema.SingleNestedAttribute{
+					Description: "Generic webhook subscriber",
+					Computed:    true,
+					CustomType:  fwtypes.NewObjectTypeOf[webhookSinkModel](ctx),
+					Attributes: map[string]schema.Attribute{
+						"uri": schema.StringAttribute{
+							Description: "Publicly reachable https endpoint receiving the data stream",
+							Computed:    true,
+						},
+						"access_token": schema.StringAttribute{
+							Description: "Token passed as Authorization header value",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Username for basic authentication",
+							Computed:    true,
+						},
+						"password": schema.StringAttribute{
+							Description: "Password for basic authentication",
+							Computed:    true,
+							Sensitive:   true,
 						},
 					},
 				},
@@ -64,34 +64,53 @@ type selectorModel struct {
 }
 
 type sinkModel struct {
-	URI              types.String                               `tfsdk:"uri"`
-	Type             types.String                               `tfsdk:"type"`
-	BatchEnabled     types.Bool                                 `tfsdk:"batch_enabled"`
-	BatchSizeMax     types.Int32                                `tfsdk:"batch_size_max"`
-	BatchWaitTimeMax types.Int32                                `tfsdk:"batch_wait_time_max"`
-	Host             types.String                               `tfsdk:"host"`
-	Credential       fwtypes.ObjectValueOf[sinkCredentialModel] `tfsdk:"credential"` // Object of CredentialModel
-	Settings         fwtypes.ObjectValueOf[sinkSettingsModel]   `tfsdk:"settings"`   // Object of SinkSettingsModel
+	Type             types.String                              `tfsdk:"type"`
+	BatchEnabled     types.Bool                                `tfsdk:"batch_enabled"`
+	BatchSizeMax     types.Int32                               `tfsdk:"batch_size_max"`
+	BatchWaitTimeMax types.Int32                               `tfsdk:"batch_wait_time_max"`
+	SplunkHEC        fwtypes.ObjectValueOf[splunkHECSinkModel] `tfsdk:"splunk_hec"` // Object of SplunkHECSinkModel
+	Datadog          fwtypes.ObjectValueOf[datadogSinkModel]   `tfsdk:"datadog"`    // Object of DatadogSinkModel
+	PagerDuty        fwtypes.ObjectValueOf[pagerDutySinkModel] `tfsdk:"pagerduty"`  // Object of PagerDutySinkModel
+	Slack            fwtypes.ObjectValueOf[uriSinkModel]       `tfsdk:"slack"`      // Object of URISinkModel
+	Teams            fwtypes.ObjectValueOf[uriSinkModel]       `tfsdk:"teams"`      // Object of URISinkModel
+	Webhook          fwtypes.ObjectValueOf[webhookSinkModel]   `tfsdk:"webhook"`    // Object of WebhookSinkModel
 }
 
-type sinkCredentialModel struct {
-	Type           types.String `tfsdk:"type"`
-	AccessToken    types.String `tfsdk:"access_token"`
-	IntegrationKey types.String `tfsdk:"integration_key"`
-	APIKey         types.String `tfsdk:"api_key"`
-	Username       types.String `tfsdk:"username"`
-	Password       types.String `tfsdk:"password"`
+type splunkHECSinkModel struct {
+	URI         types.String `tfsdk:"uri"`
+	AccessToken types.String `tfsdk:"access_token"`
+	EventIndex  types.String `tfsdk:"event_index"`
+	MetricIndex types.String `tfsdk:"metric_index"`
+	Source      types.String `tfsdk:"source"`
 }
 
-type sinkSettingsModel struct {
-	EventIndex     types.String `tfsdk:"event_index"`
-	MetricIndex    types.String `tfsdk:"metric_index"`
-	Source         types.String `tfsdk:"source"`
+type datadogSinkModel struct {
+	Host           types.String `tfsdk:"host"`
+	APIKey         types.String `tfsdk:"api_key"`
 	ApplicationKey types.String `tfsdk:"application_key"`
+	Source         types.String `tfsdk:"source"`
+	EventURI       types.String `tfsdk:"event_uri"`
+	MetricURI      types.String `tfsdk:"metric_uri"`
+}
+
+type pagerDutySinkModel struct {
+	Host           types.String `tfsdk:"host"`
+	IntegrationKey types.String `tfsdk:"integration_key"`
 	EventURI       types.String `tfsdk:"event_uri"`
 	MetricURI      types.String `tfsdk:"metric_uri"`
 }
 
+type uriSinkModel struct {
+	URI types.String `tfsdk:"uri"`
+}
+
+type webhookSinkModel struct {
+	URI         types.String `tfsdk:"uri"`
+	AccessToken types.String `tfsdk:"access_token"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+}
+
 type changeLogModel struct {
 	CreatedBy         types.String `tfsdk:"created_by"`
 	CreatedByFullName types.String `tfsdk:"created_by_full_name"`
@@ -195,9 +214,9 @@ func (m *baseStreamSubscriptionModel) parse(ctx context.Context, streamSubscript
 	}
 	m.EventSelector = eventSelectorObject
 
-	planSinkModel := sinkModel{}
+	priorSinkModel := sinkModel{}
 	if !m.Sink.IsNull() && !m.Sink.IsUnknown() {
-		diags = m.Sink.As(ctx, &planSinkModel, basetypes.ObjectAsOptions{})
+		diags = m.Sink.As(ctx, &priorSinkModel, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			mDiags.Append(diags...)
 			return mDiags
@@ -205,77 +224,12 @@ func (m *baseStreamSubscriptionModel) parse(ctx context.Context, streamSubscript
 	}
 
 	// Parse Sink
-	streamSubSink := streamSubscription.GetSink()
-	sink := sinkModel{
-		URI:              types.StringValue(streamSubSink.GetUri()),
-		Type:             types.StringValue(string(streamSubSink.GetType())),
-		BatchEnabled:     types.BoolValue(streamSubSink.GetBatchEnabled()),
-		BatchSizeMax:     types.Int32Value(streamSubSink.GetBatchSizeMax()),
-		BatchWaitTimeMax: types.Int32Value(streamSubSink.GetBatchWaitTimeMax()),
-		Host:             types.StringValue(streamSubSink.GetHost()),
-	}
-
-	if planSinkModel.URI.ValueString() != "" {
-		sink.URI = types.StringValue(planSinkModel.URI.ValueString())
-	}
-
-	sinkCredential := streamSubSink.GetCredential()
-	credentialModel := sinkCredentialModel{
-		Type:           types.StringValue(string(sinkCredential.GetType())),
-		AccessToken:    types.StringValue(sinkCredential.GetAccessToken()),
-		IntegrationKey: types.StringValue(sinkCredential.GetIntegrationKey()),
-		APIKey:         types.StringValue(sinkCredential.GetApiKey()),
-		Username:       types.StringValue(sinkCredential.GetUsername()),
-		Password:       types.StringValue(sinkCredential.GetPassword()),
-	}
-
-	if !planSinkModel.Credential.IsNull() && !planSinkModel.Credential.IsUnknown() {
-		planCredentialModel := sinkCredentialModel{}
-		diags = planSinkModel.Credential.As(ctx, &planCredentialModel, basetypes.ObjectAsOptions{})
-		if diags.HasError() {
-			mDiags.Append(diags...)
-			return mDiags
-		}
-		switch fabricv4.StreamSubscriptionSinkCredentialType(planCredentialModel.Type.ValueString()) {
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_ACCESS_TOKEN:
-			credentialModel.AccessToken = types.StringValue(planCredentialModel.AccessToken.ValueString())
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_API_KEY:
-			credentialModel.APIKey = types.StringValue(planCredentialModel.APIKey.ValueString())
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_INTEGRATION_KEY:
-			credentialModel.IntegrationKey = types.StringValue(planCredentialModel.IntegrationKey.ValueString())
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_USERNAME_PASSWORD:
-			credentialModel.Username = types.StringValue(planCredentialModel.Username.ValueString())
-			credentialModel.Password = types.StringValue(planCredentialModel.Password.ValueString())
-		}
-	}
-
-	sink.Credential = fwtypes.NewObjectValueOf[sinkCredentialModel](ctx, &credentialModel)
-
-	streamSubSinkSettings := streamSubSink.GetSettings()
-	sinkSettings := sinkSettingsModel{
-		EventIndex:     types.StringValue(streamSubSinkSettings.GetEventIndex()),
-		MetricIndex:    types.StringValue(streamSubSinkSettings.GetMetricIndex()),
-		Source:         types.StringValue(streamSubSinkSettings.GetSource()),
-		ApplicationKey: types.StringValue(streamSubSinkSettings.GetApplicationKey()),
-		EventURI:       types.StringValue(streamSubSinkSettings.GetEventUri()),
-		MetricURI:      types.StringValue(streamSubSinkSettings.GetMetricUri()),
-	}
-
-	if !planSinkModel.Settings.IsNull() && !planSinkModel.Settings.IsUnknown() {
-		planSettingsModel := sinkSettingsModel{}
-		diags = planSinkModel.Settings.As(ctx, &planSettingsModel, basetypes.ObjectAsOptions{})
-		if diags.HasError() {
-			mDiags.Append(diags...)
-			return mDiags
-		}
-		if planSettingsModel.ApplicationKey.ValueString() != "" {
-			sinkSettings.ApplicationKey = types.StringValue(planSettingsModel.ApplicationKey.ValueString())
-		}
+	sinkObject, diags := parseSinkModel(ctx, streamSubscription.GetSink(), priorSinkModel)
+	if diags.HasError() {
+		mDiags.Append(diags...)
+		return mDiags
 	}
-
-	sink.Settings = fwtypes.NewObjectValueOf[sinkSettingsModel](ctx, &sinkSettings)
-
-	m.Sink = fwtypes.NewObjectValueOf[sinkModel](ctx, &sink)
+	m.Sink = sinkObject
 
 	// Parse ChangeLog
 	streamSubscriptionChangeLog := streamSubscription.GetChangeLog()
@@ -314,3 +268,121 @@ func parseSelectorModel(ctx context.Context, streamSubSelector fabricv4.StreamSu
 	}
 	return fwtypes.NewObjectValueOf[selectorModel](ctx, &selector), diags
 }
+
+// parseSinkModel maps the API sink into the typed sink block matching its type.
+// Credentials and the Slack and Teams URIs, which embed a token, are masked or
+// omitted by the API, so for those secrets the values already known from the
+// prior plan or state take precedence over the response.
+func parseSinkModel(ctx context.Context, streamSubSink fabricv4.StreamSubscriptionSink, prior sinkModel) (fwtypes.ObjectValueOf[sinkModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sinkCredential := streamSubSink.GetCredential()
+	sinkSettings := streamSubSink.GetSettings()
+
+	sink := sinkModel{
+		Type:             types.StringValue(string(streamSubSink.GetType())),
+		BatchEnabled:     types.BoolValue(streamSubSink.GetBatchEnabled()),
+		BatchSizeMax:     types.Int32Value(streamSubSink.GetBatchSizeMax()),
+		BatchWaitTimeMax: types.Int32Value(streamSubSink.GetBatchWaitTimeMax()),
+		SplunkHEC:        fwtypes.NewObjectValueOfNull[splunkHECSinkModel](ctx),
+		Datadog:          fwtypes.NewObjectValueOfNull[datadogSinkModel](ctx),
+		PagerDuty:        fwtypes.NewObjectValueOfNull[pagerDutySinkModel](ctx),
+		Slack:            fwtypes.NewObjectValueOfNull[uriSinkModel](ctx),
+		Teams:            fwtypes.NewObjectValueOfNull[uriSinkModel](ctx),
+		Webhook:          fwtypes.NewObjectValueOfNull[webhookSinkModel](ctx),
+	}
+
+	switch streamSubSink.GetType() {
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SPLUNK_HEC:
+		priorSplunk := splunkHECSinkModel{}
+		diags.Append(objectAsIfKnown(ctx, prior.SplunkHEC, &priorSplunk)...)
+		splunk := splunkHECSinkModel{
+			URI:         types.StringValue(streamSubSink.GetUri()),
+			AccessToken: secretOrPrior(sinkCredential.GetAccessToken(), priorSplunk.AccessToken),
+			EventIndex:  types.StringValue(sinkSettings.GetEventIndex()),
+			MetricIndex: types.StringValue(sinkSettings.GetMetricIndex()),
+			Source:      types.StringValue(sinkSettings.GetSource()),
+		}
+		sink.SplunkHEC = fwtypes.NewObjectValueOf[splunkHECSinkModel](ctx, &splunk)
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_DATADOG:
+		priorDatadog := datadogSinkModel{}
+		diags.Append(objectAsIfKnown(ctx, prior.Datadog, &priorDatadog)...)
+		datadog := datadogSinkModel{
+			Host:           types.StringValue(streamSubSink.GetHost()),
+			APIKey:         secretOrPrior(sinkCredential.GetApiKey(), priorDatadog.APIKey),
+			ApplicationKey: secretOrPrior(sinkSettings.GetApplicationKey(), priorDatadog.ApplicationKey),
+			Source:         types.StringValue(sinkSettings.GetSource()),
+			EventURI:       types.StringValue(sinkSettings.GetEventUri()),
+			MetricURI:      types.StringValue(sinkSettings.GetMetricUri()),
+		}
+		sink.Datadog = fwtypes.NewObjectValueOf[datadogSinkModel](ctx, &datadog)
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_PAGERDUTY:
+		priorPagerDuty := pagerDutySinkModel{}
+		diags.Append(objectAsIfKnown(ctx, prior.PagerDuty, &priorPagerDuty)...)
+		pagerDuty := pagerDutySinkModel{
+			Host:           types.StringValue(streamSubSink.GetHost()),
+			IntegrationKey: secretOrPrior(sinkCredential.GetIntegrationKey(), priorPagerDuty.IntegrationKey),
+			EventURI:       types.StringValue(sinkSettings.GetEventUri()),
+			MetricURI:      types.StringValue(sinkSettings.GetMetricUri()),
+		}
+		sink.PagerDuty = fwtypes.NewObjectValueOf[pagerDutySinkModel](ctx, &pagerDuty)
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SLACK:
+		priorSlack := uriSinkModel{}
+		diags.Append(objectAsIfKnown(ctx, prior.Slack, &priorSlack)...)
+		slack := uriSinkModel{
+			URI: secretOrPrior(streamSubSink.GetUri(), priorSlack.URI),
+		}
+		sink.Slack = fwtypes.NewObjectValueOf[uriSinkModel](ctx, &slack)
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_TEAMS:
+		priorTeams := uriSinkModel{}
+		diags.Append(objectAsIfKnown(ctx, prior.Teams, &priorTeams)...)
+		teams := uriSinkModel{
+			URI: secretOrPrior(streamSubSink.GetUri(), priorTeams.URI),
+		}
+		sink.Teams = fwtypes.NewObjectValueOf[uriSinkModel](ctx, &teams)
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_CUSTOM:
+		priorWebhook := webhookSinkModel{}
+		diags.Append(objectAsIfKnown(ctx, prior.Webhook, &priorWebhook)...)
+		webhook := webhookSinkModel{
+			URI:         types.StringValue(streamSubSink.GetUri()),
+			AccessToken: nullableSecretOrPrior(sinkCredential.GetAccessToken(), priorWebhook.AccessToken),
+			Username:    types.StringNull(),
+			Password:    nullableSecretOrPrior(sinkCredential.GetPassword(), priorWebhook.Password),
+		}
+		if username := sinkCredential.GetUsername(); username != "" {
+			webhook.Username = types.StringValue(username)
+		}
+		sink.Webhook = fwtypes.NewObjectValueOf[webhookSinkModel](ctx, &webhook)
+	}
+	if diags.HasError() {
+		return fwtypes.NewObjectValueOfNull[sinkModel](ctx), diags
+	}
+
+	return fwtypes.NewObjectValueOf[sinkModel](ctx, &sink), diags
+}
+
+func objectAsIfKnown[T any](ctx context.Context, object fwtypes.ObjectValueOf[T], target *T) diag.Diagnostics {
+	if object.IsNull() || object.IsUnknown() {
+		return nil
+	}
+	return object.As(ctx, target, basetypes.ObjectAsOptions{})
+}
+
+// secretOrPrior keeps a known prior value of a secret the API masks or omits
+func secretOrPrior(value string, prior types.String) types.String {
+	if prior.ValueString() != "" {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// nullableSecretOrPrior behaves like secretOrPrior but keeps optional webhook
+// credentials null when neither the API nor the prior value carries them.
+func nullableSecretOrPrior(value string, prior types.String) types.String {
+	if prior.ValueString() != "" {
+		return prior
+	}
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
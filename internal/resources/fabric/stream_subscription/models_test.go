@@ -0,0 +1,54 @@
+package streamsubscription
+
+import (
+	"context"
+	"testing"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseSinkModel_priorSecrets(t *testing.T) {
+	ctx := context.Background()
+	credential := fabricv4.StreamSubscriptionSinkCredential{}
+	credential.SetUsername("api-user")
+	sink := fabricv4.StreamSubscriptionSink{}
+	sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_CUSTOM)
+	sink.SetUri("https://example.com/new")
+	sink.SetCredential(credential)
+
+	prior := sinkModel{Webhook: fwtypes.NewObjectValueOf[webhookSinkModel](ctx, &webhookSinkModel{
+		URI:         types.StringValue("https://example.com/old"),
+		AccessToken: types.StringNull(),
+		Username:    types.StringValue("old-user"),
+		Password:    types.StringValue("secret"),
+	})}
+
+	parsed, diags := parseSinkModel(ctx, sink, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	parsedSink, diags := parsed.ToPtr(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	webhook, diags := parsedSink.Webhook.ToPtr(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if webhook.URI.ValueString() != "https://example.com/new" {
+		t.Errorf("expected the uri from the API, got %s", webhook.URI)
+	}
+	if webhook.Username.ValueString() != "api-user" {
+		t.Errorf("expected the username from the API, got %s", webhook.Username)
+	}
+	if webhook.Password.ValueString() != "secret" {
+		t.Errorf("expected the prior password, got %s", webhook.Password)
+	}
+	if !webhook.AccessToken.IsNull() {
+		t.Errorf("expected no access token, got %s", webhook.AccessToken)
+	}
+}
@@ -63,6 +63,7 @@ func (r *Resource) Create(
 
 	createRequest, diags := buildCreateRequest(ctx, plan)
 	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
@@ -78,7 +79,7 @@ func (r *Resource) Create(
 		return
 	}
 	createWaiter := getCreateUpdateWaiter(ctx, client, plan.StreamID.ValueString(), streamSubscription.GetUuid(), createTimeout)
-	streamChecked, err := createWaiter.WaitForStateContext(ctx)
+	streamChecked, err := r.Meta.WaitForStateContext(ctx, createWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed creating stream subscription %s", streamSubscription.GetUuid()), err.Error())
@@ -171,7 +172,7 @@ func (r *Resource) Update(
 	}
 
 	updateWaiter := getCreateUpdateWaiter(ctx, client, streamID, id, updateTimeout)
-	streamSubscriptionChecked, err := updateWaiter.WaitForStateContext(ctx)
+	streamSubscriptionChecked, err := r.Meta.WaitForStateContext(ctx, updateWaiter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed updating stream subscription %s", id), err.Error())
@@ -222,7 +223,7 @@ func (r *Resource) Delete(
 		return
 	}
 	deleteWaiter := getDeleteWaiter(ctx, client, streamID, id, deleteTimeout)
-	_, err = deleteWaiter.WaitForStateContext(ctx)
+	_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -341,82 +342,120 @@ func buildStreamSubscriptionSink(ctx context.Context, sinkObject fwtypes.ObjectV
 	}
 
 	var sink fabricv4.StreamSubscriptionSink
+	var credential fabricv4.StreamSubscriptionSinkCredential
+	var settings fabricv4.StreamSubscriptionSinkSetting
 
-	sink.SetType(fabricv4.StreamSubscriptionSinkType(sinkValue.Type.ValueString()))
-	if !sinkValue.URI.IsNull() && !sinkValue.URI.IsUnknown() {
-		sink.SetUri(sinkValue.URI.ValueString())
-	}
-
-	if !sinkValue.BatchEnabled.IsNull() && !sinkValue.BatchEnabled.IsUnknown() {
-		sink.SetBatchEnabled(sinkValue.BatchEnabled.ValueBool())
-	}
-
-	if !sinkValue.BatchSizeMax.IsNull() && !sinkValue.BatchSizeMax.IsUnknown() {
-		sink.SetBatchSizeMax(sinkValue.BatchSizeMax.ValueInt32())
-	}
-
-	if !sinkValue.BatchWaitTimeMax.IsNull() && !sinkValue.BatchWaitTimeMax.IsUnknown() {
-		sink.SetBatchWaitTimeMax(sinkValue.BatchWaitTimeMax.ValueInt32())
-	}
-
-	if !sinkValue.Host.IsNull() && !sinkValue.Host.IsUnknown() {
-		sink.SetHost(sinkValue.Host.ValueString())
-	}
-
-	if !sinkValue.Credential.IsNull() && !sinkValue.Credential.IsUnknown() {
-		// Build credential
-		var credentialModel sinkCredentialModel
-		diags = sinkValue.Credential.As(ctx, &credentialModel, basetypes.ObjectAsOptions{})
+	switch {
+	case !sinkValue.SplunkHEC.IsNull() && !sinkValue.SplunkHEC.IsUnknown():
+		var splunk splunkHECSinkModel
+		diags = sinkValue.SplunkHEC.As(ctx, &splunk, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return fabricv4.StreamSubscriptionSink{}, diags
 		}
-
-		var credential fabricv4.StreamSubscriptionSinkCredential
-		credential.SetType(fabricv4.StreamSubscriptionSinkCredentialType(credentialModel.Type.ValueString()))
-
-		switch credential.GetType() {
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_ACCESS_TOKEN:
-			credential.SetAccessToken(credentialModel.AccessToken.ValueString())
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_INTEGRATION_KEY:
-			credential.SetIntegrationKey(credentialModel.IntegrationKey.ValueString())
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_API_KEY:
-			credential.SetApiKey(credentialModel.APIKey.ValueString())
-		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_USERNAME_PASSWORD:
-			credential.SetUsername(credentialModel.Username.ValueString())
-			credential.SetPassword(credentialModel.Password.ValueString())
-		default:
-			diags.AddError("sink credential type is invalid", fmt.Sprintf("update sink credential type to one of the following %v", fabricv4.AllowedStreamSubscriptionSinkCredentialTypeEnumValues))
+		sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SPLUNK_HEC)
+		sink.SetUri(splunk.URI.ValueString())
+		credential.SetType(fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_ACCESS_TOKEN)
+		credential.SetAccessToken(splunk.AccessToken.ValueString())
+		sink.SetCredential(credential)
+		if !splunk.EventIndex.IsNull() && !splunk.EventIndex.IsUnknown() {
+			settings.SetEventIndex(splunk.EventIndex.ValueString())
+		}
+		if !splunk.MetricIndex.IsNull() && !splunk.MetricIndex.IsUnknown() {
+			settings.SetMetricIndex(splunk.MetricIndex.ValueString())
+		}
+		if !splunk.Source.IsNull() && !splunk.Source.IsUnknown() {
+			settings.SetSource(splunk.Source.ValueString())
+		}
+		sink.SetSettings(settings)
+	case !sinkValue.Datadog.IsNull() && !sinkValue.Datadog.IsUnknown():
+		var datadog datadogSinkModel
+		diags = sinkValue.Datadog.As(ctx, &datadog, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
 			return fabricv4.StreamSubscriptionSink{}, diags
 		}
-
+		sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_DATADOG)
+		sink.SetHost(datadog.Host.ValueString())
+		credential.SetType(fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_API_KEY)
+		credential.SetApiKey(datadog.APIKey.ValueString())
 		sink.SetCredential(credential)
-	}
-
-	if !sinkValue.Settings.IsNull() && !sinkValue.Settings.IsUnknown() {
-		// Build settings
-		var settingsModel sinkSettingsModel
-		diags = sinkValue.Settings.As(ctx, &settingsModel, basetypes.ObjectAsOptions{})
-
-		var settings fabricv4.StreamSubscriptionSinkSetting
-		if !settingsModel.EventIndex.IsNull() && !settingsModel.EventIndex.IsUnknown() {
-			settings.SetEventIndex(settingsModel.EventIndex.ValueString())
+		settings.SetApplicationKey(datadog.ApplicationKey.ValueString())
+		if !datadog.Source.IsNull() && !datadog.Source.IsUnknown() {
+			settings.SetSource(datadog.Source.ValueString())
 		}
-		if !settingsModel.MetricIndex.IsNull() && !settingsModel.MetricIndex.IsUnknown() {
-			settings.SetMetricIndex(settingsModel.MetricIndex.ValueString())
+		if !datadog.EventURI.IsNull() && !datadog.EventURI.IsUnknown() {
+			settings.SetEventUri(datadog.EventURI.ValueString())
 		}
-		if !settingsModel.Source.IsNull() && !settingsModel.Source.IsUnknown() {
-			settings.SetSource(settingsModel.Source.ValueString())
+		if !datadog.MetricURI.IsNull() && !datadog.MetricURI.IsUnknown() {
+			settings.SetMetricUri(datadog.MetricURI.ValueString())
 		}
-		if !settingsModel.ApplicationKey.IsNull() && !settingsModel.ApplicationKey.IsUnknown() {
-			settings.SetApplicationKey(settingsModel.ApplicationKey.ValueString())
+		sink.SetSettings(settings)
+	case !sinkValue.PagerDuty.IsNull() && !sinkValue.PagerDuty.IsUnknown():
+		var pagerDuty pagerDutySinkModel
+		diags = sinkValue.PagerDuty.As(ctx, &pagerDuty, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return fabricv4.StreamSubscriptionSink{}, diags
 		}
-		if !settingsModel.EventURI.IsNull() && !settingsModel.EventURI.IsUnknown() {
-			settings.SetEventUri(settingsModel.EventURI.ValueString())
+		sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_PAGERDUTY)
+		sink.SetHost(pagerDuty.Host.ValueString())
+		credential.SetType(fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_INTEGRATION_KEY)
+		credential.SetIntegrationKey(pagerDuty.IntegrationKey.ValueString())
+		sink.SetCredential(credential)
+		if !pagerDuty.EventURI.IsNull() && !pagerDuty.EventURI.IsUnknown() {
+			settings.SetEventUri(pagerDuty.EventURI.ValueString())
 		}
-		if !settingsModel.MetricURI.IsNull() && !settingsModel.MetricURI.IsUnknown() {
-			settings.SetMetricUri(settingsModel.MetricURI.ValueString())
+		if !pagerDuty.MetricURI.IsNull() && !pagerDuty.MetricURI.IsUnknown() {
+			settings.SetMetricUri(pagerDuty.MetricURI.ValueString())
 		}
 		sink.SetSettings(settings)
+	case !sinkValue.Slack.IsNull() && !sinkValue.Slack.IsUnknown():
+		var slack uriSinkModel
+		diags = sinkValue.Slack.As(ctx, &slack, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return fabricv4.StreamSubscriptionSink{}, diags
+		}
+		sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SLACK)
+		sink.SetUri(slack.URI.ValueString())
+	case !sinkValue.Teams.IsNull() && !sinkValue.Teams.IsUnknown():
+		var teams uriSinkModel
+		diags = sinkValue.Teams.As(ctx, &teams, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return fabricv4.StreamSubscriptionSink{}, diags
+		}
+		sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_TEAMS)
+		sink.SetUri(teams.URI.ValueString())
+	case !sinkValue.Webhook.IsNull() && !sinkValue.Webhook.IsUnknown():
+		var webhook webhookSinkModel
+		diags = sinkValue.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return fabricv4.StreamSubscriptionSink{}, diags
+		}
+		sink.SetType(fabricv4.STREAMSUBSCRIPTIONSINKTYPE_CUSTOM)
+		sink.SetUri(webhook.URI.ValueString())
+		if webhook.AccessToken.ValueString() != "" {
+			credential.SetType(fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_ACCESS_TOKEN)
+			credential.SetAccessToken(webhook.AccessToken.ValueString())
+			sink.SetCredential(credential)
+		} else if webhook.Username.ValueString() != "" {
+			credential.SetType(fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_USERNAME_PASSWORD)
+			credential.SetUsername(webhook.Username.ValueString())
+			credential.SetPassword(webhook.Password.ValueString())
+			sink.SetCredential(credential)
+		}
+	default:
+		diags.AddError("sink type is missing", "configure exactly one of the splunk_hec, datadog, pagerduty, slack, teams or webhook sink blocks")
+		return fabricv4.StreamSubscriptionSink{}, diags
+	}
+
+	if !sinkValue.BatchEnabled.IsNull() && !sinkValue.BatchEnabled.IsUnknown() {
+		sink.SetBatchEnabled(sinkValue.BatchEnabled.ValueBool())
+	}
+
+	if !sinkValue.BatchSizeMax.IsNull() && !sinkValue.BatchSizeMax.IsUnknown() {
+		sink.SetBatchSizeMax(sinkValue.BatchSizeMax.ValueInt32())
+	}
+
+	if !sinkValue.BatchWaitTimeMax.IsNull() && !sinkValue.BatchWaitTimeMax.IsUnknown() {
+		sink.SetBatchWaitTimeMax(sinkValue.BatchWaitTimeMax.ValueInt32())
 	}
 
 	return sink, diags
@@ -438,9 +477,8 @@ func getCreateUpdateWaiter(ctx context.Context, client *fabricv4.APIClient, stre
 			}
 			return streamSubscription, string(streamSubscription.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
 
@@ -467,8 +505,7 @@ func getDeleteWaiter(ctx context.Context, client *fabricv4.APIClient, streamID,
 			}
 			return streamSubscription, string(streamSubscription.GetState()), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
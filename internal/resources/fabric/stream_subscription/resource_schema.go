@@ -2,21 +2,30 @@ package streamsubscription
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/equinix/terraform-provider-equinix/internal/framework"
 	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func resourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
+		Version: 1,
 		Description: `Fabric V4 API compatible resource allows creation and management of Equinix Fabric Stream Subscriptions
 
 Additional Documentation:
@@ -95,25 +104,17 @@ Additional Documentation:
 				},
 			},
 			"sink": schema.SingleNestedAttribute{
-				Description: "The details of the subscriber to the Equinix Stream",
+				Description: "The details of the subscriber to the Equinix Stream. Exactly one of the splunk_hec, datadog, pagerduty, slack, teams or webhook blocks must be configured",
 				Required:    true,
 				CustomType:  fwtypes.NewObjectTypeOf[sinkModel](ctx),
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.UseStateForUnknown(),
-				},
 				Attributes: map[string]schema.Attribute{
-					"uri": schema.StringAttribute{
-						Description: "Publicly reachable http endpoint destination for data stream",
-						Optional:    true,
+					"type": schema.StringAttribute{
+						Description: "Type of the subscriber, derived from the configured sink block",
 						Computed:    true,
 						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
+							sinkTypeFromBlock{},
 						},
 					},
-					"type": schema.StringAttribute{
-						Description: "Type of the subscriber",
-						Required:    true,
-					},
 					"batch_enabled": schema.BoolAttribute{
 						Description: "Boolean switch enabling batch delivery of data",
 						Optional:    true,
@@ -138,61 +139,104 @@ Additional Documentation:
 							int32planmodifier.UseStateForUnknown(),
 						},
 					},
-					"host": schema.StringAttribute{
-						Description: "Known hostname of certain data stream subscription products. Not to be confused with a variable URI",
-						Optional:    true,
-						Computed:    true,
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
-						},
-					},
-					"credential": schema.SingleNestedAttribute{
-						Description: "Access details for the specified sink type",
+					"splunk_hec": schema.SingleNestedAttribute{
+						Description: "Splunk HTTP Event Collector subscriber",
 						Optional:    true,
-						Computed:    true,
-						CustomType:  fwtypes.NewObjectTypeOf[sinkCredentialModel](ctx),
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
+						CustomType:  fwtypes.NewObjectTypeOf[splunkHECSinkModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(sinkBlockPaths("splunk_hec")...),
 						},
 						Attributes: map[string]schema.Attribute{
-							"type": schema.StringAttribute{
-								Description: "Type of the credential being passed",
+							"uri": schema.StringAttribute{
+								Description: "Splunk HEC endpoint, i.e. https://<host>:8088/services/collector",
 								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(httpsURIRegex, "must be an https:// URI"),
+								},
 							},
 							"access_token": schema.StringAttribute{
-								Description: "Passed as Authorization header value",
+								Description: "Splunk HEC token",
+								Required:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+							},
+							"event_index": schema.StringAttribute{
+								Description: "Splunk index receiving events",
 								Optional:    true,
 								Computed:    true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
 							},
-							"integration_key": schema.StringAttribute{
-								Description: "Passed as Authorization header value",
+							"metric_index": schema.StringAttribute{
+								Description: "Splunk index receiving metrics",
 								Optional:    true,
 								Computed:    true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
 							},
+							"source": schema.StringAttribute{
+								Description: "Splunk source of the delivered data",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+					"datadog": schema.SingleNestedAttribute{
+						Description: "Datadog subscriber",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[datadogSinkModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(sinkBlockPaths("datadog")...),
+						},
+						Attributes: map[string]schema.Attribute{
+							"host": schema.StringAttribute{
+								Description: "Datadog site host, i.e. datadoghq.com",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+							},
 							"api_key": schema.StringAttribute{
-								Description: "Passed as Authorization header value",
+								Description: "Datadog API key",
+								Required:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+							},
+							"application_key": schema.StringAttribute{
+								Description: "Datadog application key",
+								Required:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+							},
+							"source": schema.StringAttribute{
+								Description: "Datadog source of the delivered data",
 								Optional:    true,
 								Computed:    true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
 							},
-							"username": schema.StringAttribute{
-								Description: "Passed as Authorization header value",
+							"event_uri": schema.StringAttribute{
+								Description: "Datadog endpoint receiving events",
 								Optional:    true,
 								Computed:    true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
 							},
-							"password": schema.StringAttribute{
-								Description: "Passed as Authorization header value",
+							"metric_uri": schema.StringAttribute{
+								Description: "Datadog endpoint receiving metrics",
 								Optional:    true,
 								Computed:    true,
 								PlanModifiers: []planmodifier.String{
@@ -201,55 +245,119 @@ Additional Documentation:
 							},
 						},
 					},
-					"settings": schema.SingleNestedAttribute{
-						Description: "Stream subscription sink settings",
+					"pagerduty": schema.SingleNestedAttribute{
+						Description: "PagerDuty subscriber",
 						Optional:    true,
-						Computed:    true,
-						CustomType:  fwtypes.NewObjectTypeOf[sinkSettingsModel](ctx),
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
+						CustomType:  fwtypes.NewObjectTypeOf[pagerDutySinkModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(sinkBlockPaths("pagerduty")...),
 						},
 						Attributes: map[string]schema.Attribute{
-							"event_index": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.UseStateForUnknown(),
+							"host": schema.StringAttribute{
+								Description: "PagerDuty events host, i.e. events.pagerduty.com",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
 								},
 							},
-							"metric_index": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.UseStateForUnknown(),
+							"integration_key": schema.StringAttribute{
+								Description: "PagerDuty integration key",
+								Required:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
 								},
 							},
-							"source": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
+							"event_uri": schema.StringAttribute{
+								Description: "PagerDuty endpoint receiving change events",
+								Optional:    true,
+								Computed:    true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
 							},
-							"application_key": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
+							"metric_uri": schema.StringAttribute{
+								Description: "PagerDuty endpoint receiving alert events",
+								Optional:    true,
+								Computed:    true,
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
 							},
-							"event_uri": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"slack": schema.SingleNestedAttribute{
+						Description: "Slack subscriber",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[uriSinkModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(sinkBlockPaths("slack")...),
+						},
+						Attributes: map[string]schema.Attribute{
+							"uri": schema.StringAttribute{
+								Description: "Slack incoming webhook URL",
+								Required:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(httpsURIRegex, "must be an https:// URI"),
 								},
 							},
-							"metric_uri": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								PlanModifiers: []planmodifier.String{
-									stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"teams": schema.SingleNestedAttribute{
+						Description: "Microsoft Teams subscriber",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[uriSinkModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(sinkBlockPaths("teams")...),
+						},
+						Attributes: map[string]schema.Attribute{
+							"uri": schema.StringAttribute{
+								Description: "Microsoft Teams incoming webhook URL",
+								Required:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(httpsURIRegex, "must be an https:// URI"),
+								},
+							},
+						},
+					},
+					"webhook": schema.SingleNestedAttribute{
+						Description: "Generic webhook subscriber",
+						Optional:    true,
+						CustomType:  fwtypes.NewObjectTypeOf[webhookSinkModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(sinkBlockPaths("webhook")...),
+						},
+						Attributes: map[string]schema.Attribute{
+							"uri": schema.StringAttribute{
+								Description: "Publicly reachable https endpoint receiving the data stream",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(httpsURIRegex, "must be an https:// URI"),
+								},
+							},
+							"access_token": schema.StringAttribute{
+								Description: "Token passed as Authorization header value",
+								Optional:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("username")),
+								},
+							},
+							"username": schema.StringAttribute{
+								Description: "Username for basic authentication",
+								Optional:    true,
+								Validators: []validator.String{
+									stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("password")),
+								},
+							},
+							"password": schema.StringAttribute{
+								Description: "Password for basic authentication",
+								Optional:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
 								},
 							},
 						},
@@ -335,3 +443,56 @@ Additional Documentation:
 		},
 	}
 }
+
+var httpsURIRegex = regexp.MustCompile(`^https://\S+$`)
+
+// sinkBlockPaths returns the paths of the typed sink blocks other than the
+// given one, for use in the ExactlyOneOf validator of each block.
+func sinkBlockPaths(except string) []path.Expression {
+	var paths []path.Expression
+	for _, name := range sinkBlockNames {
+		if name != except {
+			paths = append(paths, path.MatchRelative().AtParent().AtName(name))
+		}
+	}
+	return paths
+}
+
+// sinkBlockNames lists the typed sink blocks, of which exactly one is configured
+var sinkBlockNames = []string{"splunk_hec", "datadog", "pagerduty", "slack", "teams", "webhook"}
+
+// sinkBlockTypes maps each typed sink block to the sink type sent to the API
+var sinkBlockTypes = map[string]fabricv4.StreamSubscriptionSinkType{
+	"splunk_hec": fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SPLUNK_HEC,
+	"datadog":    fabricv4.STREAMSUBSCRIPTIONSINKTYPE_DATADOG,
+	"pagerduty":  fabricv4.STREAMSUBSCRIPTIONSINKTYPE_PAGERDUTY,
+	"slack":      fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SLACK,
+	"teams":      fabricv4.STREAMSUBSCRIPTIONSINKTYPE_TEAMS,
+	"webhook":    fabricv4.STREAMSUBSCRIPTIONSINKTYPE_CUSTOM,
+}
+
+// sinkTypeFromBlock plans the computed sink type from whichever typed sink
+// block is configured, so that switching blocks shows the new type in the plan
+type sinkTypeFromBlock struct{}
+
+func (m sinkTypeFromBlock) Description(_ context.Context) string {
+	return "Sets the sink type from the configured sink block"
+}
+
+func (m sinkTypeFromBlock) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m sinkTypeFromBlock) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	for _, name := range sinkBlockNames {
+		var block types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(name), &block)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !block.IsNull() && !block.IsUnknown() {
+			resp.PlanValue = types.StringValue(string(sinkBlockTypes[name]))
+			return
+		}
+	}
+}
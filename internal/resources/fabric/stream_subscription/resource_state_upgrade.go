@@ -0,0 +1,260 @@
+package streamsubscription
+
+import (
+	"context"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/equinix/equinix-sdk-go/services/fabricv4"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModelV0 is the state of the resource before the sink was split into
+// typed blocks per sink kind
+type resourceModelV0 struct {
+	StreamID       types.String                          `tfsdk:"stream_id"`
+	ID             types.String                          `tfsdk:"id"`
+	Timeouts       timeouts.Value                        `tfsdk:"timeouts"`
+	Type           types.String                          `tfsdk:"type"`
+	Name           types.String                          `tfsdk:"name"`
+	Description    types.String                          `tfsdk:"description"`
+	Enabled        types.Bool                            `tfsdk:"enabled"`
+	MetricSelector fwtypes.ObjectValueOf[selectorModel]  `tfsdk:"metric_selector"`
+	EventSelector  fwtypes.ObjectValueOf[selectorModel]  `tfsdk:"event_selector"`
+	Sink           fwtypes.ObjectValueOf[sinkModelV0]    `tfsdk:"sink"`
+	Href           types.String                          `tfsdk:"href"`
+	UUID           types.String                          `tfsdk:"uuid"`
+	State          types.String                          `tfsdk:"state"`
+	ChangeLog      fwtypes.ObjectValueOf[changeLogModel] `tfsdk:"change_log"`
+}
+
+type sinkModelV0 struct {
+	URI              types.String                                 `tfsdk:"uri"`
+	Type             types.String                                 `tfsdk:"type"`
+	BatchEnabled     types.Bool                                   `tfsdk:"batch_enabled"`
+	BatchSizeMax     types.Int32                                  `tfsdk:"batch_size_max"`
+	BatchWaitTimeMax types.Int32                                  `tfsdk:"batch_wait_time_max"`
+	Host             types.String                                 `tfsdk:"host"`
+	Credential       fwtypes.ObjectValueOf[sinkCredentialModelV0] `tfsdk:"credential"`
+	Settings         fwtypes.ObjectValueOf[sinkSettingsModelV0]   `tfsdk:"settings"`
+}
+
+type sinkCredentialModelV0 struct {
+	Type           types.String `tfsdk:"type"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	IntegrationKey types.String `tfsdk:"integration_key"`
+	APIKey         types.String `tfsdk:"api_key"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+}
+
+type sinkSettingsModelV0 struct {
+	EventIndex     types.String `tfsdk:"event_index"`
+	MetricIndex    types.String `tfsdk:"metric_index"`
+	Source         types.String `tfsdk:"source"`
+	ApplicationKey types.String `tfsdk:"application_key"`
+	EventURI       types.String `tfsdk:"event_uri"`
+	MetricURI      types.String `tfsdk:"metric_uri"`
+}
+
+// UpgradeState migrates state written with the untyped sink object
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := resourceSchemaV0(ctx)
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior resourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := resourceModel{
+					StreamID: prior.StreamID,
+					ID:       prior.ID,
+					Timeouts: prior.Timeouts,
+					baseStreamSubscriptionModel: baseStreamSubscriptionModel{
+						Type:           prior.Type,
+						Name:           prior.Name,
+						Description:    prior.Description,
+						Enabled:        prior.Enabled,
+						MetricSelector: prior.MetricSelector,
+						EventSelector:  prior.EventSelector,
+						Href:           prior.Href,
+						UUID:           prior.UUID,
+						State:          prior.State,
+						ChangeLog:      prior.ChangeLog,
+					},
+				}
+
+				sink, diags := upgradeSinkV0(ctx, prior.Sink)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				upgraded.Sink = sink
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
+
+// upgradeSinkV0 moves the flat uri, host, credential and settings values of a
+// version 0 sink into the typed block matching its type
+func upgradeSinkV0(ctx context.Context, priorSink fwtypes.ObjectValueOf[sinkModelV0]) (fwtypes.ObjectValueOf[sinkModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if priorSink.IsNull() || priorSink.IsUnknown() {
+		return fwtypes.NewObjectValueOfNull[sinkModel](ctx), diags
+	}
+
+	prior := sinkModelV0{}
+	credential := sinkCredentialModelV0{}
+	settings := sinkSettingsModelV0{}
+	diags.Append(objectAsIfKnown(ctx, priorSink, &prior)...)
+	diags.Append(objectAsIfKnown(ctx, prior.Credential, &credential)...)
+	diags.Append(objectAsIfKnown(ctx, prior.Settings, &settings)...)
+	if diags.HasError() {
+		return fwtypes.NewObjectValueOfNull[sinkModel](ctx), diags
+	}
+
+	sink := sinkModel{
+		Type:             prior.Type,
+		BatchEnabled:     prior.BatchEnabled,
+		BatchSizeMax:     prior.BatchSizeMax,
+		BatchWaitTimeMax: prior.BatchWaitTimeMax,
+		SplunkHEC:        fwtypes.NewObjectValueOfNull[splunkHECSinkModel](ctx),
+		Datadog:          fwtypes.NewObjectValueOfNull[datadogSinkModel](ctx),
+		PagerDuty:        fwtypes.NewObjectValueOfNull[pagerDutySinkModel](ctx),
+		Slack:            fwtypes.NewObjectValueOfNull[uriSinkModel](ctx),
+		Teams:            fwtypes.NewObjectValueOfNull[uriSinkModel](ctx),
+		Webhook:          fwtypes.NewObjectValueOfNull[webhookSinkModel](ctx),
+	}
+
+	switch fabricv4.StreamSubscriptionSinkType(prior.Type.ValueString()) {
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SPLUNK_HEC:
+		sink.SplunkHEC = fwtypes.NewObjectValueOf[splunkHECSinkModel](ctx, &splunkHECSinkModel{
+			URI:         prior.URI,
+			AccessToken: credential.AccessToken,
+			EventIndex:  settings.EventIndex,
+			MetricIndex: settings.MetricIndex,
+			Source:      settings.Source,
+		})
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_DATADOG:
+		sink.Datadog = fwtypes.NewObjectValueOf[datadogSinkModel](ctx, &datadogSinkModel{
+			Host:           prior.Host,
+			APIKey:         credential.APIKey,
+			ApplicationKey: settings.ApplicationKey,
+			Source:         settings.Source,
+			EventURI:       settings.EventURI,
+			MetricURI:      settings.MetricURI,
+		})
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_PAGERDUTY:
+		sink.PagerDuty = fwtypes.NewObjectValueOf[pagerDutySinkModel](ctx, &pagerDutySinkModel{
+			Host:           prior.Host,
+			IntegrationKey: credential.IntegrationKey,
+			EventURI:       settings.EventURI,
+			MetricURI:      settings.MetricURI,
+		})
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_SLACK:
+		sink.Slack = fwtypes.NewObjectValueOf[uriSinkModel](ctx, &uriSinkModel{
+			URI: prior.URI,
+		})
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_TEAMS:
+		sink.Teams = fwtypes.NewObjectValueOf[uriSinkModel](ctx, &uriSinkModel{
+			URI: prior.URI,
+		})
+	case fabricv4.STREAMSUBSCRIPTIONSINKTYPE_CUSTOM:
+		webhook := webhookSinkModel{
+			URI:         prior.URI,
+			AccessToken: types.StringNull(),
+			Username:    types.StringNull(),
+			Password:    types.StringNull(),
+		}
+		switch fabricv4.StreamSubscriptionSinkCredentialType(credential.Type.ValueString()) {
+		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_ACCESS_TOKEN:
+			webhook.AccessToken = credential.AccessToken
+		case fabricv4.STREAMSUBSCRIPTIONSINKCREDENTIALTYPE_USERNAME_PASSWORD:
+			webhook.Username = credential.Username
+			webhook.Password = credential.Password
+		}
+		sink.Webhook = fwtypes.NewObjectValueOf[webhookSinkModel](ctx, &webhook)
+	default:
+		diags.AddError("unable to upgrade stream subscription sink",
+			"sink type "+prior.Type.ValueString()+" has no typed sink block; remove the resource from state and import it again")
+		return fwtypes.NewObjectValueOfNull[sinkModel](ctx), diags
+	}
+
+	return fwtypes.NewObjectValueOf[sinkModel](ctx, &sink), diags
+}
+
+// resourceSchemaV0 is the version 0 schema; only the sink attribute differs
+// from the current schema
+func resourceSchemaV0(ctx context.Context) schema.Schema {
+	priorSchema := resourceSchema(ctx)
+	priorSchema.Version = 0
+
+	optionalComputedString := schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+	}
+	priorSchema.Attributes["sink"] = schema.SingleNestedAttribute{
+		Required:   true,
+		CustomType: fwtypes.NewObjectTypeOf[sinkModelV0](ctx),
+		Attributes: map[string]schema.Attribute{
+			"uri": optionalComputedString,
+			"type": schema.StringAttribute{
+				Required: true,
+			},
+			"batch_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"batch_size_max": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"batch_wait_time_max": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"host": optionalComputedString,
+			"credential": schema.SingleNestedAttribute{
+				Optional:   true,
+				Computed:   true,
+				CustomType: fwtypes.NewObjectTypeOf[sinkCredentialModelV0](ctx),
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required: true,
+					},
+					"access_token":    optionalComputedString,
+					"integration_key": optionalComputedString,
+					"api_key":         optionalComputedString,
+					"username":        optionalComputedString,
+					"password":        optionalComputedString,
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Optional:   true,
+				Computed:   true,
+				CustomType: fwtypes.NewObjectTypeOf[sinkSettingsModelV0](ctx),
+				Attributes: map[string]schema.Attribute{
+					"event_index":     optionalComputedString,
+					"metric_index":    optionalComputedString,
+					"source":          optionalComputedString,
+					"application_key": optionalComputedString,
+					"event_uri":       optionalComputedString,
+					"metric_uri":      optionalComputedString,
+				},
+			},
+		},
+	}
+
+	return priorSchema
+}
@@ -0,0 +1,87 @@
+package streamsubscription
+
+import (
+	"context"
+	"testing"
+
+	fwtypes "github.com/equinix/terraform-provider-equinix/internal/framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpgradeSinkV0(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		prior   sinkModelV0
+		check   func(*testing.T, sinkModel)
+		wantErr bool
+	}{
+		"splunk": {
+			prior: sinkModelV0{
+				URI:  types.StringValue("https://splunk.example.com:8088/services/collector"),
+				Type: types.StringValue("SPLUNK_HEC"),
+				Credential: fwtypes.NewObjectValueOf[sinkCredentialModelV0](ctx, &sinkCredentialModelV0{
+					Type:        types.StringValue("ACCESS_TOKEN"),
+					AccessToken: types.StringValue("token"),
+				}),
+				Settings: fwtypes.NewObjectValueOf[sinkSettingsModelV0](ctx, &sinkSettingsModelV0{
+					EventIndex: types.StringValue("events"),
+				}),
+			},
+			check: func(t *testing.T, sink sinkModel) {
+				splunk, _ := sink.SplunkHEC.ToPtr(ctx)
+				if splunk == nil || splunk.AccessToken.ValueString() != "token" || splunk.EventIndex.ValueString() != "events" {
+					t.Errorf("unexpected splunk_hec block: %+v", splunk)
+				}
+				if !sink.Slack.IsNull() || !sink.Webhook.IsNull() {
+					t.Errorf("expected only the splunk_hec block to be set")
+				}
+			},
+		},
+		"custom with basic authentication": {
+			prior: sinkModelV0{
+				URI:  types.StringValue("https://hooks.example.com"),
+				Type: types.StringValue("CUSTOM"),
+				Credential: fwtypes.NewObjectValueOf[sinkCredentialModelV0](ctx, &sinkCredentialModelV0{
+					Type:        types.StringValue("USERNAME_PASSWORD"),
+					AccessToken: types.StringValue(""),
+					Username:    types.StringValue("user"),
+					Password:    types.StringValue("secret"),
+				}),
+				Settings: fwtypes.NewObjectValueOfNull[sinkSettingsModelV0](ctx),
+			},
+			check: func(t *testing.T, sink sinkModel) {
+				webhook, _ := sink.Webhook.ToPtr(ctx)
+				if webhook == nil || webhook.Username.ValueString() != "user" || !webhook.AccessToken.IsNull() {
+					t.Errorf("unexpected webhook block: %+v", webhook)
+				}
+			},
+		},
+		"unknown type": {
+			prior: sinkModelV0{
+				Type:       types.StringValue("UNSUPPORTED"),
+				Credential: fwtypes.NewObjectValueOfNull[sinkCredentialModelV0](ctx),
+				Settings:   fwtypes.NewObjectValueOfNull[sinkSettingsModelV0](ctx),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sink, diags := upgradeSinkV0(ctx, fwtypes.NewObjectValueOf[sinkModelV0](ctx, &tc.prior))
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if tc.wantErr {
+				return
+			}
+			upgraded, d := sink.ToPtr(ctx)
+			if d.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", d)
+			}
+			tc.check(t, *upgraded)
+		})
+	}
+}
@@ -62,15 +62,11 @@ func testAccFabricStreamSubscriptionConfig(streamTestData map[string]map[string]
 		  stream_id = equinix_fabric_stream.new_stream.id
 		  enabled = false
 		  sink = {
-			type = "SPLUNK_HEC"
-			uri = "%s"
-			settings = {
+			splunk_hec = {
+			  uri = "%s"
 			  event_index  = "%s"
 			  metric_index = "%s"
 			  source = "%s"
-			}
-			credential = {
-			  type = "ACCESS_TOKEN"
 			  access_token = "%s"
 			}
 		  }
@@ -83,8 +79,9 @@ func testAccFabricStreamSubscriptionConfig(streamTestData map[string]map[string]
 		  stream_id = equinix_fabric_stream.new_stream.id
 		  enabled = false
 		  sink = {
-			type = "SLACK"
-			uri = "%s"
+			slack = {
+			  uri = "%s"
+			}
 		  }
 		}
 
@@ -95,14 +92,10 @@ func testAccFabricStreamSubscriptionConfig(streamTestData map[string]map[string]
 		  stream_id = equinix_fabric_stream.new_stream.id
 		  enabled = false
 		  sink = {
-			type = "PAGERDUTY"
-			host = "%s"
-			settings = {
-			    change_uri = "%s"
-			    alert_uri = "%s"
-			}
-			credential = {
-			  type = "INTEGRATION_KEY"
+			pagerduty = {
+			  host = "%s"
+			  event_uri = "%s"
+			  metric_uri = "%s"
 			  integration_key = "%s"
 			}
 		  }
@@ -115,16 +108,12 @@ func testAccFabricStreamSubscriptionConfig(streamTestData map[string]map[string]
 		  stream_id = equinix_fabric_stream.new_stream2.id
 		  enabled = false
 		  sink = {
-			type = "DATADOG"
-			host = "%s"
-			settings = {
-				source = "Equinix"
-				application_key = "%s"
-			    event_uri = "%s"
-			    metric_uri = "%s"
-			}
-			credential = {
-			  type = "API_KEY"
+			datadog = {
+			  host = "%s"
+			  source = "Equinix"
+			  application_key = "%s"
+			  event_uri = "%s"
+			  metric_uri = "%s"
 			  api_key = "%s"
 			}
 		  }
@@ -137,8 +126,9 @@ func testAccFabricStreamSubscriptionConfig(streamTestData map[string]map[string]
 		  stream_id = equinix_fabric_stream.new_stream2.id
 		  enabled = false
 		  sink = {
-			type = "TEAMS"
-			uri = "%s"
+			teams = {
+			  uri = "%s"
+			}
 		  }
 		}
 
@@ -199,13 +189,12 @@ func TestAccFabricStreamSubscription_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"equinix_fabric_stream_subscription.splunk", "description", "Stream Subscription Splunk TF Testing"),
 					resource.TestCheckResourceAttr("equinix_fabric_stream_subscription.splunk", "sink.type", "SPLUNK_HEC"),
-					resource.TestCheckResourceAttr("equinix_fabric_stream_subscription.splunk", "sink.credential.type", "ACCESS_TOKEN"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "stream_id"),
-					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.uri"),
-					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.credential.access_token"),
-					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.settings.event_index"),
-					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.settings.metric_index"),
-					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.settings.source"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.splunk_hec.uri"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.splunk_hec.access_token"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.splunk_hec.event_index"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.splunk_hec.metric_index"),
+					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "sink.splunk_hec.source"),
 					resource.TestCheckResourceAttrSet("equinix_fabric_stream_subscription.splunk", "uuid"),
 					resource.TestCheckResourceAttr(
 						"data.equinix_fabric_stream_subscription.by_ids", "name", "Splunk_PFCR"),
@@ -214,12 +203,11 @@ func TestAccFabricStreamSubscription_PFCR(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"data.equinix_fabric_stream_subscription.by_ids", "description", "Stream Subscription Splunk TF Testing"),
 					resource.TestCheckResourceAttr("data.equinix_fabric_stream_subscription.by_ids", "sink.type", "SPLUNK_HEC"),
-					resource.TestCheckResourceAttr("data.equinix_fabric_stream_subscription.by_ids", "sink.credential.type", "ACCESS_TOKEN"),
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "stream_id"),
-					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.uri"),
-					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.settings.event_index"),
-					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.settings.metric_index"),
-					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.settings.source"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.splunk_hec.uri"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.splunk_hec.event_index"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.splunk_hec.metric_index"),
+					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "sink.splunk_hec.source"),
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscription.by_ids", "uuid"),
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscriptions.all", "data.0.name"),
 					resource.TestCheckResourceAttrSet("data.equinix_fabric_stream_subscriptions.all", "data.0.type"),
@@ -57,7 +57,7 @@ func (r *Resource) Create(
 	client := r.Meta.NewMetalClientForFramework(ctx, req.ProviderMeta)
 	projectID := plan.ProjectID.ValueString()
 
-	createRequest, diags := buildCreateRequest(ctx, plan)
+	createRequest, diags := BuildCreateRequest(ctx, plan)
 
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -277,8 +277,8 @@ func (r *Resource) Delete(
 	// the timeouts block, I opted to replicage the non-configurable
 	// timeout from packngo for now
 	deleteTimeout := 60 * time.Second
-	deleteWaiter := getDeleteWaiter(ctx, client, id, deleteTimeout)
-	_, err = deleteWaiter.WaitForStateContext(ctx)
+	deleteWaiter := GetDeleteWaiter(ctx, client, id, deleteTimeout)
+	_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -476,7 +476,9 @@ func validateSharedConnection(plan ResourceModel) (diags diag.Diagnostics) {
 	return
 }
 
-func buildCreateRequest(ctx context.Context, plan ResourceModel) (request metalv1.CreateOrganizationInterconnectionRequest, diags diag.Diagnostics) {
+// BuildCreateRequest converts a connection plan into the API create request,
+// validating the combination of type, vlans and vrfs
+func BuildCreateRequest(ctx context.Context, plan ResourceModel) (request metalv1.CreateOrganizationInterconnectionRequest, diags diag.Diagnostics) {
 	hasVlans := len(plan.Vlans.Elements()) != 0
 	hasVrfs := len(plan.Vrfs.Elements()) != 0
 
@@ -579,7 +581,8 @@ func getConnection(ctx context.Context, client *metalv1.APIClient, state *tfsdk.
 	return conn, diags
 }
 
-func getDeleteWaiter(ctx context.Context, client *metalv1.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
+// GetDeleteWaiter waits for a Metal Connection to be deleted
+func GetDeleteWaiter(ctx context.Context, client *metalv1.APIClient, id string, timeout time.Duration) *retry.StateChangeConf {
 	// deletedMarker is a terraform-provider-only value that is used by the waiter
 	// to indicate that the connection appears to be deleted successfully based on
 	// status code
@@ -599,8 +602,7 @@ func getDeleteWaiter(ctx context.Context, client *metalv1.APIClient, id string,
 			}
 			return conn, conn.GetStatus(), nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
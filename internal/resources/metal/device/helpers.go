@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"path"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/equinix/terraform-provider-equinix/internal/config"
 	"github.com/equinix/terraform-provider-equinix/internal/converters"
 
 	"github.com/equinix/equinix-sdk-go/services/metalv1"
@@ -18,16 +20,35 @@ import (
 	"github.com/packethost/packngo"
 )
 
+const (
+	// PowerStateOn is the power_state of a device that is powered on
+	PowerStateOn = "on"
+	// PowerStateOff is the power_state of a device that is powered off
+	PowerStateOff = "off"
+)
+
 const (
 	deprovisioning = "deprovisioning"
 	provisionable  = "provisionable"
 	reprovisioned  = "reprovisioned"
 	errstate       = "error"
+	actionPending  = "pending"
+	actionStarted  = "started"
 )
 
 var (
 	wgMap   = map[string]*sync.WaitGroup{}
 	wgMutex = sync.Mutex{}
+
+	// transitionalStates are the states a device leaves on its own to become
+	// active or inactive
+	transitionalStates = []metalv1.DeviceState{
+		metalv1.DEVICESTATE_QUEUED,
+		metalv1.DEVICESTATE_PROVISIONING,
+		metalv1.DEVICESTATE_REINSTALLING,
+		metalv1.DEVICESTATE_POWERING_ON,
+		metalv1.DEVICESTATE_POWERING_OFF,
+	}
 )
 
 type NetworkInfo struct {
@@ -132,19 +153,124 @@ func hwReservationStateRefreshFunc(ctx context.Context, client *metalv1.APIClien
 	}
 }
 
-func WaitUntilReservationProvisionable(ctx context.Context, client *metalv1.APIClient, reservationId, instanceId string, delay, timeout, minTimeout time.Duration) error {
+func WaitUntilReservationProvisionable(ctx context.Context, meta *config.Config, client *metalv1.APIClient, reservationId, instanceId string, delay, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{deprovisioning},
-		Target:     []string{provisionable, reprovisioned},
-		Refresh:    hwReservationStateRefreshFunc(ctx, client, reservationId, instanceId),
-		Timeout:    timeout,
-		Delay:      delay,
-		MinTimeout: minTimeout,
-	}
-	_, err := stateConf.WaitForStateContext(ctx)
+		Pending: []string{deprovisioning},
+		Target:  []string{provisionable, reprovisioned},
+		Refresh: hwReservationStateRefreshFunc(ctx, client, reservationId, instanceId),
+		Timeout: timeout,
+		Delay:   delay,
+	}
+	_, err := meta.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
+// PowerState returns the power_state of a device in the given state. Active
+// and powering on devices are on, inactive and powering off devices are off;
+// any other state, e.g. provisioning or reinstalling, has no power state and
+// an empty string is returned.
+func PowerState(state metalv1.DeviceState) string {
+	switch state {
+	case metalv1.DEVICESTATE_ACTIVE, metalv1.DEVICESTATE_POWERING_ON:
+		return PowerStateOn
+	case metalv1.DEVICESTATE_INACTIVE, metalv1.DEVICESTATE_POWERING_OFF:
+		return PowerStateOff
+	default:
+		return ""
+	}
+}
+
+// GetStateWaiter returns a waiter for the device to move from one of the
+// pending states to one of the target states. The waiter result is the
+// device state.
+func GetStateWaiter(ctx context.Context, client *metalv1.APIClient, id string, pending, target []metalv1.DeviceState, timeout time.Duration) *retry.StateChangeConf {
+	toStrings := func(states []metalv1.DeviceState) []string {
+		s := make([]string, len(states))
+		for i, state := range states {
+			s[i] = string(state)
+		}
+		return s
+	}
+
+	return &retry.StateChangeConf{
+		Pending: toStrings(pending),
+		Target:  toStrings(target),
+		Refresh: func() (interface{}, string, error) {
+			device, _, err := client.DevicesApi.FindDeviceById(ctx, id).Execute()
+			if err != nil {
+				return "", "", err
+			}
+			state := string(device.GetState())
+			return state, state, nil
+		},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+}
+
+// GetActionStartWaiter returns a waiter for the device to pick up an action
+// performed after it was last updated at updatedAt: the device leaves the
+// active state or its updated_at changes. Actions such as reboots end in the
+// active state again, so waiting for active alone could return before the
+// action started.
+func GetActionStartWaiter(ctx context.Context, client *metalv1.APIClient, id string, updatedAt time.Time, timeout time.Duration) *retry.StateChangeConf {
+	return &retry.StateChangeConf{
+		Pending: []string{actionPending},
+		Target:  []string{actionStarted},
+		Refresh: func() (interface{}, string, error) {
+			device, _, err := client.DevicesApi.FindDeviceById(ctx, id).Execute()
+			if err != nil {
+				return "", "", err
+			}
+			if device.GetState() == metalv1.DEVICESTATE_ACTIVE && device.GetUpdatedAt().Equal(updatedAt) {
+				return device, actionPending, nil
+			}
+			return device, actionStarted, nil
+		},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+}
+
+// setPowerState powers the device on or off when power_state changed to the
+// given value and waits for the device to reach the matching state. A device
+// in any other state, e.g. reinstalling or already powering on or off, is
+// waited for first; no action is performed if it settles in the target state.
+func setPowerState(ctx context.Context, client *metalv1.APIClient, d *schema.ResourceData, meta interface{}, powerState string, timeout time.Duration) error {
+	if d.Get("power_state").(string) != powerState {
+		return nil
+	}
+
+	action := metalv1.DEVICEACTIONINPUTTYPE_POWER_ON
+	pending := []metalv1.DeviceState{metalv1.DEVICESTATE_INACTIVE, metalv1.DEVICESTATE_POWERING_ON}
+	target := []metalv1.DeviceState{metalv1.DEVICESTATE_ACTIVE}
+	if powerState == PowerStateOff {
+		action = metalv1.DEVICEACTIONINPUTTYPE_POWER_OFF
+		pending = []metalv1.DeviceState{metalv1.DEVICESTATE_ACTIVE, metalv1.DEVICESTATE_POWERING_OFF}
+		target = []metalv1.DeviceState{metalv1.DEVICESTATE_INACTIVE}
+	}
+
+	settleConf := GetStateWaiter(ctx, client, d.Id(), transitionalStates,
+		[]metalv1.DeviceState{metalv1.DEVICESTATE_ACTIVE, metalv1.DEVICESTATE_INACTIVE}, timeout)
+	state, err := waitForDeviceAttribute(ctx, meta.(*config.Config), d, settleConf)
+	if err != nil {
+		return fmt.Errorf("error waiting for device %s to be powered on or off: %w", d.Id(), err)
+	}
+	if PowerState(metalv1.DeviceState(state)) == powerState {
+		return nil
+	}
+
+	if _, err := client.DevicesApi.PerformAction(ctx, d.Id()).DeviceActionInput(*metalv1.NewDeviceActionInput(action)).Execute(); err != nil {
+		return fmt.Errorf("error powering %s device %s: %w", powerState, d.Id(), err)
+	}
+
+	stateConf := GetStateWaiter(ctx, client, d.Id(), pending, target, timeout)
+	if _, err := waitForDeviceAttribute(ctx, meta.(*config.Config), d, stateConf); err != nil {
+		return fmt.Errorf("error waiting for device %s to power %s: %w", d.Id(), powerState, err)
+	}
+	return nil
+}
+
 func getWaitForDeviceLock(deviceID string) *sync.WaitGroup {
 	wgMutex.Lock()
 	defer wgMutex.Unlock()
@@ -156,7 +282,7 @@ func getWaitForDeviceLock(deviceID string) *sync.WaitGroup {
 	return wg
 }
 
-func waitForDeviceAttribute(ctx context.Context, d *schema.ResourceData, stateConf *retry.StateChangeConf) (string, error) {
+func waitForDeviceAttribute(ctx context.Context, meta *config.Config, d *schema.ResourceData, stateConf *retry.StateChangeConf) (string, error) {
 	wg := getWaitForDeviceLock(d.Id())
 	wg.Wait()
 
@@ -174,7 +300,7 @@ func waitForDeviceAttribute(ctx context.Context, d *schema.ResourceData, stateCo
 		return "", errors.New("invalid stateconf to wait for")
 	}
 
-	attrValRaw, err := stateConf.WaitForStateContext(ctx)
+	attrValRaw, err := meta.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := attrValRaw.(string); ok {
 		return v, err
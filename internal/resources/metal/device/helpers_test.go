@@ -165,8 +165,10 @@ func Test_WaitUntilReservationProvisionable(t *testing.T) {
 
 			mockAPI := httptest.NewServer(http.HandlerFunc(tt.args.handler))
 			meta := &config.Config{
-				BaseURL: mockAPI.URL,
-				Token:   "fakeTokenForMock",
+				BaseURL:         mockAPI.URL,
+				Token:           "fakeTokenForMock",
+				PollMinInterval: 50 * time.Millisecond,
+				PollMaxInterval: 50 * time.Millisecond,
 			}
 			err := meta.Load(ctx)
 			if err != nil {
@@ -174,7 +176,7 @@ func Test_WaitUntilReservationProvisionable(t *testing.T) {
 			}
 
 			client := meta.NewMetalClientForTesting()
-			if err := device.WaitUntilReservationProvisionable(ctx, client, tt.args.reservationId, tt.args.instanceId, 50*time.Millisecond, 1*time.Second, 50*time.Millisecond); (err != nil) != tt.wantErr {
+			if err := device.WaitUntilReservationProvisionable(ctx, meta, client, tt.args.reservationId, tt.args.instanceId, 50*time.Millisecond, 1*time.Second); (err != nil) != tt.wantErr {
 				t.Errorf("waitUntilReservationProvisionable() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -182,3 +184,91 @@ func Test_WaitUntilReservationProvisionable(t *testing.T) {
 		})
 	}
 }
+
+func TestPowerState(t *testing.T) {
+	tests := map[metalv1.DeviceState]string{
+		metalv1.DEVICESTATE_ACTIVE:       device.PowerStateOn,
+		metalv1.DEVICESTATE_POWERING_ON:  device.PowerStateOn,
+		metalv1.DEVICESTATE_INACTIVE:     device.PowerStateOff,
+		metalv1.DEVICESTATE_POWERING_OFF: device.PowerStateOff,
+		metalv1.DEVICESTATE_PROVISIONING: "",
+		metalv1.DEVICESTATE_REINSTALLING: "",
+		metalv1.DEVICESTATE_FAILED:       "",
+	}
+
+	for state, want := range tests {
+		t.Run(string(state), func(t *testing.T) {
+			if got := device.PowerState(state); got != want {
+				t.Errorf("PowerState(%s) = %s, want %s", state, got, want)
+			}
+		})
+	}
+}
+
+func TestGetActionStartWaiter(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type response struct {
+		state     metalv1.DeviceState
+		updatedAt time.Time
+	}
+
+	tests := map[string]struct {
+		responses []response
+		wantErr   bool
+	}{
+		"device leaves active": {
+			responses: []response{
+				{metalv1.DEVICESTATE_ACTIVE, updatedAt},
+				{metalv1.DEVICESTATE_POWERING_OFF, updatedAt},
+			},
+		},
+		"device updated while active": {
+			responses: []response{
+				{metalv1.DEVICESTATE_ACTIVE, updatedAt},
+				{metalv1.DEVICESTATE_ACTIVE, updatedAt.Add(time.Minute)},
+			},
+		},
+		"action never starts": {
+			responses: []response{
+				{metalv1.DEVICESTATE_ACTIVE, updatedAt},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			invoked := 0
+			mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				response := tc.responses[min(invoked, len(tc.responses)-1)]
+				invoked++
+				body, err := (&metalv1.Device{State: &response.state, UpdatedAt: &response.updatedAt}).MarshalJSON()
+				if err != nil {
+					// This should never be reached and indicates a failure in the test itself
+					panic(err)
+				}
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(body)
+			}))
+			defer mockAPI.Close()
+
+			meta := &config.Config{
+				BaseURL:         mockAPI.URL,
+				Token:           "fakeTokenForMock",
+				PollMinInterval: 10 * time.Millisecond,
+				PollMaxInterval: 10 * time.Millisecond,
+			}
+			if err := meta.Load(ctx); err != nil {
+				log.Printf("failed to load provider config during test: %v", err)
+			}
+
+			waiter := device.GetActionStartWaiter(ctx, meta.NewMetalClientForTesting(), "deviceId", updatedAt, 500*time.Millisecond)
+			waiter.Delay = 0
+			if _, err := meta.WaitForStateContext(ctx, waiter); (err != nil) != tc.wantErr {
+				t.Errorf("GetActionStartWaiter() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -182,6 +182,13 @@ func Resource() *schema.Resource {
 				Description: "The status of the device",
 				Computed:    true,
 			},
+			"power_state": {
+				Type:         schema.TypeString,
+				Description:  "The desired power state of the device, `on` or `off`. Changes are applied through device power actions. The current power state is read back, so a device powered on or off outside of Terraform is reported as drift. While the device is in another state, e.g. provisioning or reinstalling, the last known power state is kept; see `state` for the current state of the device",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{PowerStateOn, PowerStateOff}, false),
+			},
 			"root_password": {
 				Type:        schema.TypeString,
 				Description: "Root password to the server (disabled after 24 hours)",
@@ -567,6 +574,11 @@ func resourceMetalDeviceCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	createTimeout = d.Timeout(schema.TimeoutCreate) - 30*time.Second - time.Since(start)
+	if err = setPowerState(ctx, client, d, meta, PowerStateOff, createTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return Read(ctx, d, meta)
 }
 
@@ -597,6 +609,10 @@ func Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 	}
 	errs = append(errs, d.Set("operating_system", device.OperatingSystem.GetSlug()))
 	errs = append(errs, d.Set("state", device.GetState()))
+	// Keep the last known power state while the device is in any other state
+	if powerState := PowerState(device.GetState()); powerState != "" {
+		errs = append(errs, d.Set("power_state", powerState))
+	}
 	errs = append(errs, d.Set("billing_cycle", device.GetBillingCycle()))
 	errs = append(errs, d.Set("locked", device.GetLocked()))
 	errs = append(errs, d.Set("created", device.GetCreatedAt().Format(time.RFC3339)))
@@ -739,10 +755,26 @@ func resourceMetalDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// Power on before reinstalling and power off after it so that a
+	// reinstall is never requested on a powered off device
+	if d.HasChange("power_state") {
+		updateTimeout := d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
+		if err := setPowerState(ctx, client, d, meta, PowerStateOn, updateTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := doReinstall(ctx, client, d, meta, start); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("power_state") {
+		updateTimeout := d.Timeout(schema.TimeoutUpdate) - 30*time.Second - time.Since(start)
+		if err := setPowerState(ctx, client, d, meta, PowerStateOff, updateTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return Read(ctx, d, meta)
 }
 
@@ -807,7 +839,7 @@ func resourceMetalDeviceDelete(ctx context.Context, d *schema.ResourceData, meta
 			// avoid "context: deadline exceeded"
 			timeout := d.Timeout(schema.TimeoutDelete) - 30*time.Second - time.Since(start)
 
-			err := WaitUntilReservationProvisionable(ctx, client, resId.(string), d.Id(), 10*time.Second, timeout, 3*time.Second)
+			err := WaitUntilReservationProvisionable(ctx, meta.(*config.Config), client, resId.(string), d.Id(), 10*time.Second, timeout)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -833,13 +865,12 @@ func WaitForActiveDevice(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 			return "error", "error", err
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 
 	// Wait for the device so we can get the networking attributes that show up after a while.
-	state, err := waitForDeviceAttribute(ctx, d, stateConf)
+	state, err := waitForDeviceAttribute(ctx, meta.(*config.Config), d, stateConf)
 	if err != nil {
 		d.SetId("")
 		// TODO: this can never be true because we don't have the API response
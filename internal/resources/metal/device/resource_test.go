@@ -1019,3 +1019,55 @@ resource "equinix_metal_device" "test" {
   termination_time = "%s"
 }`, acceptance.ConfAccMetalDeviceBase(acceptance.PreferablePlans, acceptance.PreferableMetros, acceptance.PreferableOs), projSuffix, locked, acceptance.DeviceTerminationTime())
 }
+
+func TestAccMetalDevice_powerState(t *testing.T) {
+	var d1, d2 metalv1.Device
+	rs := acctest.RandString(10)
+	r := "equinix_metal_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccMetalDeviceCheckDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetalDeviceConfig_powerState(rs, device.PowerStateOff),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMetalDeviceExists(r, &d1),
+					resource.TestCheckResourceAttr(r, "power_state", device.PowerStateOff),
+					resource.TestCheckResourceAttr(r, "state", string(metalv1.DEVICESTATE_INACTIVE)),
+				),
+			},
+			{
+				Config: testAccMetalDeviceConfig_powerState(rs, device.PowerStateOn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMetalDeviceExists(r, &d2),
+					testAccMetalSameDevice(t, &d1, &d2),
+					resource.TestCheckResourceAttr(r, "power_state", device.PowerStateOn),
+					resource.TestCheckResourceAttr(r, "state", string(metalv1.DEVICESTATE_ACTIVE)),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetalDeviceConfig_powerState(projSuffix, powerState string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "equinix_metal_project" "test" {
+    name = "tfacc-device-%s"
+}
+
+resource "equinix_metal_device" "test" {
+  hostname         = "tfacc-test-device"
+  plan             = local.plan
+  metro            = local.metro
+  operating_system = local.os
+  billing_cycle    = "hourly"
+  project_id       = "${equinix_metal_project.test.id}"
+  power_state      = "%s"
+  termination_time = "%s"
+}`, acceptance.ConfAccMetalDeviceBase(acceptance.PreferablePlans, acceptance.PreferableMetros, acceptance.PreferableOs), projSuffix, powerState, acceptance.DeviceTerminationTime())
+}
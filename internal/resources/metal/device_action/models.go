@@ -0,0 +1,15 @@
+package deviceaction
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	DeviceID types.String   `tfsdk:"device_id"`
+	Type     types.String   `tfsdk:"type"`
+	Triggers types.Map      `tfsdk:"triggers"`
+	State    types.String   `tfsdk:"state"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,153 @@
+package deviceaction
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	equinix_errors "github.com/equinix/terraform-provider-equinix/internal/errors"
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+	"github.com/equinix/terraform-provider-equinix/internal/resources/metal/device"
+
+	"github.com/equinix/equinix-sdk-go/services/metalv1"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pendingStates are the device states a device passes through after a
+// reboot or rescue action before it is active again
+var pendingStates = []metalv1.DeviceState{
+	metalv1.DEVICESTATE_QUEUED,
+	metalv1.DEVICESTATE_PROVISIONING,
+	metalv1.DEVICESTATE_REINSTALLING,
+	metalv1.DEVICESTATE_POWERING_OFF,
+	metalv1.DEVICESTATE_INACTIVE,
+	metalv1.DEVICESTATE_POWERING_ON,
+}
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: framework.NewBaseResource(
+			framework.BaseResourceConfig{
+				Name: "equinix_metal_device_action",
+			},
+		),
+	}
+}
+
+type Resource struct {
+	framework.BaseResource
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewMetalClientForFramework(ctx, req.ProviderMeta)
+
+	deviceID := plan.DeviceID.ValueString()
+	actionType := metalv1.DeviceActionInputType(plan.Type.ValueString())
+	metalDevice, _, err := client.DevicesApi.FindDeviceById(ctx, deviceID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving device %s", deviceID), err.Error())
+		return
+	}
+	if _, err := client.DevicesApi.PerformAction(ctx, deviceID).DeviceActionInput(*metalv1.NewDeviceActionInput(actionType)).Execute(); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed performing %s action on device %s", actionType, deviceID), err.Error())
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 20*time.Minute)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	start := time.Now()
+	startWaiter := device.GetActionStartWaiter(ctx, client, deviceID, metalDevice.GetUpdatedAt(), createTimeout)
+	if _, err := r.Meta.WaitForStateContext(ctx, startWaiter); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed waiting for device %s to start the %s action", deviceID, actionType), err.Error())
+		return
+	}
+	waiter := device.GetStateWaiter(ctx, client, deviceID, pendingStates, []metalv1.DeviceState{metalv1.DEVICESTATE_ACTIVE}, createTimeout-time.Since(start))
+	state, err := r.Meta.WaitForStateContext(ctx, waiter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed waiting for device %s to be active after %s action", deviceID, actionType), err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.New().String())
+	plan.State = types.StringValue(state.(string))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.NewMetalClientForFramework(ctx, req.ProviderMeta)
+
+	deviceID := state.DeviceID.ValueString()
+	metalDevice, httpResp, err := client.DevicesApi.FindDeviceById(ctx, deviceID).Execute()
+	if err != nil {
+		if equinix_errors.IgnoreHttpResponseErrors(http.StatusForbidden, http.StatusNotFound)(httpResp, err) == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed retrieving device %s", deviceID), err.Error())
+		return
+	}
+
+	state.State = types.StringValue(string(metalDevice.GetState()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only persists changes to timeouts; every other argument performs
+// the action again
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the action from the Terraform state; device actions
+// cannot be undone through the API
+func (r *Resource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
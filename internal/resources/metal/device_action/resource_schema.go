@@ -0,0 +1,69 @@
+package deviceaction
+
+import (
+	"context"
+
+	"github.com/equinix/terraform-provider-equinix/internal/framework"
+
+	"github.com/equinix/equinix-sdk-go/services/metalv1"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var actionTypes = []string{
+	string(metalv1.DEVICEACTIONINPUTTYPE_REBOOT),
+	string(metalv1.DEVICEACTIONINPUTTYPE_RESCUE),
+}
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: `Provides a resource to perform an action, such as a reboot or a boot into rescue mode, on an Equinix Metal device. The action is performed on create and waited on until the device is active again; like terraform_data, changing triggers performs the action again. Destroying the resource only removes it from the Terraform state
+
+To power a device on or off, use the ` + "`power_state`" + ` attribute of ` + "`equinix_metal_device`" + ` instead.`,
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttributeDefaultDescription(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"device_id": schema.StringAttribute{
+				Description: "The ID of the device to perform the action on",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The action to perform, `reboot` or `rescue`. A `rescue` action reboots the device into the rescue operating system",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(actionTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will perform the action again",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the device",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package deviceaction_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/equinix/terraform-provider-equinix/internal/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetalDeviceAction_reboot(t *testing.T) {
+	rs := acctest.RandString(10)
+	r := "equinix_metal_device_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ExternalProviders:        acceptance.TestExternalProviders,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetalDeviceActionConfig(rs, "reboot", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(r, "id"),
+					resource.TestCheckResourceAttr(r, "type", "reboot"),
+					resource.TestCheckResourceAttr(r, "state", "active"),
+					resource.TestCheckResourceAttrPair(r, "device_id", "equinix_metal_device.test", "id"),
+				),
+			},
+			{
+				Config: testAccMetalDeviceActionConfig(rs, "reboot", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(r, "triggers.run", "2"),
+					resource.TestCheckResourceAttr(r, "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetalDeviceActionConfig(projSuffix, actionType, run string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "equinix_metal_project" "test" {
+    name = "tfacc-device-action-%s"
+}
+
+resource "equinix_metal_device" "test" {
+  hostname         = "tfacc-test-device-action"
+  plan             = local.plan
+  metro            = local.metro
+  operating_system = local.os
+  billing_cycle    = "hourly"
+  project_id       = equinix_metal_project.test.id
+  termination_time = "%s"
+}
+
+resource "equinix_metal_device_action" "test" {
+  device_id = equinix_metal_device.test.id
+  type      = "%s"
+  triggers = {
+    run = "%s"
+  }
+}`, acceptance.ConfAccMetalDeviceBase(acceptance.PreferablePlans, acceptance.PreferableMetros, acceptance.PreferableOs), projSuffix, acceptance.DeviceTerminationTime(), actionType, run)
+}
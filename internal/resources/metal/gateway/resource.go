@@ -188,7 +188,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 			deleteTimeout,
 			[]string{string(metalv1.METALGATEWAYSTATE_DELETING)},
 		)
-		_, err = deleteWaiter.WaitForStateContext(ctx)
+		_, err = r.Meta.WaitForStateContext(ctx, deleteWaiter)
 	}
 
 	if err != nil {
@@ -242,8 +242,7 @@ func getGatewayDeleteWaiter(ctx context.Context, client *metalv1.APIClient, id s
 			}
 			return gw, state, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
@@ -25,6 +25,7 @@ var (
 )
 
 type ClientPortResource struct {
+	Meta     *config.Config
 	Client   *metalv1.APIClient
 	Port     *metalv1.Port
 	Resource *schema.ResourceData
@@ -45,6 +46,7 @@ func getClientPortResource(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	cpr := &ClientPortResource{
+		Meta:     meta.(*config.Config),
 		Client:   client,
 		Port:     port,
 		Resource: d,
@@ -194,11 +196,10 @@ func createAndWaitForBatch(ctx context.Context, start time.Time, cpr *ClientPort
 	ctxTimeout := deadline.Sub(start)
 
 	stateChangeConf := &retry.StateChangeConf{
-		Delay:      5 * time.Second,
-		Pending:    []string{string(metalv1.PORTVLANASSIGNMENTBATCHSTATE_QUEUED), string(metalv1.PORTVLANASSIGNMENTBATCHSTATE_IN_PROGRESS)},
-		Target:     []string{string(metalv1.PORTVLANASSIGNMENTBATCHSTATE_COMPLETED)},
-		MinTimeout: 5 * time.Second,
-		Timeout:    ctxTimeout - time.Since(start) - 30*time.Second,
+		Delay:   5 * time.Second,
+		Pending: []string{string(metalv1.PORTVLANASSIGNMENTBATCHSTATE_QUEUED), string(metalv1.PORTVLANASSIGNMENTBATCHSTATE_IN_PROGRESS)},
+		Target:  []string{string(metalv1.PORTVLANASSIGNMENTBATCHSTATE_COMPLETED)},
+		Timeout: ctxTimeout - time.Since(start) - 30*time.Second,
 		Refresh: func() (result interface{}, state string, err error) {
 			b, _, err := c.PortsApi.FindPortVlanAssignmentBatchByPortIdAndBatchId(ctx, portID, b.GetId()).Execute()
 			switch b.GetState() {
@@ -215,7 +216,7 @@ func createAndWaitForBatch(ctx context.Context, start time.Time, cpr *ClientPort
 			}
 		},
 	}
-	if _, err = stateChangeConf.WaitForStateContext(ctx); err != nil {
+	if _, err = cpr.Meta.WaitForStateContext(ctx, stateChangeConf); err != nil {
 		return errors.Wrapf(err, "vlan assignment batch %s is not complete after timeout", b.GetId())
 	}
 	return nil
@@ -267,7 +267,7 @@ func resourceMetalVirtualCircuitCreate(ctx context.Context, d *schema.ResourceDa
 			[]string{string(metalv1.VLANVIRTUALCIRCUITSTATUS_ACTIVE)},
 		)
 
-		_, err = createWaiter.WaitForStateContext(ctx)
+		_, err = meta.(*config.Config).WaitForStateContext(ctx, createWaiter)
 		if err != nil {
 			return diag.Errorf("Error waiting for virtual circuit %s to be created: %s", vcId, err.Error())
 		}
@@ -395,9 +395,8 @@ func getVCStateWaiter(ctx context.Context, client *metalv1.APIClient, id string,
 			}
 			return vc, vcStatus, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout: timeout,
+		Delay:   10 * time.Second,
 	}
 }
 
@@ -551,7 +550,7 @@ func resourceMetalVirtualCircuitDelete(ctx context.Context, d *schema.ResourceDa
 		[]string{},
 	)
 
-	_, err = deleteWaiter.WaitForStateContext(ctx)
+	_, err = meta.(*config.Config).WaitForStateContext(ctx, deleteWaiter)
 	if equinix_errors.IgnoreHttpResponseErrors(http.StatusForbidden, http.StatusNotFound)(nil, err) != nil {
 		return diag.Errorf("Error deleting virtual circuit %s: %s", d.Id(), err)
 	}
@@ -8,6 +8,7 @@ import (
 	fabric_route_filter "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/route_filter"
 	fabric_route_aggregation "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/routeaggregation"
 	fabric_stream "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream"
+	fabric_stream_alert_rule "github.com/equinix/terraform-provider-equinix/internal/resources/fabric/stream_alert_rule"
 
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/network"
 	"github.com/equinix/terraform-provider-equinix/internal/resources/fabric/serviceprofile"
@@ -39,6 +40,7 @@ func addTestSweepers() {
 	fabric_route_filter.AddTestSweeper()
 	fabric_route_aggregation.AddTestSweeper()
 	fabric_stream.AddTestSweeper()
+	fabric_stream_alert_rule.AddTestSweeper()
 	network.AddTestSweeper()
 	organization.AddTestSweeper()
 	project.AddTestSweeper()